@@ -0,0 +1,223 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/mail"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/nicewook/gocore/internal/config"
+	"github.com/nicewook/gocore/internal/db"
+	"github.com/nicewook/gocore/internal/domain"
+	repository "github.com/nicewook/gocore/internal/repository/postgres"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+// 관리자 계정 생성. 서버를 시작할 때의 첫 관리자(bootstrap_admin)와 gocore admin create 명령에서 사용한다
+
+const adminUsage = `usage: gocore admin create -email <email> [-env dev] [-name Admin] [-password-file <path>|-] [-password-change-required=true]
+비밀번호는 -password-file (- 이면 표준 입력) 또는 GOCORE_ADMIN_PASSWORD 환경 변수로 전달한다`
+
+// runAdmin 은 gocore admin 하위 명령을 실행한다
+func runAdmin(args []string) error {
+	if len(args) == 0 || args[0] != "create" {
+		return errors.New(adminUsage)
+	}
+
+	err := runAdminCreate(args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return nil
+	}
+	return err
+}
+
+// runAdminCreate 는 관리자 계정을 만든다. 비밀번호가 명령줄 인자로 노출되지 않도록 파일이나 환경 변수로만 받는다
+func runAdminCreate(args []string) error {
+	fs := flag.NewFlagSet("admin create", flag.ContinueOnError)
+	env := fs.String("env", "dev", "Environment (dev, qa, stg, prod)")
+	email := fs.String("email", "", "관리자 이메일")
+	name := fs.String("name", "Admin", "관리자 이름")
+	passwordFile := fs.String("password-file", "", "비밀번호 파일. - 이면 표준 입력에서 읽는다. 비어 있으면 GOCORE_ADMIN_PASSWORD 를 사용한다")
+	passwordChangeRequired := fs.Bool("password-change-required", true, "처음 로그인할 때 비밀번호 변경 요구")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if _, err := mail.ParseAddress(*email); err != nil {
+		return fmt.Errorf("invalid -email %q\n%s", *email, adminUsage)
+	}
+
+	password := os.Getenv("GOCORE_ADMIN_PASSWORD")
+	if *passwordFile != "" {
+		var err error
+		if password, err = readPasswordFile(*passwordFile); err != nil {
+			return err
+		}
+	}
+	if password == "" {
+		return fmt.Errorf("password is required\n%s", adminUsage)
+	}
+
+	cfg, err := loadConfig(*env)
+	if err != nil {
+		return err
+	}
+
+	policy, err := NewPasswordPolicy(cfg)
+	if err != nil {
+		return err
+	}
+	if err := checkPasswordPolicy(policy, password, *email); err != nil {
+		return err
+	}
+
+	hashParams, err := NewHashParams(cfg)
+	if err != nil {
+		return err
+	}
+	passwordHash, err := security.GeneratePasswordHash(password, hashParams)
+	if err != nil {
+		return err
+	}
+
+	dbConn, err := db.NewDBConnection(cfg)
+	if err != nil {
+		return err
+	}
+	defer dbConn.Close()
+
+	admin, err := createAdmin(context.Background(), repository.NewUserRepository(dbConn), *name, *email, passwordHash, *passwordChangeRequired)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Admin created: id=%d email=%s password_change_required=%t\n", admin.ID, admin.Email, admin.PasswordChangeRequired)
+	return nil
+}
+
+// BootstrapAdmin 은 bootstrap_admin 설정이 켜져 있으면 첫 관리자 계정을 만든다
+// 같은 이메일의 사용자가 이미 있으면 설정의 비밀번호로 덮어쓰지 않는다
+func BootstrapAdmin(cfg *config.Config, userRepo domain.UserRepository, hashParams *security.HashParams, policy *security.PasswordPolicy, logger *slog.Logger) error {
+	adminCfg := cfg.Secure.BootstrapAdmin
+	if !adminCfg.Enabled {
+		return nil
+	}
+	if adminCfg.Email == "" {
+		return errors.New("bootstrap admin: email is required")
+	}
+
+	ctx := context.Background()
+	_, err := userRepo.GetUserByEmail(ctx, adminCfg.Email)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, domain.ErrNotFound) {
+		return fmt.Errorf("bootstrap admin: %w", err)
+	}
+
+	passwordHash, err := bootstrapPasswordHash(adminCfg, hashParams, policy)
+	if err != nil {
+		return fmt.Errorf("bootstrap admin: %w", err)
+	}
+
+	name := adminCfg.Name
+	if name == "" {
+		name = "Admin"
+	}
+	admin, err := createAdmin(ctx, userRepo, name, adminCfg.Email, passwordHash, true)
+	if err != nil {
+		// 삭제를 요청한 사용자가 아직 이메일을 가지고 있는 경우
+		if errors.Is(err, domain.ErrAlreadyExists) {
+			logger.Warn("Bootstrap admin skipped, email is in use by a deleted user")
+			return nil
+		}
+		return fmt.Errorf("bootstrap admin: %w", err)
+	}
+
+	logger.Info("Bootstrap admin created, password change required on first login", slog.Int64("user_id", admin.ID))
+	return nil
+}
+
+// bootstrapPasswordHash 는 password_file, password_hash, password 순서로 먼저 지정된 값으로 비밀번호 해시를 만든다
+// 원문 비밀번호(password_file, password)에는 가입과 같은 비밀번호 정책을 적용한다
+func bootstrapPasswordHash(adminCfg config.BootstrapAdminConfig, hashParams *security.HashParams, policy *security.PasswordPolicy) (string, error) {
+	var password string
+	switch {
+	case adminCfg.PasswordFile != "":
+		var err error
+		if password, err = readPasswordFile(adminCfg.PasswordFile); err != nil {
+			return "", err
+		}
+	case adminCfg.PasswordHash != "":
+		if err := security.ValidatePasswordHash(adminCfg.PasswordHash); err != nil {
+			return "", fmt.Errorf("invalid password_hash: %w", err)
+		}
+		return adminCfg.PasswordHash, nil
+	case adminCfg.Password != "":
+		password = adminCfg.Password
+	default:
+		return "", errors.New("one of password_file, password_hash or password is required")
+	}
+
+	if err := checkPasswordPolicy(policy, password, adminCfg.Email); err != nil {
+		return "", err
+	}
+	return security.GeneratePasswordHash(password, hashParams)
+}
+
+// checkPasswordPolicy 는 관리자 비밀번호에 가입과 같은 비밀번호 정책을 적용한다
+func checkPasswordPolicy(policy *security.PasswordPolicy, password, email string) error {
+	violations := policy.Check(password, email)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(violations))
+	for _, v := range violations {
+		messages = append(messages, v.Message)
+	}
+	return fmt.Errorf("weak password: %s", strings.Join(messages, ", "))
+}
+
+// createAdmin 은 이메일 인증을 마친 관리자 계정을 만든다. 같은 이메일의 사용자가 있으면 ErrAlreadyExists 를 반환한다
+func createAdmin(ctx context.Context, userRepo domain.UserRepository, name, email, passwordHash string, passwordChangeRequired bool) (*domain.User, error) {
+	verifiedAt := time.Now()
+	return userRepo.Save(ctx, &domain.User{
+		Name:                   name,
+		Email:                  email,
+		Password:               passwordHash,
+		Roles:                  []string{domain.RoleAdmin},
+		VerifiedAt:             &verifiedAt,
+		PasswordChangeRequired: passwordChangeRequired,
+	})
+}
+
+// readPasswordFile 은 파일의 첫 줄을 비밀번호로 읽는다. path 가 - 이면 표준 입력에서 읽는다
+func readPasswordFile(path string) (string, error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to open password file: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	password, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("failed to read password file: %w", err)
+	}
+	password = strings.TrimRight(password, "\r\n")
+	if password == "" {
+		return "", errors.New("password file is empty")
+	}
+	return password, nil
+}
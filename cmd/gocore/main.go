@@ -16,18 +16,37 @@ import (
 
 	"github.com/nicewook/gocore/internal/config"
 	"github.com/nicewook/gocore/internal/db"
+	"github.com/nicewook/gocore/internal/domain"
 	"github.com/nicewook/gocore/internal/handler"
+	"github.com/nicewook/gocore/internal/mailer"
 	"github.com/nicewook/gocore/internal/middlewares"
+	"github.com/nicewook/gocore/internal/oidc"
+	"github.com/nicewook/gocore/internal/repository/memory"
 	repository "github.com/nicewook/gocore/internal/repository/postgres"
 	"github.com/nicewook/gocore/internal/usecase"
+	"github.com/nicewook/gocore/pkg/contextutil"
+	"github.com/nicewook/gocore/pkg/security"
 )
 
 func main() {
+	// 첫 인자가 관리 명령이면 서버 대신 명령을 실행한다 (예: gocore admin create -email ..., gocore migrate up)
+	if len(os.Args) > 1 {
+		if command, ok := commands[os.Args[1]]; ok {
+			if err := command(os.Args[2:]); err != nil {
+				log.Fatalf("%s: %v", os.Args[1], err)
+			}
+			return
+		}
+	}
+
 	app := fx.New(
 		fx.Provide(
 			NewConfig,
 			NewLogger,
 			NewDB,
+			NewKeyRing,
+			NewHashParams,
+			NewPasswordPolicy,
 			echo.New,
 		),
 		fx.Provide(
@@ -35,9 +54,23 @@ func main() {
 			repository.NewUserRepository,
 			repository.NewProductRepository,
 			repository.NewOrderRepository,
+			repository.NewRefreshTokenRepository,
+			repository.NewOneTimeTokenRepository,
+			repository.NewMFARepository,
+			repository.NewSessionRepository,
+			repository.NewAPIKeyRepository,
+			repository.NewOAuthClientRepository,
+			NewTokenRevocationRepository,
+			NewLoginAttemptRepository,
+			NewPermissionRepository,
+			NewMailer,
+			NewOIDCProvider,
 		),
 		fx.Provide(
 			usecase.NewAuthUseCase,
+			usecase.NewAPIKeyUseCase,
+			usecase.NewOAuthClientUseCase,
+			usecase.NewPermissionUseCase,
 			usecase.NewUserUseCase,
 			usecase.NewProductUseCase,
 			usecase.NewOrderUseCase,
@@ -48,28 +81,49 @@ func main() {
 			handler.NewUserHandler,
 			handler.NewProductHandler,
 			handler.NewOrderHandler,
+			handler.NewAdminHandler,
+			handler.NewMeHandler,
+			handler.NewAPIKeyHandler,
+			handler.NewOAuthHandler,
+			handler.NewOIDCHandler,
+			handler.NewWellKnownHandler,
 		),
-		fx.Invoke(StartServer),
+		fx.Invoke(BootstrapAdmin, StartServer, StartAccountEraser),
 	)
 
 	app.Run()
 }
 
+// commands 는 서버 대신 실행하는 관리 명령이다
+var commands = map[string]func(args []string) error{
+	"admin":   runAdmin,
+	"migrate": runMigrate,
+}
+
 func NewConfig() *config.Config {
 	env := flag.String("env", "dev", "Environment (dev, qa, stg, prod)")
 	flag.Parse()
 
+	cfg, err := loadConfig(*env)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return cfg
+}
+
+// loadConfig 는 환경 이름을 확인하고 해당 환경의 설정을 읽는다. 서버와 관리 명령이 함께 사용한다
+func loadConfig(env string) (*config.Config, error) {
 	validEnvs := map[string]bool{"dev": true, "qa": true, "stg": true, "prod": true}
-	if !validEnvs[*env] {
-		log.Fatalf("Invalid environment: %s. Valid environments are: dev, qa, stg, prod", *env)
+	if !validEnvs[env] {
+		return nil, fmt.Errorf("invalid environment: %s. Valid environments are: dev, qa, stg, prod", env)
 	}
 
-	cfg, err := config.LoadConfig(*env)
+	cfg, err := config.LoadConfig(env)
 	if err != nil {
-		log.Fatalf("Config load error: %v", err)
+		return nil, fmt.Errorf("config load error: %w", err)
 	}
-
-	return cfg
+	return cfg, nil
 }
 
 func NewLogger(cfg *config.Config) *slog.Logger {
@@ -118,6 +172,163 @@ func NewDB(lc fx.Lifecycle, cfg *config.Config) *sql.DB {
 	return dbConn
 }
 
+// NewKeyRing 은 설정의 JWT 키 목록과 iss/aud 로 토큰 서명/검증에 사용할 키 링을 만든다
+func NewKeyRing(cfg *config.Config) (*security.KeyRing, error) {
+	keyRing, err := newKeyRing(cfg.Secure.JWT)
+	if err != nil {
+		return nil, err
+	}
+	return keyRing.WithIssuer(cfg.Secure.JWT.Issuer, cfg.Secure.JWT.Audience), nil
+}
+
+func newKeyRing(jwtCfg config.JWTConfig) (*security.KeyRing, error) {
+	// keys 설정이 없으면 단일 키(private_key, public_key) 설정을 사용한다
+	if len(jwtCfg.Keys) == 0 {
+		signingKeyID := jwtCfg.SigningKeyID
+		if signingKeyID == "" {
+			signingKeyID = "default"
+		}
+		return security.NewKeyRing(signingKeyID, security.KeyPEM{
+			ID:         signingKeyID,
+			Algorithm:  jwtCfg.Algorithm,
+			PrivateKey: jwtCfg.PrivateKey,
+			PublicKey:  jwtCfg.PublicKey,
+		})
+	}
+
+	keys := make([]security.KeyPEM, 0, len(jwtCfg.Keys))
+	for _, k := range jwtCfg.Keys {
+		// 알고리즘을 교체하는 동안에는 키마다 다른 알고리즘을 지정할 수 있다
+		algorithm := k.Algorithm
+		if algorithm == "" {
+			algorithm = jwtCfg.Algorithm
+		}
+		keys = append(keys, security.KeyPEM{
+			ID:         k.ID,
+			Algorithm:  algorithm,
+			PrivateKey: k.PrivateKey,
+			PublicKey:  k.PublicKey,
+		})
+	}
+	return security.NewKeyRing(jwtCfg.SigningKeyID, keys...)
+}
+
+// NewHashParams 는 설정의 비밀번호 해싱 파라미터를 검증한다. 설정하지 않으면 기본값(nil)을 사용한다
+func NewHashParams(cfg *config.Config) (*security.HashParams, error) {
+	hashCfg := cfg.Secure.PasswordHash
+	if hashCfg.Time == 0 {
+		return nil, nil
+	}
+
+	params := &security.HashParams{
+		Time:    hashCfg.Time,
+		Memory:  hashCfg.MemoryKiB,
+		Threads: hashCfg.Threads,
+		KeyLen:  hashCfg.KeyLen,
+	}
+	if err := security.ValidateHashParams(params); err != nil {
+		return nil, fmt.Errorf("invalid password hash config: %w", err)
+	}
+	return params, nil
+}
+
+// NewPasswordPolicy 는 설정의 비밀번호 정책을 만들고, 유출된 비밀번호 목록 파일이 있으면 읽어둔다
+// password_policy 가 비어 있으면 nil(기본 정책)을 반환한다. min_length 만 빠진 경우에는 기본 최소 길이를 쓴다
+func NewPasswordPolicy(cfg *config.Config) (*security.PasswordPolicy, error) {
+	policyCfg := cfg.Secure.PasswordPolicy
+	if policyCfg == (config.PasswordPolicyConfig{}) {
+		return nil, nil
+	}
+	if policyCfg.MinLength == 0 {
+		policyCfg.MinLength = security.DefaultMinLength
+	}
+
+	policy := &security.PasswordPolicy{
+		MinLength:     policyCfg.MinLength,
+		MaxLength:     policyCfg.MaxLength,
+		RequireUpper:  policyCfg.RequireUpper,
+		RequireLower:  policyCfg.RequireLower,
+		RequireDigit:  policyCfg.RequireDigit,
+		RequireSymbol: policyCfg.RequireSymbol,
+		DisallowEmail: policyCfg.DisallowEmail,
+	}
+	if policyCfg.BreachedList != "" {
+		breached, err := security.LoadBreachedPasswords(policyCfg.BreachedList)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("Breached password list loaded: %d passwords", breached.Len())
+		policy.Breached = breached
+	}
+	return policy, nil
+}
+
+// NewMailer 는 설정에 따라 메일 발송 방식을 선택한다
+func NewMailer(cfg *config.Config) domain.Mailer {
+	switch strings.ToLower(cfg.Mail.Driver) {
+	case "file":
+		return mailer.NewFileMailer(cfg.Mail.From, cfg.Mail.Dir)
+	default:
+		return mailer.NewLogMailer(cfg.Mail.From)
+	}
+}
+
+// NewOIDCProvider 는 OIDC 로그인을 사용하면 외부 공급자 클라이언트를 만든다. 사용하지 않으면 nil 을 반환한다
+func NewOIDCProvider(cfg *config.Config) domain.OIDCProvider {
+	if !cfg.Secure.OIDC.Enabled {
+		return nil
+	}
+	return oidc.NewProvider(cfg.Secure.OIDC, nil)
+}
+
+// NewTokenRevocationRepository 는 설정에 따라 액세스 토큰 폐기 목록 저장소를 선택한다
+func NewTokenRevocationRepository(cfg *config.Config, dbConn *sql.DB) domain.TokenRevocationRepository {
+	switch strings.ToLower(cfg.Secure.JWT.RevocationStore) {
+	case "postgres":
+		return repository.NewTokenRevocationRepository(dbConn)
+	default:
+		return memory.NewTokenRevocationRepository()
+	}
+}
+
+// NewLoginAttemptRepository 는 설정에 따라 로그인 실패 기록 저장소를 선택한다
+func NewLoginAttemptRepository(cfg *config.Config, dbConn *sql.DB) domain.LoginAttemptRepository {
+	switch strings.ToLower(cfg.Secure.LoginThrottle.Store) {
+	case "postgres":
+		return repository.NewLoginAttemptRepository(dbConn)
+	default:
+		return memory.NewLoginAttemptRepository()
+	}
+}
+
+// NewPermissionRepository 는 설정에 따라 역할별 권한 매핑 저장소를 선택한다
+// config 저장소는 정의되지 않은 권한이 있으면 오타로 권한이 빠지지 않도록 시작하지 않는다
+func NewPermissionRepository(cfg *config.Config, dbConn *sql.DB) (domain.PermissionRepository, error) {
+	switch strings.ToLower(cfg.Secure.Permissions.Store) {
+	case "postgres":
+		// 테이블이 비어 있으면(처음 시작할 때) 기본 매핑으로 채운다
+		seeded, err := repository.SeedRolePermissions(context.Background(), dbConn, domain.DefaultRolePermissions)
+		if err != nil {
+			return nil, err
+		}
+		if seeded {
+			log.Printf("Role permissions seeded with the default mapping")
+		}
+		return repository.NewPermissionRepository(dbConn), nil
+	default:
+		rolePermissions := make(map[string][]string, len(cfg.Secure.Permissions.Roles))
+		for _, role := range cfg.Secure.Permissions.Roles {
+			for _, permission := range role.Permissions {
+				if !domain.IsValidPermission(permission) {
+					return nil, fmt.Errorf("unknown permission %q for role %q", permission, role.Role)
+				}
+			}
+			rolePermissions[role.Role] = append(rolePermissions[role.Role], role.Permissions...)
+		}
+		return memory.NewPermissionRepository(rolePermissions), nil
+	}
+}
+
 func StartServer(lc fx.Lifecycle, e *echo.Echo, cfg *config.Config) {
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
@@ -133,3 +344,39 @@ func StartServer(lc fx.Lifecycle, e *echo.Echo, cfg *config.Config) {
 		},
 	})
 }
+
+// StartAccountEraser 는 유예 기간이 지난 삭제된 계정의 개인정보를 주기적으로 지운다
+// 같은 계정을 여러 인스턴스가 동시에 지워도 결과가 같으므로 인스턴스마다 실행한다
+func StartAccountEraser(lc fx.Lifecycle, authUseCase domain.AuthUseCase, cfg *config.Config, logger *slog.Logger) {
+	interval := time.Duration(cfg.Secure.AccountDeletion.EraseIntervalMin) * time.Minute
+	if interval <= 0 {
+		interval = time.Hour
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx = contextutil.WithLogger(ctx, logger.With(slog.String("job", "account_eraser")))
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				ticker := time.NewTicker(interval)
+				defer ticker.Stop()
+				for {
+					if _, err := authUseCase.EraseDeletedUsers(ctx); err != nil && ctx.Err() == nil {
+						logger.Error("Failed to erase deleted users", slog.String("err", err.Error()))
+					}
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+			return nil
+		},
+	})
+}
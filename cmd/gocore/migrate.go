@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/nicewook/gocore/internal/db"
+)
+
+const migrateUsage = `usage: gocore migrate <command> [flags]
+  up      [-env dev]                                적용되지 않은 마이그레이션을 모두 적용한다
+  down    [-env dev] [-steps 1]                     최근에 적용한 마이그레이션부터 steps 개를 되돌린다
+  status  [-env dev]                                마이그레이션별 적용 여부를 출력한다
+  new     [-dir internal/db/migrations] <name>      다음 버전의 빈 up, down 파일을 만든다`
+
+// runMigrate 는 gocore migrate 하위 명령을 실행한다
+func runMigrate(args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	fs := flag.NewFlagSet("migrate "+args[0], flag.ContinueOnError)
+	env := fs.String("env", "dev", "Environment (dev, qa, stg, prod)")
+	steps := fs.Int("steps", 1, "down 에서 되돌릴 마이그레이션 수")
+	dir := fs.String("dir", "internal/db/migrations", "new 에서 파일을 만들 디렉터리")
+	if err := fs.Parse(args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	// 새 파일은 소스 디렉터리에 만들고, 다음 빌드부터 바이너리에 포함된다
+	if args[0] == "new" {
+		if fs.NArg() != 1 {
+			return errors.New(migrateUsage)
+		}
+		upPath, downPath, err := db.CreateMigration(*dir, fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Created %s\nCreated %s\n", upPath, downPath)
+		return nil
+	}
+
+	cfg, err := loadConfig(*env)
+	if err != nil {
+		return err
+	}
+	dbConn, err := db.Open(cfg)
+	if err != nil {
+		return err
+	}
+	defer dbConn.Close()
+
+	migrator, err := db.NewMigrator(dbConn, db.Migrations)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			fmt.Printf("Applied %04d_%s\n", migration.Version, migration.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("No pending migrations")
+		}
+		return err
+	case "down":
+		if *steps < 1 {
+			return errors.New("-steps must be at least 1")
+		}
+		reverted, err := migrator.Down(ctx, *steps)
+		for _, migration := range reverted {
+			fmt.Printf("Reverted %04d_%s\n", migration.Version, migration.Name)
+		}
+		if err == nil && len(reverted) == 0 {
+			fmt.Println("No applied migrations")
+		}
+		return err
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, status := range statuses {
+			name, appliedAt := status.Name, "pending"
+			if name == "" {
+				name = "(unknown, applied by a newer version)"
+			}
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Format("2006-01-02 15:04:05 MST")
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\n", status.Version, name, appliedAt)
+		}
+		return w.Flush()
+	default:
+		return errors.New(migrateUsage)
+	}
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/viper"
 )
@@ -10,6 +11,7 @@ type Config struct {
 	App    AppConfig    `mapstructure:"app"`
 	DB     DBConfig     `mapstructure:"db"`
 	Secure SecureConfig `mapstructure:"secure"`
+	Mail   MailConfig   `mapstructure:"mail"`
 }
 
 type AppConfig struct {
@@ -26,19 +28,52 @@ type DBConfig struct {
 	Password string `mapstructure:"password"`
 	DBName   string `mapstructure:"dbname"`
 	SSLMode  string `mapstructure:"sslmode"` // sslmode는 disable, require, verify-ca, verify-full 를 설정 가능
+
+	// 서버를 시작할 때 마이그레이션을 적용한다. false 이면 적용되지 않은 마이그레이션이 있을 때 시작하지 않는다
+	AutoMigrate bool `mapstructure:"auto_migrate"`
 }
 
 type SecureConfig struct {
-	CORSAllowOrigins []string  `mapstructure:"cors_allow_origins"`
-	JWT              JWTConfig `mapstructure:"jwt"`
+	CORSAllowOrigins  []string                `mapstructure:"cors_allow_origins"`
+	JWT               JWTConfig               `mapstructure:"jwt"`
+	PasswordReset     PasswordResetConfig     `mapstructure:"password_reset"`
+	EmailVerification EmailVerificationConfig `mapstructure:"email_verification"`
+	MFA               MFAConfig               `mapstructure:"mfa"`
+	LoginThrottle     LoginThrottleConfig     `mapstructure:"login_throttle"`
+	PasswordHash      PasswordHashConfig      `mapstructure:"password_hash"`
+	PasswordPolicy    PasswordPolicyConfig    `mapstructure:"password_policy"`
+	OAuth             OAuthConfig             `mapstructure:"oauth"`
+	OIDC              OIDCConfig              `mapstructure:"oidc"`
+	Impersonation     ImpersonationConfig     `mapstructure:"impersonation"`
+	AccountDeletion   AccountDeletionConfig   `mapstructure:"account_deletion"`
+	BootstrapAdmin    BootstrapAdminConfig    `mapstructure:"bootstrap_admin"`
+	Permissions       PermissionConfig        `mapstructure:"permissions"`
+}
+
+type PasswordResetConfig struct {
+	URL                string `mapstructure:"url"`                  // 메일에 포함할 재설정 페이지 주소. token 쿼리 파라미터가 붙는다
+	TokenExpirationMin int    `mapstructure:"token_expiration_min"` // 재설정 토큰 만료 시간 (분)
 }
 
 type JWTConfig struct {
-	PrivateKey           string       `mapstructure:"private_key"`            // RSA 개인키 (서명용)
-	PublicKey            string       `mapstructure:"public_key"`             // RSA 공개키 (검증용)
-	AccessExpirationMin  int          `mapstructure:"access_expiration_min"`  // Access Token 만료 시간 (분)
-	RefreshExpirationDay int          `mapstructure:"refresh_expiration_day"` // Refresh Token 만료 시간 (일)
-	Cookie               CookieConfig `mapstructure:"cookie"`                 // 쿠키 관련 설정
+	Algorithm            string         `mapstructure:"algorithm"`              // 서명 알고리즘 (RS256, ES256, EdDSA). 기본값 RS256
+	PrivateKey           string         `mapstructure:"private_key"`            // 개인키 PEM (서명용). keys 가 비어 있을 때만 사용
+	PublicKey            string         `mapstructure:"public_key"`             // 공개키 PEM (검증용). keys 가 비어 있을 때만 사용
+	SigningKeyID         string         `mapstructure:"signing_key_id"`         // 현재 서명에 사용하는 키의 ID (kid)
+	Issuer               string         `mapstructure:"issuer"`                 // 토큰 발급자 (iss). 모든 요청에서 검증
+	Audience             string         `mapstructure:"audience"`               // 토큰 대상 (aud). 모든 요청에서 검증
+	Keys                 []JWTKeyConfig `mapstructure:"keys"`                   // 검증 키 목록. 교체된 키는 private_key 없이 남겨둔다
+	AccessExpirationMin  int            `mapstructure:"access_expiration_min"`  // Access Token 만료 시간 (분)
+	RefreshExpirationDay int            `mapstructure:"refresh_expiration_day"` // Refresh Token 만료 시간 (일)
+	RevocationStore      string         `mapstructure:"revocation_store"`       // 액세스 토큰 폐기 목록 저장소 (memory, postgres)
+	Cookie               CookieConfig   `mapstructure:"cookie"`                 // 쿠키 관련 설정
+}
+
+type JWTKeyConfig struct {
+	ID         string `mapstructure:"id"`          // 키 ID (토큰 헤더의 kid)
+	Algorithm  string `mapstructure:"algorithm"`   // 키의 서명 알고리즘. 비어 있으면 jwt.algorithm 을 사용
+	PrivateKey string `mapstructure:"private_key"` // 개인키 PEM (서명용). 검증 전용 키는 비워둔다
+	PublicKey  string `mapstructure:"public_key"`  // 공개키 PEM (검증용)
 }
 
 type CookieConfig struct {
@@ -48,6 +83,113 @@ type CookieConfig struct {
 	Domain   string `mapstructure:"domain"`    // 쿠키 도메인
 }
 
+type EmailVerificationConfig struct {
+	URL                string `mapstructure:"url"`                  // 메일에 포함할 인증 주소. token 쿼리 파라미터가 붙는다
+	TokenExpirationMin int    `mapstructure:"token_expiration_min"` // 인증 토큰 만료 시간 (분)
+	ResendIntervalSec  int    `mapstructure:"resend_interval_sec"`  // 인증 메일 재발송 최소 간격 (초)
+	RequiredForLogin   bool   `mapstructure:"required_for_login"`   // 이메일 인증을 마치지 않은 사용자의 로그인 차단 여부
+}
+
+type MFAConfig struct {
+	Issuer                 string   `mapstructure:"issuer"`                   // 인증 앱에 표시되는 서비스 이름
+	ChallengeExpirationMin int      `mapstructure:"challenge_expiration_min"` // 로그인 중 MFA 토큰 만료 시간 (분)
+	RecoveryCodeCount      int      `mapstructure:"recovery_code_count"`      // 등록 시 발급하는 복구 코드 수
+	RequiredRoles          []string `mapstructure:"required_roles"`           // MFA 를 반드시 사용해야 하는 역할 (예: Admin)
+}
+
+// LoginThrottleConfig 는 로그인 실패 횟수 제한 설정이다. 허용 횟수가 0 이면 해당 기준의 제한을 사용하지 않는다
+type LoginThrottleConfig struct {
+	Store          string `mapstructure:"store"`            // 실패 기록 저장소 (memory, postgres)
+	MaxFailures    int    `mapstructure:"max_failures"`     // 이메일 기준 잠금 전 허용 실패 횟수
+	IPMaxFailures  int    `mapstructure:"ip_max_failures"`  // IP 기준 잠금 전 허용 실패 횟수
+	WindowMin      int    `mapstructure:"window_min"`       // 실패 횟수를 누적하는 기간 (분). 마지막 실패 후 이 기간이 지나면 초기화
+	BaseLockoutSec int    `mapstructure:"base_lockout_sec"` // 첫 잠금 시간 (초). 이후 실패할 때마다 두 배로 늘어난다
+	MaxLockoutMin  int    `mapstructure:"max_lockout_min"`  // 잠금 시간 상한 (분)
+}
+
+// PasswordHashConfig 는 비밀번호 해싱(Argon2id) 파라미터이다. time 이 0 이면 기본값을 사용한다
+// 값을 올리면 기존 사용자의 해시는 다음 로그인에 성공할 때 새 파라미터로 다시 해싱된다
+type PasswordHashConfig struct {
+	Time      uint32 `mapstructure:"time"`       // 반복 횟수
+	MemoryKiB uint32 `mapstructure:"memory_kib"` // 메모리 사용량 (KiB)
+	Threads   uint8  `mapstructure:"threads"`    // 병렬 스레드 수
+	KeyLen    uint32 `mapstructure:"key_len"`    // 해시 길이 (바이트)
+}
+
+// PasswordPolicyConfig 는 가입, 비밀번호 변경, 재설정에 적용하는 비밀번호 정책이다
+// 아무 항목도 설정하지 않으면 기본 정책(8자 이상, 128자 이하, 이메일 포함 금지)을 사용한다
+// 다른 항목만 설정하고 min_length 가 0 이면 최소 길이는 8자로 둔다
+type PasswordPolicyConfig struct {
+	MinLength     int    `mapstructure:"min_length"`     // 최소 길이 (문자 수)
+	MaxLength     int    `mapstructure:"max_length"`     // 최대 길이 (문자 수). 0 이면 제한 없음
+	RequireUpper  bool   `mapstructure:"require_upper"`  // 대문자 필수
+	RequireLower  bool   `mapstructure:"require_lower"`  // 소문자 필수
+	RequireDigit  bool   `mapstructure:"require_digit"`  // 숫자 필수
+	RequireSymbol bool   `mapstructure:"require_symbol"` // 특수문자 필수
+	DisallowEmail bool   `mapstructure:"disallow_email"` // 이메일 주소나 아이디 포함 금지
+	BreachedList  string `mapstructure:"breached_list"`  // 유출된 비밀번호 목록 파일 (한 줄에 하나). 비어 있으면 검사하지 않음
+}
+
+// OAuthConfig 는 서비스 간 호출용 client_credentials 토큰 설정이다
+type OAuthConfig struct {
+	ClientTokenExpirationMin int `mapstructure:"client_token_expiration_min"` // 서비스 토큰 만료 시간 (분). 0 이면 jwt.access_expiration_min
+}
+
+// OIDCConfig 는 외부 OpenID Connect 공급자(사내 IdP) 로그인 설정이다
+// 공급자의 엔드포인트와 서명 키는 {issuer}/.well-known/openid-configuration 에서 가져온다
+type OIDCConfig struct {
+	Enabled        bool     `mapstructure:"enabled"`         // OIDC 로그인 사용 여부
+	Issuer         string   `mapstructure:"issuer"`          // 공급자 주소. ID 토큰의 iss 와 같아야 한다
+	ClientID       string   `mapstructure:"client_id"`       // 공급자에 등록한 클라이언트 ID. ID 토큰의 aud 로 검증
+	ClientSecret   string   `mapstructure:"client_secret"`   // 공급자에 등록한 클라이언트 시크릿
+	RedirectURL    string   `mapstructure:"redirect_url"`    // 공급자에 등록한 콜백 주소 (/auth/oidc/callback)
+	Scopes         []string `mapstructure:"scopes"`          // 요청할 scope. 비어 있으면 openid email profile
+	AllowedDomains []string `mapstructure:"allowed_domains"` // 로그인을 허용하는 이메일 도메인. 비어 있으면 제한 없음
+	AutoProvision  bool     `mapstructure:"auto_provision"`  // 가입되지 않은 이메일이면 User 역할로 사용자를 만든다
+}
+
+// ImpersonationConfig 는 관리자가 사용자로 대리 로그인할 때 발급하는 토큰 설정이다
+type ImpersonationConfig struct {
+	TokenExpirationMin int `mapstructure:"token_expiration_min"` // 대리 로그인 토큰 만료 시간 (분). 0 이면 15분
+}
+
+// AccountDeletionConfig 는 사용자가 삭제를 요청한 계정의 개인정보를 지우는 설정이다
+// 주문 기록은 회계를 위해 남기고, 사용자 행의 이름, 이메일, 비밀번호만 익명화한다
+type AccountDeletionConfig struct {
+	GracePeriodDay   int `mapstructure:"grace_period_day"`   // 삭제 요청 후 개인정보를 지우기까지의 유예 기간 (일). 0 이면 30일
+	EraseIntervalMin int `mapstructure:"erase_interval_min"` // 유예 기간이 지난 계정을 찾아 지우는 주기 (분). 0 이면 60분
+}
+
+// BootstrapAdminConfig 는 서버를 시작할 때 만드는 첫 관리자 계정이다. enabled 가 false 이면 만들지 않는다
+// 비밀번호는 password_file, password_hash, password 순서로 먼저 지정된 값을 사용하며(평문에는 비밀번호 정책을 적용한다), 만든 계정은 처음 로그인할 때 비밀번호를 바꿔야 한다.
+// 같은 이메일의 사용자가 이미 있으면 아무것도 하지 않는다. 그 밖의 관리자는 gocore admin create 로 만든다
+type BootstrapAdminConfig struct {
+	Enabled      bool   `mapstructure:"enabled"`       // 첫 관리자 계정 생성 여부
+	Name         string `mapstructure:"name"`          // 이름. 비어 있으면 Admin
+	Email        string `mapstructure:"email"`         // 로그인 이메일
+	Password     string `mapstructure:"password"`      // 평문 비밀번호. 설정 파일 대신 GOCORE_SECURE_BOOTSTRAP_ADMIN_PASSWORD 로 지정한다
+	PasswordHash string `mapstructure:"password_hash"` // Argon2id 해시 ($argon2id$...). 평문을 전달하지 않을 때 사용
+	PasswordFile string `mapstructure:"password_file"` // 비밀번호가 담긴 파일 (예: /run/secrets/admin_password)
+}
+
+// PermissionConfig 는 역할별 권한 매핑 설정이다
+// viper 는 맵 키를 소문자로 바꾸므로 역할 이름을 키로 쓰지 않고 목록으로 설정한다
+type PermissionConfig struct {
+	Store string                 `mapstructure:"store"` // 매핑 저장소 (config, postgres)
+	Roles []RolePermissionConfig `mapstructure:"roles"` // store 가 config 일 때의 매핑. 비어 있으면 기본 매핑
+}
+
+type RolePermissionConfig struct {
+	Role        string   `mapstructure:"role"`        // 역할 이름 (예: Admin)
+	Permissions []string `mapstructure:"permissions"` // 역할에 부여할 권한 (예: orders:read:any)
+}
+
+type MailConfig struct {
+	Driver string `mapstructure:"driver"` // 메일 발송 방식 (log, file)
+	From   string `mapstructure:"from"`   // 보내는 사람 주소
+	Dir    string `mapstructure:"dir"`    // file 방식일 때 메일을 저장할 디렉터리
+}
+
 func LoadConfig(env string) (*Config, error) {
 
 	viper.SetConfigName(fmt.Sprintf("config.%s", env))
@@ -56,6 +198,11 @@ func LoadConfig(env string) (*Config, error) {
 
 	viper.SetConfigType("yaml")
 
+	// 설정 파일의 값은 GOCORE_ 로 시작하는 환경 변수로 덮어쓸 수 있다 (예: secure.jwt.private_key → GOCORE_SECURE_JWT_PRIVATE_KEY)
+	viper.SetEnvPrefix("GOCORE")
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	viper.AutomaticEnv()
+
 	if err := viper.ReadInConfig(); err != nil {
 		return nil, fmt.Errorf("failed to read config: %w", err)
 	}
@@ -1,18 +1,34 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"log"
 	"time"
 
 	_ "github.com/lib/pq"
 
 	"github.com/nicewook/gocore/internal/config"
-	"github.com/nicewook/gocore/internal/domain"
-	"github.com/nicewook/gocore/pkg/security"
 )
 
+// NewDBConnection 은 데이터베이스에 연결하고 스키마가 최신인지 확인한다
+// db.auto_migrate 가 true 이면 적용되지 않은 마이그레이션을 적용하고, false 이면 남아 있을 때 에러를 반환한다
 func NewDBConnection(cfg *config.Config) (*sql.DB, error) {
+	db, err := Open(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := migrateOnStart(db, cfg.DB.AutoMigrate); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// Open 은 데이터베이스에 연결한다. 스키마는 확인하지 않으므로 마이그레이션 명령에서 사용한다
+func Open(cfg *config.Config) (*sql.DB, error) {
 	// DSN(Data Source Name) 생성
 	dsn := fmt.Sprintf(
 		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
@@ -32,124 +48,42 @@ func NewDBConnection(cfg *config.Config) (*sql.DB, error) {
 
 	// 실제 연결을 테스트하여 연결 가능 여부 확인
 	if err := db.Ping(); err != nil {
+		db.Close()
 		return nil, fmt.Errorf(
 			"failed to ping database (host: %s, db: %s): %w",
 			cfg.DB.Host, cfg.DB.DBName, err,
 		)
 	}
 
-	if err := createUserTable(db); err != nil {
-		return nil, fmt.Errorf("failed to create users table: %w", err)
-	}
-
-	if err := createProductTable(db); err != nil {
-		return nil, fmt.Errorf("failed to create products table: %w", err)
-	}
-
-	if err := createOrderTable(db); err != nil {
-		return nil, fmt.Errorf("failed to create orders table: %w", err)
-	}
-
 	return db, nil
 }
 
-// DB 생성시에 User 테이블을 생성하는 함수(존재하지 않을 경우에는)
-func createUserTable(db *sql.DB) error {
-	const query = `
-		CREATE TABLE IF NOT EXISTS users (
-			id SERIAL PRIMARY KEY,
-			name VARCHAR(255) NOT NULL,
-			email VARCHAR(255) NOT NULL UNIQUE,
-			password VARCHAR(255) NOT NULL,
-			roles VARCHAR(255) DEFAULT 'User'
-		)
-	`
-
-	if _, err := db.Exec(query); err != nil {
-		return fmt.Errorf("failed to create users table: %w", err)
-	}
-
-	// 관리자 계정 생성 (테이블 존재 여부와 관계없이 실행)
-	if err := createAdminUser(db); err != nil {
-		return fmt.Errorf("failed to create admin user: %w", err)
-	}
-
-	return nil
-}
-
-func createProductTable(db *sql.DB) error {
-	const query = `
-		CREATE TABLE IF NOT EXISTS products (
-			id SERIAL PRIMARY KEY,
-			name VARCHAR(255) NOT NULL,
-            price_in_krw BIGINT NOT NULL,
-			UNIQUE (name)
-		)
-	`
-	if _, err := db.Exec(query); err != nil {
-		return fmt.Errorf("failed to create products table: %w", err)
-	}
-	return nil
-}
-
-func createOrderTable(db *sql.DB) error {
-	const query = `
-		CREATE TABLE IF NOT EXISTS orders (
-			id SERIAL PRIMARY KEY,
-			user_id INT NOT NULL,
-			product_id INT NOT NULL,
-			quantity INT NOT NULL,
-		    total_price_in_krw BIGINT NOT NULL,
-			created_at TIMESTAMP NOT NULL DEFAULT NOW(),
-			FOREIGN KEY (user_id) REFERENCES users(id),
-			FOREIGN KEY (product_id) REFERENCES products(id)
-		)
-	`
-	if _, err := db.Exec(query); err != nil {
-		return fmt.Errorf("failed to create orders table: %w", err)
-	}
-	return nil
-}
-
-// 관리자 계정 생성 함수
-func createAdminUser(db *sql.DB) error {
-
-	adminUser := domain.User{
-		Name:     "Admin",
-		Email:    "admin@gmail.com",
-		Password: "adminpassword",
-		Roles:    []string{domain.RoleAdmin},
-	}
-
-	// 관리자 계정이 이미 존재하는지 확인
-	var count int
-	err := db.QueryRow(
-		"SELECT COUNT(*) FROM users WHERE email = $1",
-		adminUser.Email).
-		Scan(&count)
+// migrateOnStart 는 서버를 시작할 때 마이그레이션을 적용하거나, 적용되지 않은 마이그레이션이 없는지 확인한다
+// 여러 인스턴스가 동시에 시작해도 advisory lock 으로 한 곳에서만 적용한다
+func migrateOnStart(db *sql.DB, autoMigrate bool) error {
+	ctx := context.Background()
+	migrator, err := NewMigrator(db, Migrations)
 	if err != nil {
-		return fmt.Errorf("failed to check if admin user exists: %w", err)
+		return err
 	}
 
-	if count > 0 {
+	if !autoMigrate {
+		pending, err := migrator.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if len(pending) > 0 {
+			return fmt.Errorf("database schema is out of date: %d pending migrations, run `gocore migrate up`", len(pending))
+		}
 		return nil
 	}
 
-	// 관리자 비밀번호 해싱
-	hashedPassword, err := security.GeneratePasswordHash(adminUser.Password, nil)
+	applied, err := migrator.Up(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to hash admin password: %w", err)
+		return err
 	}
-	adminUser.Password = hashedPassword
-
-	// 관리자 계정 생성
-	_, err = db.Exec(
-		"INSERT INTO users (name, email, password, roles) VALUES ($1, $2, $3, $4)",
-		adminUser.Name, adminUser.Email, adminUser.Password, adminUser.RolesToString(),
-	)
-	if err != nil {
-		return fmt.Errorf("failed to create admin user: %w", err)
+	for _, migration := range applied {
+		log.Printf("Migration applied: %04d_%s", migration.Version, migration.Name)
 	}
-
 	return nil
 }
@@ -0,0 +1,103 @@
+package db
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	tests := []struct {
+		name      string
+		files     fstest.MapFS
+		expected  []int64
+		expectErr bool
+	}{
+		{
+			name: "버전 순서로 정렬",
+			files: fstest.MapFS{
+				"0010_add_index.up.sql":        {Data: []byte("CREATE INDEX")},
+				"0010_add_index.down.sql":      {Data: []byte("DROP INDEX")},
+				"0002_add_column.up.sql":       {Data: []byte("ALTER TABLE")},
+				"0002_add_column.down.sql":     {Data: []byte("ALTER TABLE")},
+				"README.md":                    {Data: []byte("sql 파일이 아니면 무시한다")},
+				"0001_initial_schema.up.sql":   {Data: []byte("CREATE TABLE")},
+				"0001_initial_schema.down.sql": {Data: []byte("DROP TABLE")},
+			},
+			expected: []int64{1, 2, 10},
+		},
+		{
+			name: "down 파일 누락",
+			files: fstest.MapFS{
+				"0001_initial_schema.up.sql": {Data: []byte("CREATE TABLE")},
+			},
+			expectErr: true,
+		},
+		{
+			name: "같은 버전의 다른 이름",
+			files: fstest.MapFS{
+				"0001_a.up.sql":   {Data: []byte("CREATE TABLE a")},
+				"0001_a.down.sql": {Data: []byte("DROP TABLE a")},
+				"0001_b.up.sql":   {Data: []byte("CREATE TABLE b")},
+				"0001_b.down.sql": {Data: []byte("DROP TABLE b")},
+			},
+			expectErr: true,
+		},
+		{
+			name: "잘못된 파일 이름",
+			files: fstest.MapFS{
+				"initial.sql": {Data: []byte("CREATE TABLE")},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrations, err := LoadMigrations(tt.files)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			versions := make([]int64, 0, len(migrations))
+			for _, migration := range migrations {
+				versions = append(versions, migration.Version)
+			}
+			assert.Equal(t, tt.expected, versions)
+		})
+	}
+}
+
+// 바이너리에 포함된 마이그레이션 파일은 항상 읽을 수 있어야 한다
+func TestEmbeddedMigrations(t *testing.T) {
+	migrations, err := LoadMigrations(Migrations)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, migrations)
+	assert.Equal(t, int64(1), migrations[0].Version)
+}
+
+func TestCreateMigration(t *testing.T) {
+	dir := t.TempDir()
+
+	upPath, downPath, err := CreateMigration(dir, "Add User Phone")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "0001_add_user_phone.up.sql"), upPath)
+	assert.Equal(t, filepath.Join(dir, "0001_add_user_phone.down.sql"), downPath)
+
+	// 다음 파일은 마지막 버전 다음 번호를 사용한다
+	upPath, _, err = CreateMigration(dir, "add-order-index")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "0002_add_order_index.up.sql"), upPath)
+
+	migrations, err := LoadMigrations(os.DirFS(dir))
+	assert.NoError(t, err)
+	assert.Len(t, migrations, 2)
+
+	_, _, err = CreateMigration(dir, "!!!")
+	assert.Error(t, err)
+}
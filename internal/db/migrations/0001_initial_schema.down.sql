@@ -0,0 +1,17 @@
+-- 모든 테이블을 삭제한다. 데이터도 함께 지워지므로 개발 환경에서만 사용한다
+DROP TABLE IF EXISTS role_permissions;
+DROP TABLE IF EXISTS oauth_clients;
+DROP TABLE IF EXISTS api_keys;
+DROP TABLE IF EXISTS login_attempts;
+DROP TABLE IF EXISTS mfa_recovery_codes;
+DROP TABLE IF EXISTS user_mfa;
+DROP TABLE IF EXISTS one_time_tokens;
+DROP TABLE IF EXISTS user_token_revocations;
+DROP TABLE IF EXISTS revoked_tokens;
+DROP TABLE IF EXISTS sessions;
+DROP TABLE IF EXISTS refresh_tokens;
+DROP TABLE IF EXISTS orders;
+DROP TABLE IF EXISTS products;
+DROP TABLE IF EXISTS user_roles;
+DROP TABLE IF EXISTS roles;
+DROP TABLE IF EXISTS users;
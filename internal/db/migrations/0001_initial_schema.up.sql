@@ -0,0 +1,191 @@
+-- 마이그레이션 도입 전의 스키마. 이전 버전이 CREATE TABLE IF NOT EXISTS 로 만든 데이터베이스에도 적용할 수 있도록
+-- 모든 문장은 이미 있는 테이블, 컬럼, 인덱스를 건너뛴다. 이후의 변경은 새 마이그레이션으로 추가한다
+
+CREATE TABLE IF NOT EXISTS users (
+	id SERIAL PRIMARY KEY,
+	name VARCHAR(255) NOT NULL,
+	email VARCHAR(255) NOT NULL UNIQUE,
+	password VARCHAR(255) NOT NULL,
+	verified_at TIMESTAMPTZ,
+	disabled_at TIMESTAMPTZ,
+	deleted_at TIMESTAMPTZ,
+	erased_at TIMESTAMPTZ,
+	password_change_required BOOLEAN NOT NULL DEFAULT FALSE
+);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS verified_at TIMESTAMPTZ;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS disabled_at TIMESTAMPTZ;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS erased_at TIMESTAMPTZ;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS password_change_required BOOLEAN NOT NULL DEFAULT FALSE;
+-- 개인정보를 지울 사용자를 찾는 작업에서 사용한다
+CREATE INDEX IF NOT EXISTS idx_users_pending_erasure ON users (deleted_at) WHERE deleted_at IS NOT NULL AND erased_at IS NULL;
+
+-- 역할과 사용자별 역할
+CREATE TABLE IF NOT EXISTS roles (
+	name VARCHAR(50) PRIMARY KEY
+);
+INSERT INTO roles (name) VALUES ('Admin'), ('Manager'), ('User') ON CONFLICT DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS user_roles (
+	user_id INT NOT NULL,
+	role VARCHAR(50) NOT NULL,
+	granted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (user_id, role),
+	FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+	FOREIGN KEY (role) REFERENCES roles(name)
+);
+CREATE INDEX IF NOT EXISTS idx_user_roles_role ON user_roles (role);
+
+-- 이전 버전의 users.roles(쉼표로 구분한 문자열) 컬럼이 남아 있으면 user_roles 로 옮기고 컬럼을 삭제한다
+-- 기본 역할이 아닌 값도 잃지 않도록 roles 에 등록한다
+DO $$
+BEGIN
+	IF EXISTS (
+		SELECT 1 FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = 'users' AND column_name = 'roles'
+	) THEN
+		CREATE TEMP TABLE csv_roles ON COMMIT DROP AS
+		SELECT u.id AS user_id, TRIM(r.role) AS role
+		FROM users u, UNNEST(STRING_TO_ARRAY(u.roles, ',')) AS r(role)
+		WHERE TRIM(r.role) <> '';
+
+		INSERT INTO roles (name)
+		SELECT DISTINCT role FROM csv_roles
+		ON CONFLICT DO NOTHING;
+
+		INSERT INTO user_roles (user_id, role)
+		SELECT DISTINCT user_id, role FROM csv_roles
+		ON CONFLICT DO NOTHING;
+
+		ALTER TABLE users DROP COLUMN roles;
+	END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS products (
+	id SERIAL PRIMARY KEY,
+	name VARCHAR(255) NOT NULL,
+	price_in_krw BIGINT NOT NULL,
+	UNIQUE (name)
+);
+
+CREATE TABLE IF NOT EXISTS orders (
+	id SERIAL PRIMARY KEY,
+	user_id INT NOT NULL,
+	product_id INT NOT NULL,
+	quantity INT NOT NULL,
+	total_price_in_krw BIGINT NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+	FOREIGN KEY (user_id) REFERENCES users(id),
+	FOREIGN KEY (product_id) REFERENCES products(id)
+);
+
+-- 리프레시 토큰. 토큰 원문이 아닌 해시를 저장하고, family_id 로 교체 체인을 묶는다
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+	id SERIAL PRIMARY KEY,
+	user_id INT NOT NULL,
+	family_id UUID NOT NULL,
+	token_hash VARCHAR(64) NOT NULL UNIQUE,
+	expires_at TIMESTAMPTZ NOT NULL,
+	rotated_at TIMESTAMPTZ,
+	revoked_at TIMESTAMPTZ,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	FOREIGN KEY (user_id) REFERENCES users(id)
+);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON refresh_tokens (family_id);
+
+-- 로그인한 기기별 세션. 세션 ID 가 리프레시 토큰의 family_id 이다
+CREATE TABLE IF NOT EXISTS sessions (
+	id UUID PRIMARY KEY,
+	user_id INT NOT NULL,
+	user_agent TEXT NOT NULL DEFAULT '',
+	ip VARCHAR(45) NOT NULL DEFAULT '',
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	last_used_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	expires_at TIMESTAMPTZ NOT NULL,
+	FOREIGN KEY (user_id) REFERENCES users(id)
+);
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions (user_id);
+
+-- 액세스 토큰 폐기 목록. jti 단위 폐기와 사용자 단위(기준 시각 이전 발급분) 폐기를 저장한다
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+	jti VARCHAR(36) PRIMARY KEY,
+	expires_at TIMESTAMPTZ NOT NULL
+);
+CREATE TABLE IF NOT EXISTS user_token_revocations (
+	user_id INT PRIMARY KEY,
+	revoked_before TIMESTAMPTZ NOT NULL,
+	expires_at TIMESTAMPTZ NOT NULL,
+	FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+-- 비밀번호 재설정 등 메일로 전달하는 일회용 토큰. 토큰 원문이 아닌 해시를 저장한다
+CREATE TABLE IF NOT EXISTS one_time_tokens (
+	id SERIAL PRIMARY KEY,
+	user_id INT NOT NULL,
+	purpose VARCHAR(32) NOT NULL,
+	token_hash VARCHAR(64) NOT NULL UNIQUE,
+	expires_at TIMESTAMPTZ NOT NULL,
+	used_at TIMESTAMPTZ,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	FOREIGN KEY (user_id) REFERENCES users(id)
+);
+CREATE INDEX IF NOT EXISTS idx_one_time_tokens_user_id ON one_time_tokens (user_id, purpose);
+
+-- TOTP 2단계 인증 설정과 복구 코드. 복구 코드는 원문이 아닌 해시를 저장한다
+CREATE TABLE IF NOT EXISTS user_mfa (
+	user_id INT PRIMARY KEY,
+	secret VARCHAR(64) NOT NULL,
+	enabled_at TIMESTAMPTZ,
+	last_used_step BIGINT NOT NULL DEFAULT 0,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	FOREIGN KEY (user_id) REFERENCES users(id)
+);
+CREATE TABLE IF NOT EXISTS mfa_recovery_codes (
+	id SERIAL PRIMARY KEY,
+	user_id INT NOT NULL,
+	code_hash VARCHAR(255) NOT NULL,
+	used_at TIMESTAMPTZ,
+	FOREIGN KEY (user_id) REFERENCES users(id)
+);
+CREATE INDEX IF NOT EXISTS idx_mfa_recovery_codes_user_id ON mfa_recovery_codes (user_id);
+
+-- 로그인 실패 기록. key 는 "email:<이메일>" 또는 "ip:<IP>" 형식이다
+CREATE TABLE IF NOT EXISTS login_attempts (
+	key VARCHAR(320) PRIMARY KEY,
+	failures INT NOT NULL,
+	last_failure_at TIMESTAMPTZ NOT NULL,
+	locked_until TIMESTAMPTZ,
+	expires_at TIMESTAMPTZ NOT NULL
+);
+
+-- 개인 API 키. 키 원문이 아닌 해시를 저장하며, scopes 는 쉼표로 구분한다
+CREATE TABLE IF NOT EXISTS api_keys (
+	id SERIAL PRIMARY KEY,
+	user_id INT NOT NULL,
+	name VARCHAR(100) NOT NULL,
+	prefix VARCHAR(16) NOT NULL,
+	key_hash VARCHAR(64) NOT NULL UNIQUE,
+	scopes TEXT NOT NULL,
+	expires_at TIMESTAMPTZ,
+	last_used_at TIMESTAMPTZ,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	FOREIGN KEY (user_id) REFERENCES users(id)
+);
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys (user_id);
+
+-- client_credentials 로 토큰을 발급받는 OAuth 클라이언트. 시크릿은 해시를 저장한다
+CREATE TABLE IF NOT EXISTS oauth_clients (
+	id SERIAL PRIMARY KEY,
+	client_id VARCHAR(64) NOT NULL UNIQUE,
+	name VARCHAR(100) NOT NULL,
+	secret_hash VARCHAR(64) NOT NULL,
+	scopes TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- 역할별 권한 매핑. permissions.store 가 postgres 일 때 사용하며, 비어 있으면 서버를 시작할 때 기본 매핑(domain.DefaultRolePermissions)으로 채운다
+CREATE TABLE IF NOT EXISTS role_permissions (
+	role VARCHAR(50) NOT NULL,
+	permission VARCHAR(100) NOT NULL,
+	PRIMARY KEY (role, permission)
+);
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// APIKey 는 사용자가 배치 작업 같은 기계 클라이언트를 위해 발급한 개인 API 키이다.
+// 키 원문은 발급 응답에서만 확인할 수 있고, 서버에는 해시만 저장한다.
+type APIKey struct {
+	ID         int64      `json:"id"`
+	UserID     int64      `json:"-"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"` // 목록에서 키를 구분하기 위한 원문의 앞부분
+	KeyHash    string     `json:"-"`
+	Scopes     []string   `json:"scopes"` // 키로 사용할 수 있는 역할. 발급 시 사용자 역할의 부분집합이어야 한다
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// IsExpired checks if the key has an expiry that has passed
+func (k *APIKey) IsExpired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+// StringToScopes 는 쉼표로 구분해 저장한 scope 를 목록으로 바꾼다
+// 빈 항목은 건너뛰므로 scope 가 없으면 빈 목록을 반환한다 (빈 문자열 scope 가 섞이지 않는다)
+func StringToScopes(scopesStr string) []string {
+	scopes := []string{}
+	for _, scope := range strings.Split(scopesStr, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name" validate:"required,min=1,max=100"`
+	Scopes    []string   `json:"scopes"` // 비어 있으면 사용자의 모든 역할
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type CreateAPIKeyResponse struct {
+	*APIKey
+	Key string `json:"key"` // 이 응답에서만 확인할 수 있다
+}
+
+type APIKeyRepository interface {
+	Save(ctx context.Context, key *APIKey) (*APIKey, error)
+	GetByHash(ctx context.Context, keyHash string) (*APIKey, error)
+	ListByUserID(ctx context.Context, userID int64) ([]*APIKey, error)
+	// Delete 는 사용자의 API 키를 삭제한다. 사용자의 키가 아니면 ErrNotFound 를 반환한다
+	Delete(ctx context.Context, userID int64, id int64) error
+	// TouchLastUsed 는 마지막 사용 시각을 기록한다
+	TouchLastUsed(ctx context.Context, id int64) error
+}
+
+type APIKeyUseCase interface {
+	Create(ctx context.Context, userID int64, req *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	List(ctx context.Context, userID int64) ([]*APIKey, error)
+	Revoke(ctx context.Context, userID int64, id int64) error
+	// Authenticate 는 키 원문을 검증하고 키의 사용자를 반환한다.
+	// 반환된 사용자의 Roles 는 키의 scope 중 사용자가 현재도 가지고 있는 역할로 제한된다
+	Authenticate(ctx context.Context, rawKey string) (*User, *APIKey, error)
+}
@@ -9,9 +9,10 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+// 비밀번호 길이 등은 설정의 비밀번호 정책으로 검사하여 사유를 함께 반환한다
 type SignUpRequest struct {
 	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password" validate:"required,min=8"`
+	Password string `json:"password" validate:"required"`
 }
 
 // Validate는 기본 validator 이후에 실행될 커스텀 유효성 검사를 수행합니다
@@ -42,9 +43,76 @@ type LoginRequest struct {
 type LoginResponse struct {
 	ID                     int64     `json:"id"`
 	Email                  string    `json:"email"`
-	AccessToken            string    `json:"access_token"`
+	AccessToken            string    `json:"access_token,omitempty"`
 	RefreshToken           string    `json:"-"` // Not included in JSON response
 	RefreshTokenExpiration time.Time `json:"-"` // Not included in JSON response
+
+	// MFA 가 필요한 경우 토큰 대신 MFAToken 을 반환하며, /auth/login/mfa 에서 코드와 함께 토큰으로 교환한다
+	MFARequired           bool     `json:"mfa_required,omitempty"`
+	MFAEnrollmentRequired bool     `json:"mfa_enrollment_required,omitempty"` // MFA 가 필수인 역할인데 아직 등록하지 않은 경우
+	MFAToken              string   `json:"mfa_token,omitempty"`
+	RecoveryCodes         []string `json:"recovery_codes,omitempty"` // 로그인 중 등록을 마친 경우에만 포함
+
+	// 비밀번호를 바꿔야 하는 경우 토큰 대신 재설정 토큰을 반환하며, /auth/password/reset 에서 새 비밀번호와 함께 사용한다
+	PasswordChangeRequired bool   `json:"password_change_required,omitempty"`
+	PasswordChangeToken    string `json:"password_change_token,omitempty"`
+}
+
+// LogoutRequest represents the tokens to invalidate on logout
+type LogoutRequest struct {
+	UserID               int64     // 액세스 토큰의 사용자 ID
+	ActorID              int64     // 대리 로그인 토큰이면 관리자의 사용자 ID
+	AccessTokenID        string    // 액세스 토큰의 jti
+	AccessTokenExpiresAt time.Time // 액세스 토큰의 만료 시각
+	RefreshToken         string    // 쿠키로 전달된 리프레시 토큰 (없을 수 있음)
+}
+
+// ImpersonationResponse 는 관리자가 사용자로 대리 로그인할 때 발급하는 토큰이다
+// 리프레시 토큰 없이 짧은 시간 동안만 유효하며, 토큰의 act claim 에 관리자의 ID 가 담긴다
+type ImpersonationResponse struct {
+	AccessToken string    `json:"access_token"`
+	TokenType   string    `json:"token_type"`
+	ExpiresIn   int       `json:"expires_in"` // 초
+	ExpiresAt   time.Time `json:"expires_at"`
+	UserID      int64     `json:"user_id"`
+	Email       string    `json:"email"`
+	ActorID     int64     `json:"actor_id"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ResendVerificationRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `query:"token" validate:"required"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required"`
+}
+
+// PasswordPolicyError 는 비밀번호가 정책을 충족하지 못한 사유 목록이다
+// errors.Is(err, ErrWeakPassword) 로 확인하고, 사유는 클라이언트에 그대로 보여줄 수 있다
+type PasswordPolicyError struct {
+	Reasons []PasswordPolicyReason
+}
+
+type PasswordPolicyReason struct {
+	Code    string `json:"code"`    // 예: too_short, breached. 클라이언트에서 메시지를 번역할 때 사용
+	Message string `json:"message"` // 영문 설명
+}
+
+func (e *PasswordPolicyError) Error() string {
+	return ErrWeakPassword.Error()
+}
+
+func (e *PasswordPolicyError) Unwrap() error {
+	return ErrWeakPassword
 }
 
 type AuthRepository interface {
@@ -54,6 +122,61 @@ type AuthRepository interface {
 type AuthUseCase interface {
 	SignUpUser(ctx context.Context, user *User) (*User, error)
 	Login(ctx context.Context, email, password string) (*LoginResponse, error)
-	Logout(ctx context.Context, userID int64) error
+	Logout(ctx context.Context, req *LogoutRequest) error
 	RefreshToken(ctx context.Context, refreshToken string) (*LoginResponse, error)
+	RevokeUserTokens(ctx context.Context, userID int64) error
+	// UnlockUser 는 로그인 실패로 잠긴 사용자의 실패 기록과 잠금을 해제한다
+	UnlockUser(ctx context.Context, userID int64) error
+	// Impersonate 는 요청한 관리자가 사용자로 대리 로그인할 수 있는 짧은 액세스 토큰을 발급한다
+	Impersonate(ctx context.Context, userID int64) (*ImpersonationResponse, error)
+	// DisableUser 는 사용자를 비활성화하고 모든 토큰을 폐기한다
+	DisableUser(ctx context.Context, userID int64) error
+	// EnableUser 는 비활성화된 사용자를 다시 활성화한다
+	EnableUser(ctx context.Context, userID int64) error
+	// RestoreUser 는 삭제를 요청했지만 아직 개인정보가 지워지지 않은 사용자를 복구한다
+	RestoreUser(ctx context.Context, userID int64) error
+	// DeleteAccount 는 비밀번호를 확인하고 계정을 삭제 상태로 바꾼다. 유예 기간이 지나면 EraseDeletedUsers 가 개인정보를 지운다
+	DeleteAccount(ctx context.Context, userID int64, req *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	// EraseDeletedUsers 는 유예 기간이 지난 삭제된 사용자의 개인정보를 지우고 지운 사용자 수를 반환한다
+	EraseDeletedUsers(ctx context.Context) (int, error)
+	// GetRoles 는 부여할 수 있는 역할 목록을 반환한다
+	GetRoles(ctx context.Context) ([]string, error)
+	// GrantRole 은 사용자에게 역할을 부여하고, 역할이 바뀌었으면 사용자의 모든 토큰을 폐기한다
+	GrantRole(ctx context.Context, userID int64, role string) (*User, error)
+	// RevokeRole 은 사용자의 역할을 회수하고 사용자의 모든 토큰을 폐기한다
+	RevokeRole(ctx context.Context, userID int64, role string) (*User, error)
+	// ForgotPassword 는 비밀번호 재설정 링크를 메일로 보낸다. 가입되지 않은 이메일이어도 에러를 반환하지 않는다
+	ForgotPassword(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, req *ResetPasswordRequest) error
+	VerifyEmail(ctx context.Context, token string) error
+	// ResendVerification 은 인증 메일을 다시 보낸다. 가입되지 않았거나 이미 인증된 이메일이어도 에러를 반환하지 않는다
+	ResendVerification(ctx context.Context, email string) error
+	// EnrollMFA 는 새 TOTP 비밀키를 발급한다. ConfirmMFA 로 확인하기 전까지는 로그인에 적용되지 않는다
+	EnrollMFA(ctx context.Context, userID int64) (*MFAEnrollResponse, error)
+	ConfirmMFA(ctx context.Context, userID int64, code string) (*MFAConfirmResponse, error)
+	DisableMFA(ctx context.Context, userID int64, code string) error
+	// EnrollMFAWithChallenge 는 MFA 가 필수인데 등록하지 않은 사용자가 로그인 중에 등록을 시작할 때 사용한다
+	EnrollMFAWithChallenge(ctx context.Context, mfaToken string) (*MFAEnrollResponse, error)
+	// LoginMFA 는 MFA 토큰과 코드를 확인하고 토큰을 발급한다
+	LoginMFA(ctx context.Context, req *MFALoginRequest) (*LoginResponse, error)
+	// UpdateProfile 은 사용자의 이름과 이메일을 수정한다. 이메일을 바꾸려면 현재 비밀번호가 필요하고, 바꾸면 다시 인증해야 한다
+	UpdateProfile(ctx context.Context, userID int64, req *UpdateProfileRequest) (*User, error)
+	// ChangePassword 는 현재 비밀번호를 확인하고 비밀번호를 바꾼다. 다른 세션은 모두 종료되고 요청한 세션에는 새 토큰을 발급한다
+	ChangePassword(ctx context.Context, userID int64, req *ChangePasswordRequest) (*LoginResponse, error)
+	// ListSessions 는 사용자의 활성 세션을 반환한다. currentSessionID 에 해당하는 세션은 Current 로 표시한다
+	ListSessions(ctx context.Context, userID int64, currentSessionID string) ([]*Session, error)
+	// RevokeSession 은 세션을 삭제하고 해당 세션의 리프레시 토큰을 폐기한다
+	RevokeSession(ctx context.Context, userID int64, sessionID string) error
+	// RevokeOtherSessions 는 currentSessionID 를 제외한 모든 세션을 종료시킨다
+	RevokeOtherSessions(ctx context.Context, userID int64, currentSessionID string) error
+	// ClientCredentialsToken 은 OAuth 클라이언트를 인증하고 서비스용 액세스 토큰을 발급한다 (client_credentials)
+	ClientCredentialsToken(ctx context.Context, clientID, clientSecret, scope string) (*TokenResponse, error)
+	// IntrospectToken 은 클라이언트를 인증하고 토큰이 지금 유효한지(폐기되지 않았고 주체가 남아 있는지) 확인한다 (RFC 7662)
+	IntrospectToken(ctx context.Context, clientID, clientSecret, token string) (*IntrospectionResponse, error)
+	// UserInfo 는 사용자의 현재 프로필을 OIDC userinfo 형식으로 반환한다
+	UserInfo(ctx context.Context, userID int64) (*UserInfoResponse, error)
+	// StartOIDCLogin 은 외부 공급자 로그인 페이지 주소와 state, nonce, PKCE verifier 를 만든다
+	StartOIDCLogin(ctx context.Context) (*OIDCAuthRequest, error)
+	// LoginOIDC 는 인가 코드를 검증하고, 이메일로 사용자를 찾거나 만든 뒤 토큰을 발급한다
+	LoginOIDC(ctx context.Context, code, codeVerifier, nonce string) (*LoginResponse, error)
 }
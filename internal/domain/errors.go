@@ -8,4 +8,29 @@ var (
 	ErrInvalidInput  = errors.New("invalid input")
 	ErrInternal      = errors.New("internal error")
 	ErrUnauthorized  = errors.New("unauthorized access")
+	ErrForbidden     = errors.New("access to this resource is not allowed")
+	ErrUnknownRole   = errors.New("unknown role")
+	ErrInvalidToken  = errors.New("invalid or expired token")
+
+	ErrEmailNotVerified   = errors.New("email not verified")
+	ErrAccountDisabled    = errors.New("account is disabled")
+	ErrInvalidCredentials = errors.New("invalid email or password")
+	ErrTooManyAttempts    = errors.New("too many failed login attempts, try again later")
+	ErrWeakPassword       = errors.New("password does not meet policy")
+	ErrIncorrectPassword  = errors.New("current password is incorrect")
+	ErrImpersonated       = errors.New("not allowed while impersonating a user")
+
+	ErrInvalidMFACode      = errors.New("invalid mfa code")
+	ErrMFAAlreadyEnabled   = errors.New("mfa already enabled")
+	ErrMFAEnrollmentNeeded = errors.New("mfa enrollment not started")
+
+	ErrInvalidAPIKey = errors.New("invalid or expired api key")
+	ErrInvalidScope  = errors.New("requested scope is not allowed")
+
+	ErrInvalidClient        = errors.New("invalid client credentials")
+	ErrUnsupportedGrantType = errors.New("unsupported grant type")
+
+	ErrOIDCDisabled       = errors.New("oidc login is not enabled")
+	ErrOIDCLoginFailed    = errors.New("oidc login failed")
+	ErrOIDCNotProvisioned = errors.New("no account is linked to this identity")
 )
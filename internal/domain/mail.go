@@ -0,0 +1,15 @@
+package domain
+
+import "context"
+
+// Mail 은 사용자에게 보내는 메일이다
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer 는 메일 발송 방식을 추상화한다. 개발/테스트 환경에서는 로그나 파일로 대신 기록한다
+type Mailer interface {
+	Send(ctx context.Context, mail *Mail) error
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// MFA 는 사용자의 TOTP 2단계 인증 설정이다.
+// 등록을 시작하면 비밀키가 저장되고, 인증 앱의 코드로 확인을 마쳐야 EnabledAt 이 기록되어 로그인에 적용된다.
+type MFA struct {
+	UserID       int64
+	Secret       string     // base32 로 인코딩된 TOTP 비밀키
+	EnabledAt    *time.Time // 등록 확인 시각. 확인 전이면 nil
+	LastUsedStep int64      // 마지막으로 사용된 TOTP 주기. 같은 코드의 재사용을 막는다
+	CreatedAt    time.Time
+}
+
+// IsEnabled checks if the enrollment has been confirmed
+func (m *MFA) IsEnabled() bool {
+	return m.EnabledAt != nil
+}
+
+// RecoveryCode 는 인증 앱을 사용할 수 없을 때 한 번 사용할 수 있는 복구 코드이다.
+// 코드 원문은 저장하지 않고 GeneratePasswordHash 로 해싱한 값만 저장한다.
+type RecoveryCode struct {
+	ID       int64
+	UserID   int64
+	CodeHash string
+	UsedAt   *time.Time
+}
+
+type MFAEnrollResponse struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"` // otpauth URI. QR 코드로 변환해 인증 앱에 등록한다
+}
+
+type MFAConfirmResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"` // 이 응답에서만 확인할 수 있다
+}
+
+type MFACodeRequest struct {
+	Code string `json:"code" validate:"required"` // TOTP 코드 또는 복구 코드
+}
+
+type MFAChallengeRequest struct {
+	MFAToken string `json:"mfa_token" validate:"required"`
+}
+
+type MFALoginRequest struct {
+	MFAToken string `json:"mfa_token" validate:"required"`
+	Code     string `json:"code" validate:"required"` // TOTP 코드 또는 복구 코드
+}
+
+type MFARepository interface {
+	// SaveSecret 은 확인 전 상태의 비밀키를 저장한다. 이미 등록이 확인된 사용자면 ErrAlreadyExists 를 반환한다
+	SaveSecret(ctx context.Context, userID int64, secret string) error
+	GetByUserID(ctx context.Context, userID int64) (*MFA, error)
+	// Enable 은 등록을 확인 처리하고 기존 복구 코드를 새 코드로 교체한다
+	Enable(ctx context.Context, userID int64, recoveryCodeHashes []string) error
+	// Delete 는 MFA 설정과 복구 코드를 모두 삭제한다
+	Delete(ctx context.Context, userID int64) error
+	// UpdateLastUsedStep 은 step 이 마지막으로 사용된 주기보다 클 때만 갱신하며, 그렇지 않으면 ErrNotFound 를 반환한다
+	UpdateLastUsedStep(ctx context.Context, userID int64, step int64) error
+	GetUnusedRecoveryCodes(ctx context.Context, userID int64) ([]RecoveryCode, error)
+	// UseRecoveryCode 는 사용되지 않은 복구 코드만 사용 처리하며, 그렇지 않으면 ErrNotFound 를 반환한다
+	UseRecoveryCode(ctx context.Context, id int64) error
+}
@@ -0,0 +1,309 @@
+// Code generated by mockery v2.52.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/nicewook/gocore/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// APIKeyRepository is an autogenerated mock type for the APIKeyRepository type
+type APIKeyRepository struct {
+	mock.Mock
+}
+
+type APIKeyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *APIKeyRepository) EXPECT() *APIKeyRepository_Expecter {
+	return &APIKeyRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, userID, id
+func (_m *APIKeyRepository) Delete(ctx context.Context, userID int64, id int64) error {
+	ret := _m.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// APIKeyRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type APIKeyRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - id int64
+func (_e *APIKeyRepository_Expecter) Delete(ctx interface{}, userID interface{}, id interface{}) *APIKeyRepository_Delete_Call {
+	return &APIKeyRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, userID, id)}
+}
+
+func (_c *APIKeyRepository_Delete_Call) Run(run func(ctx context.Context, userID int64, id int64)) *APIKeyRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *APIKeyRepository_Delete_Call) Return(_a0 error) *APIKeyRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *APIKeyRepository_Delete_Call) RunAndReturn(run func(context.Context, int64, int64) error) *APIKeyRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByHash provides a mock function with given fields: ctx, keyHash
+func (_m *APIKeyRepository) GetByHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	ret := _m.Called(ctx, keyHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByHash")
+	}
+
+	var r0 *domain.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.APIKey, error)); ok {
+		return rf(ctx, keyHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.APIKey); ok {
+		r0 = rf(ctx, keyHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, keyHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyRepository_GetByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByHash'
+type APIKeyRepository_GetByHash_Call struct {
+	*mock.Call
+}
+
+// GetByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyHash string
+func (_e *APIKeyRepository_Expecter) GetByHash(ctx interface{}, keyHash interface{}) *APIKeyRepository_GetByHash_Call {
+	return &APIKeyRepository_GetByHash_Call{Call: _e.mock.On("GetByHash", ctx, keyHash)}
+}
+
+func (_c *APIKeyRepository_GetByHash_Call) Run(run func(ctx context.Context, keyHash string)) *APIKeyRepository_GetByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *APIKeyRepository_GetByHash_Call) Return(_a0 *domain.APIKey, _a1 error) *APIKeyRepository_GetByHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyRepository_GetByHash_Call) RunAndReturn(run func(context.Context, string) (*domain.APIKey, error)) *APIKeyRepository_GetByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserID provides a mock function with given fields: ctx, userID
+func (_m *APIKeyRepository) ListByUserID(ctx context.Context, userID int64) ([]*domain.APIKey, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUserID")
+	}
+
+	var r0 []*domain.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*domain.APIKey, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*domain.APIKey); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyRepository_ListByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserID'
+type APIKeyRepository_ListByUserID_Call struct {
+	*mock.Call
+}
+
+// ListByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *APIKeyRepository_Expecter) ListByUserID(ctx interface{}, userID interface{}) *APIKeyRepository_ListByUserID_Call {
+	return &APIKeyRepository_ListByUserID_Call{Call: _e.mock.On("ListByUserID", ctx, userID)}
+}
+
+func (_c *APIKeyRepository_ListByUserID_Call) Run(run func(ctx context.Context, userID int64)) *APIKeyRepository_ListByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *APIKeyRepository_ListByUserID_Call) Return(_a0 []*domain.APIKey, _a1 error) *APIKeyRepository_ListByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyRepository_ListByUserID_Call) RunAndReturn(run func(context.Context, int64) ([]*domain.APIKey, error)) *APIKeyRepository_ListByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, key
+func (_m *APIKeyRepository) Save(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 *domain.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.APIKey) (*domain.APIKey, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.APIKey) *domain.APIKey); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.APIKey) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type APIKeyRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key *domain.APIKey
+func (_e *APIKeyRepository_Expecter) Save(ctx interface{}, key interface{}) *APIKeyRepository_Save_Call {
+	return &APIKeyRepository_Save_Call{Call: _e.mock.On("Save", ctx, key)}
+}
+
+func (_c *APIKeyRepository_Save_Call) Run(run func(ctx context.Context, key *domain.APIKey)) *APIKeyRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.APIKey))
+	})
+	return _c
+}
+
+func (_c *APIKeyRepository_Save_Call) Return(_a0 *domain.APIKey, _a1 error) *APIKeyRepository_Save_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyRepository_Save_Call) RunAndReturn(run func(context.Context, *domain.APIKey) (*domain.APIKey, error)) *APIKeyRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TouchLastUsed provides a mock function with given fields: ctx, id
+func (_m *APIKeyRepository) TouchLastUsed(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchLastUsed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// APIKeyRepository_TouchLastUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchLastUsed'
+type APIKeyRepository_TouchLastUsed_Call struct {
+	*mock.Call
+}
+
+// TouchLastUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *APIKeyRepository_Expecter) TouchLastUsed(ctx interface{}, id interface{}) *APIKeyRepository_TouchLastUsed_Call {
+	return &APIKeyRepository_TouchLastUsed_Call{Call: _e.mock.On("TouchLastUsed", ctx, id)}
+}
+
+func (_c *APIKeyRepository_TouchLastUsed_Call) Run(run func(ctx context.Context, id int64)) *APIKeyRepository_TouchLastUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *APIKeyRepository_TouchLastUsed_Call) Return(_a0 error) *APIKeyRepository_TouchLastUsed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *APIKeyRepository_TouchLastUsed_Call) RunAndReturn(run func(context.Context, int64) error) *APIKeyRepository_TouchLastUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAPIKeyRepository creates a new instance of APIKeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAPIKeyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *APIKeyRepository {
+	mock := &APIKeyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
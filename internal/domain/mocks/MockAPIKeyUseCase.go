@@ -0,0 +1,272 @@
+// Code generated by mockery v2.52.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/nicewook/gocore/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// APIKeyUseCase is an autogenerated mock type for the APIKeyUseCase type
+type APIKeyUseCase struct {
+	mock.Mock
+}
+
+type APIKeyUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *APIKeyUseCase) EXPECT() *APIKeyUseCase_Expecter {
+	return &APIKeyUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Authenticate provides a mock function with given fields: ctx, rawKey
+func (_m *APIKeyUseCase) Authenticate(ctx context.Context, rawKey string) (*domain.User, *domain.APIKey, error) {
+	ret := _m.Called(ctx, rawKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authenticate")
+	}
+
+	var r0 *domain.User
+	var r1 *domain.APIKey
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.User, *domain.APIKey, error)); ok {
+		return rf(ctx, rawKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = rf(ctx, rawKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) *domain.APIKey); ok {
+		r1 = rf(ctx, rawKey)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*domain.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, rawKey)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// APIKeyUseCase_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type APIKeyUseCase_Authenticate_Call struct {
+	*mock.Call
+}
+
+// Authenticate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rawKey string
+func (_e *APIKeyUseCase_Expecter) Authenticate(ctx interface{}, rawKey interface{}) *APIKeyUseCase_Authenticate_Call {
+	return &APIKeyUseCase_Authenticate_Call{Call: _e.mock.On("Authenticate", ctx, rawKey)}
+}
+
+func (_c *APIKeyUseCase_Authenticate_Call) Run(run func(ctx context.Context, rawKey string)) *APIKeyUseCase_Authenticate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *APIKeyUseCase_Authenticate_Call) Return(_a0 *domain.User, _a1 *domain.APIKey, _a2 error) *APIKeyUseCase_Authenticate_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *APIKeyUseCase_Authenticate_Call) RunAndReturn(run func(context.Context, string) (*domain.User, *domain.APIKey, error)) *APIKeyUseCase_Authenticate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, userID, req
+func (_m *APIKeyUseCase) Create(ctx context.Context, userID int64, req *domain.CreateAPIKeyRequest) (*domain.CreateAPIKeyResponse, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.CreateAPIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *domain.CreateAPIKeyRequest) (*domain.CreateAPIKeyResponse, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *domain.CreateAPIKeyRequest) *domain.CreateAPIKeyResponse); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CreateAPIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *domain.CreateAPIKeyRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyUseCase_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type APIKeyUseCase_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - req *domain.CreateAPIKeyRequest
+func (_e *APIKeyUseCase_Expecter) Create(ctx interface{}, userID interface{}, req interface{}) *APIKeyUseCase_Create_Call {
+	return &APIKeyUseCase_Create_Call{Call: _e.mock.On("Create", ctx, userID, req)}
+}
+
+func (_c *APIKeyUseCase_Create_Call) Run(run func(ctx context.Context, userID int64, req *domain.CreateAPIKeyRequest)) *APIKeyUseCase_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*domain.CreateAPIKeyRequest))
+	})
+	return _c
+}
+
+func (_c *APIKeyUseCase_Create_Call) Return(_a0 *domain.CreateAPIKeyResponse, _a1 error) *APIKeyUseCase_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyUseCase_Create_Call) RunAndReturn(run func(context.Context, int64, *domain.CreateAPIKeyRequest) (*domain.CreateAPIKeyResponse, error)) *APIKeyUseCase_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, userID
+func (_m *APIKeyUseCase) List(ctx context.Context, userID int64) ([]*domain.APIKey, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*domain.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*domain.APIKey, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*domain.APIKey); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyUseCase_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type APIKeyUseCase_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *APIKeyUseCase_Expecter) List(ctx interface{}, userID interface{}) *APIKeyUseCase_List_Call {
+	return &APIKeyUseCase_List_Call{Call: _e.mock.On("List", ctx, userID)}
+}
+
+func (_c *APIKeyUseCase_List_Call) Run(run func(ctx context.Context, userID int64)) *APIKeyUseCase_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *APIKeyUseCase_List_Call) Return(_a0 []*domain.APIKey, _a1 error) *APIKeyUseCase_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyUseCase_List_Call) RunAndReturn(run func(context.Context, int64) ([]*domain.APIKey, error)) *APIKeyUseCase_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: ctx, userID, id
+func (_m *APIKeyUseCase) Revoke(ctx context.Context, userID int64, id int64) error {
+	ret := _m.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// APIKeyUseCase_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type APIKeyUseCase_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - id int64
+func (_e *APIKeyUseCase_Expecter) Revoke(ctx interface{}, userID interface{}, id interface{}) *APIKeyUseCase_Revoke_Call {
+	return &APIKeyUseCase_Revoke_Call{Call: _e.mock.On("Revoke", ctx, userID, id)}
+}
+
+func (_c *APIKeyUseCase_Revoke_Call) Run(run func(ctx context.Context, userID int64, id int64)) *APIKeyUseCase_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *APIKeyUseCase_Revoke_Call) Return(_a0 error) *APIKeyUseCase_Revoke_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *APIKeyUseCase_Revoke_Call) RunAndReturn(run func(context.Context, int64, int64) error) *APIKeyUseCase_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAPIKeyUseCase creates a new instance of APIKeyUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAPIKeyUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *APIKeyUseCase {
+	mock := &APIKeyUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
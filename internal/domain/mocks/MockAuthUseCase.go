@@ -22,29 +22,1406 @@ func (_m *AuthUseCase) EXPECT() *AuthUseCase_Expecter {
 	return &AuthUseCase_Expecter{mock: &_m.Mock}
 }
 
+// ChangePassword provides a mock function with given fields: ctx, userID, req
+func (_m *AuthUseCase) ChangePassword(ctx context.Context, userID int64, req *domain.ChangePasswordRequest) (*domain.LoginResponse, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangePassword")
+	}
+
+	var r0 *domain.LoginResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *domain.ChangePasswordRequest) (*domain.LoginResponse, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *domain.ChangePasswordRequest) *domain.LoginResponse); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LoginResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *domain.ChangePasswordRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthUseCase_ChangePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangePassword'
+type AuthUseCase_ChangePassword_Call struct {
+	*mock.Call
+}
+
+// ChangePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - req *domain.ChangePasswordRequest
+func (_e *AuthUseCase_Expecter) ChangePassword(ctx interface{}, userID interface{}, req interface{}) *AuthUseCase_ChangePassword_Call {
+	return &AuthUseCase_ChangePassword_Call{Call: _e.mock.On("ChangePassword", ctx, userID, req)}
+}
+
+func (_c *AuthUseCase_ChangePassword_Call) Run(run func(ctx context.Context, userID int64, req *domain.ChangePasswordRequest)) *AuthUseCase_ChangePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*domain.ChangePasswordRequest))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_ChangePassword_Call) Return(_a0 *domain.LoginResponse, _a1 error) *AuthUseCase_ChangePassword_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthUseCase_ChangePassword_Call) RunAndReturn(run func(context.Context, int64, *domain.ChangePasswordRequest) (*domain.LoginResponse, error)) *AuthUseCase_ChangePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClientCredentialsToken provides a mock function with given fields: ctx, clientID, clientSecret, scope
+func (_m *AuthUseCase) ClientCredentialsToken(ctx context.Context, clientID string, clientSecret string, scope string) (*domain.TokenResponse, error) {
+	ret := _m.Called(ctx, clientID, clientSecret, scope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClientCredentialsToken")
+	}
+
+	var r0 *domain.TokenResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*domain.TokenResponse, error)); ok {
+		return rf(ctx, clientID, clientSecret, scope)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *domain.TokenResponse); ok {
+		r0 = rf(ctx, clientID, clientSecret, scope)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TokenResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, clientID, clientSecret, scope)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthUseCase_ClientCredentialsToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClientCredentialsToken'
+type AuthUseCase_ClientCredentialsToken_Call struct {
+	*mock.Call
+}
+
+// ClientCredentialsToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+//   - clientSecret string
+//   - scope string
+func (_e *AuthUseCase_Expecter) ClientCredentialsToken(ctx interface{}, clientID interface{}, clientSecret interface{}, scope interface{}) *AuthUseCase_ClientCredentialsToken_Call {
+	return &AuthUseCase_ClientCredentialsToken_Call{Call: _e.mock.On("ClientCredentialsToken", ctx, clientID, clientSecret, scope)}
+}
+
+func (_c *AuthUseCase_ClientCredentialsToken_Call) Run(run func(ctx context.Context, clientID string, clientSecret string, scope string)) *AuthUseCase_ClientCredentialsToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_ClientCredentialsToken_Call) Return(_a0 *domain.TokenResponse, _a1 error) *AuthUseCase_ClientCredentialsToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthUseCase_ClientCredentialsToken_Call) RunAndReturn(run func(context.Context, string, string, string) (*domain.TokenResponse, error)) *AuthUseCase_ClientCredentialsToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmMFA provides a mock function with given fields: ctx, userID, code
+func (_m *AuthUseCase) ConfirmMFA(ctx context.Context, userID int64, code string) (*domain.MFAConfirmResponse, error) {
+	ret := _m.Called(ctx, userID, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmMFA")
+	}
+
+	var r0 *domain.MFAConfirmResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (*domain.MFAConfirmResponse, error)); ok {
+		return rf(ctx, userID, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) *domain.MFAConfirmResponse); ok {
+		r0 = rf(ctx, userID, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.MFAConfirmResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, userID, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthUseCase_ConfirmMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmMFA'
+type AuthUseCase_ConfirmMFA_Call struct {
+	*mock.Call
+}
+
+// ConfirmMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - code string
+func (_e *AuthUseCase_Expecter) ConfirmMFA(ctx interface{}, userID interface{}, code interface{}) *AuthUseCase_ConfirmMFA_Call {
+	return &AuthUseCase_ConfirmMFA_Call{Call: _e.mock.On("ConfirmMFA", ctx, userID, code)}
+}
+
+func (_c *AuthUseCase_ConfirmMFA_Call) Run(run func(ctx context.Context, userID int64, code string)) *AuthUseCase_ConfirmMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_ConfirmMFA_Call) Return(_a0 *domain.MFAConfirmResponse, _a1 error) *AuthUseCase_ConfirmMFA_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthUseCase_ConfirmMFA_Call) RunAndReturn(run func(context.Context, int64, string) (*domain.MFAConfirmResponse, error)) *AuthUseCase_ConfirmMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAccount provides a mock function with given fields: ctx, userID, req
+func (_m *AuthUseCase) DeleteAccount(ctx context.Context, userID int64, req *domain.DeleteAccountRequest) (*domain.DeleteAccountResponse, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAccount")
+	}
+
+	var r0 *domain.DeleteAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *domain.DeleteAccountRequest) (*domain.DeleteAccountResponse, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *domain.DeleteAccountRequest) *domain.DeleteAccountResponse); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DeleteAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *domain.DeleteAccountRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthUseCase_DeleteAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccount'
+type AuthUseCase_DeleteAccount_Call struct {
+	*mock.Call
+}
+
+// DeleteAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - req *domain.DeleteAccountRequest
+func (_e *AuthUseCase_Expecter) DeleteAccount(ctx interface{}, userID interface{}, req interface{}) *AuthUseCase_DeleteAccount_Call {
+	return &AuthUseCase_DeleteAccount_Call{Call: _e.mock.On("DeleteAccount", ctx, userID, req)}
+}
+
+func (_c *AuthUseCase_DeleteAccount_Call) Run(run func(ctx context.Context, userID int64, req *domain.DeleteAccountRequest)) *AuthUseCase_DeleteAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*domain.DeleteAccountRequest))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_DeleteAccount_Call) Return(_a0 *domain.DeleteAccountResponse, _a1 error) *AuthUseCase_DeleteAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthUseCase_DeleteAccount_Call) RunAndReturn(run func(context.Context, int64, *domain.DeleteAccountRequest) (*domain.DeleteAccountResponse, error)) *AuthUseCase_DeleteAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DisableMFA provides a mock function with given fields: ctx, userID, code
+func (_m *AuthUseCase) DisableMFA(ctx context.Context, userID int64, code string) error {
+	ret := _m.Called(ctx, userID, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableMFA")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, userID, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthUseCase_DisableMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableMFA'
+type AuthUseCase_DisableMFA_Call struct {
+	*mock.Call
+}
+
+// DisableMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - code string
+func (_e *AuthUseCase_Expecter) DisableMFA(ctx interface{}, userID interface{}, code interface{}) *AuthUseCase_DisableMFA_Call {
+	return &AuthUseCase_DisableMFA_Call{Call: _e.mock.On("DisableMFA", ctx, userID, code)}
+}
+
+func (_c *AuthUseCase_DisableMFA_Call) Run(run func(ctx context.Context, userID int64, code string)) *AuthUseCase_DisableMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_DisableMFA_Call) Return(_a0 error) *AuthUseCase_DisableMFA_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthUseCase_DisableMFA_Call) RunAndReturn(run func(context.Context, int64, string) error) *AuthUseCase_DisableMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DisableUser provides a mock function with given fields: ctx, userID
+func (_m *AuthUseCase) DisableUser(ctx context.Context, userID int64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthUseCase_DisableUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableUser'
+type AuthUseCase_DisableUser_Call struct {
+	*mock.Call
+}
+
+// DisableUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *AuthUseCase_Expecter) DisableUser(ctx interface{}, userID interface{}) *AuthUseCase_DisableUser_Call {
+	return &AuthUseCase_DisableUser_Call{Call: _e.mock.On("DisableUser", ctx, userID)}
+}
+
+func (_c *AuthUseCase_DisableUser_Call) Run(run func(ctx context.Context, userID int64)) *AuthUseCase_DisableUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_DisableUser_Call) Return(_a0 error) *AuthUseCase_DisableUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthUseCase_DisableUser_Call) RunAndReturn(run func(context.Context, int64) error) *AuthUseCase_DisableUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnableUser provides a mock function with given fields: ctx, userID
+func (_m *AuthUseCase) EnableUser(ctx context.Context, userID int64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnableUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthUseCase_EnableUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnableUser'
+type AuthUseCase_EnableUser_Call struct {
+	*mock.Call
+}
+
+// EnableUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *AuthUseCase_Expecter) EnableUser(ctx interface{}, userID interface{}) *AuthUseCase_EnableUser_Call {
+	return &AuthUseCase_EnableUser_Call{Call: _e.mock.On("EnableUser", ctx, userID)}
+}
+
+func (_c *AuthUseCase_EnableUser_Call) Run(run func(ctx context.Context, userID int64)) *AuthUseCase_EnableUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_EnableUser_Call) Return(_a0 error) *AuthUseCase_EnableUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthUseCase_EnableUser_Call) RunAndReturn(run func(context.Context, int64) error) *AuthUseCase_EnableUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnrollMFA provides a mock function with given fields: ctx, userID
+func (_m *AuthUseCase) EnrollMFA(ctx context.Context, userID int64) (*domain.MFAEnrollResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnrollMFA")
+	}
+
+	var r0 *domain.MFAEnrollResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.MFAEnrollResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.MFAEnrollResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.MFAEnrollResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthUseCase_EnrollMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnrollMFA'
+type AuthUseCase_EnrollMFA_Call struct {
+	*mock.Call
+}
+
+// EnrollMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *AuthUseCase_Expecter) EnrollMFA(ctx interface{}, userID interface{}) *AuthUseCase_EnrollMFA_Call {
+	return &AuthUseCase_EnrollMFA_Call{Call: _e.mock.On("EnrollMFA", ctx, userID)}
+}
+
+func (_c *AuthUseCase_EnrollMFA_Call) Run(run func(ctx context.Context, userID int64)) *AuthUseCase_EnrollMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_EnrollMFA_Call) Return(_a0 *domain.MFAEnrollResponse, _a1 error) *AuthUseCase_EnrollMFA_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthUseCase_EnrollMFA_Call) RunAndReturn(run func(context.Context, int64) (*domain.MFAEnrollResponse, error)) *AuthUseCase_EnrollMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnrollMFAWithChallenge provides a mock function with given fields: ctx, mfaToken
+func (_m *AuthUseCase) EnrollMFAWithChallenge(ctx context.Context, mfaToken string) (*domain.MFAEnrollResponse, error) {
+	ret := _m.Called(ctx, mfaToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnrollMFAWithChallenge")
+	}
+
+	var r0 *domain.MFAEnrollResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.MFAEnrollResponse, error)); ok {
+		return rf(ctx, mfaToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.MFAEnrollResponse); ok {
+		r0 = rf(ctx, mfaToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.MFAEnrollResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, mfaToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthUseCase_EnrollMFAWithChallenge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnrollMFAWithChallenge'
+type AuthUseCase_EnrollMFAWithChallenge_Call struct {
+	*mock.Call
+}
+
+// EnrollMFAWithChallenge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mfaToken string
+func (_e *AuthUseCase_Expecter) EnrollMFAWithChallenge(ctx interface{}, mfaToken interface{}) *AuthUseCase_EnrollMFAWithChallenge_Call {
+	return &AuthUseCase_EnrollMFAWithChallenge_Call{Call: _e.mock.On("EnrollMFAWithChallenge", ctx, mfaToken)}
+}
+
+func (_c *AuthUseCase_EnrollMFAWithChallenge_Call) Run(run func(ctx context.Context, mfaToken string)) *AuthUseCase_EnrollMFAWithChallenge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_EnrollMFAWithChallenge_Call) Return(_a0 *domain.MFAEnrollResponse, _a1 error) *AuthUseCase_EnrollMFAWithChallenge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthUseCase_EnrollMFAWithChallenge_Call) RunAndReturn(run func(context.Context, string) (*domain.MFAEnrollResponse, error)) *AuthUseCase_EnrollMFAWithChallenge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EraseDeletedUsers provides a mock function with given fields: ctx
+func (_m *AuthUseCase) EraseDeletedUsers(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EraseDeletedUsers")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthUseCase_EraseDeletedUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EraseDeletedUsers'
+type AuthUseCase_EraseDeletedUsers_Call struct {
+	*mock.Call
+}
+
+// EraseDeletedUsers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AuthUseCase_Expecter) EraseDeletedUsers(ctx interface{}) *AuthUseCase_EraseDeletedUsers_Call {
+	return &AuthUseCase_EraseDeletedUsers_Call{Call: _e.mock.On("EraseDeletedUsers", ctx)}
+}
+
+func (_c *AuthUseCase_EraseDeletedUsers_Call) Run(run func(ctx context.Context)) *AuthUseCase_EraseDeletedUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_EraseDeletedUsers_Call) Return(_a0 int, _a1 error) *AuthUseCase_EraseDeletedUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthUseCase_EraseDeletedUsers_Call) RunAndReturn(run func(context.Context) (int, error)) *AuthUseCase_EraseDeletedUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ForgotPassword provides a mock function with given fields: ctx, email
+func (_m *AuthUseCase) ForgotPassword(ctx context.Context, email string) error {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForgotPassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthUseCase_ForgotPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForgotPassword'
+type AuthUseCase_ForgotPassword_Call struct {
+	*mock.Call
+}
+
+// ForgotPassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *AuthUseCase_Expecter) ForgotPassword(ctx interface{}, email interface{}) *AuthUseCase_ForgotPassword_Call {
+	return &AuthUseCase_ForgotPassword_Call{Call: _e.mock.On("ForgotPassword", ctx, email)}
+}
+
+func (_c *AuthUseCase_ForgotPassword_Call) Run(run func(ctx context.Context, email string)) *AuthUseCase_ForgotPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_ForgotPassword_Call) Return(_a0 error) *AuthUseCase_ForgotPassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthUseCase_ForgotPassword_Call) RunAndReturn(run func(context.Context, string) error) *AuthUseCase_ForgotPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoles provides a mock function with given fields: ctx
+func (_m *AuthUseCase) GetRoles(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoles")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthUseCase_GetRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoles'
+type AuthUseCase_GetRoles_Call struct {
+	*mock.Call
+}
+
+// GetRoles is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AuthUseCase_Expecter) GetRoles(ctx interface{}) *AuthUseCase_GetRoles_Call {
+	return &AuthUseCase_GetRoles_Call{Call: _e.mock.On("GetRoles", ctx)}
+}
+
+func (_c *AuthUseCase_GetRoles_Call) Run(run func(ctx context.Context)) *AuthUseCase_GetRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_GetRoles_Call) Return(_a0 []string, _a1 error) *AuthUseCase_GetRoles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthUseCase_GetRoles_Call) RunAndReturn(run func(context.Context) ([]string, error)) *AuthUseCase_GetRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GrantRole provides a mock function with given fields: ctx, userID, role
+func (_m *AuthUseCase) GrantRole(ctx context.Context, userID int64, role string) (*domain.User, error) {
+	ret := _m.Called(ctx, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GrantRole")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (*domain.User, error)); ok {
+		return rf(ctx, userID, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) *domain.User); ok {
+		r0 = rf(ctx, userID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, userID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthUseCase_GrantRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GrantRole'
+type AuthUseCase_GrantRole_Call struct {
+	*mock.Call
+}
+
+// GrantRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - role string
+func (_e *AuthUseCase_Expecter) GrantRole(ctx interface{}, userID interface{}, role interface{}) *AuthUseCase_GrantRole_Call {
+	return &AuthUseCase_GrantRole_Call{Call: _e.mock.On("GrantRole", ctx, userID, role)}
+}
+
+func (_c *AuthUseCase_GrantRole_Call) Run(run func(ctx context.Context, userID int64, role string)) *AuthUseCase_GrantRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_GrantRole_Call) Return(_a0 *domain.User, _a1 error) *AuthUseCase_GrantRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthUseCase_GrantRole_Call) RunAndReturn(run func(context.Context, int64, string) (*domain.User, error)) *AuthUseCase_GrantRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Impersonate provides a mock function with given fields: ctx, userID
+func (_m *AuthUseCase) Impersonate(ctx context.Context, userID int64) (*domain.ImpersonationResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Impersonate")
+	}
+
+	var r0 *domain.ImpersonationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.ImpersonationResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.ImpersonationResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ImpersonationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthUseCase_Impersonate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Impersonate'
+type AuthUseCase_Impersonate_Call struct {
+	*mock.Call
+}
+
+// Impersonate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *AuthUseCase_Expecter) Impersonate(ctx interface{}, userID interface{}) *AuthUseCase_Impersonate_Call {
+	return &AuthUseCase_Impersonate_Call{Call: _e.mock.On("Impersonate", ctx, userID)}
+}
+
+func (_c *AuthUseCase_Impersonate_Call) Run(run func(ctx context.Context, userID int64)) *AuthUseCase_Impersonate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_Impersonate_Call) Return(_a0 *domain.ImpersonationResponse, _a1 error) *AuthUseCase_Impersonate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthUseCase_Impersonate_Call) RunAndReturn(run func(context.Context, int64) (*domain.ImpersonationResponse, error)) *AuthUseCase_Impersonate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IntrospectToken provides a mock function with given fields: ctx, clientID, clientSecret, token
+func (_m *AuthUseCase) IntrospectToken(ctx context.Context, clientID string, clientSecret string, token string) (*domain.IntrospectionResponse, error) {
+	ret := _m.Called(ctx, clientID, clientSecret, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IntrospectToken")
+	}
+
+	var r0 *domain.IntrospectionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*domain.IntrospectionResponse, error)); ok {
+		return rf(ctx, clientID, clientSecret, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *domain.IntrospectionResponse); ok {
+		r0 = rf(ctx, clientID, clientSecret, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.IntrospectionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, clientID, clientSecret, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthUseCase_IntrospectToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IntrospectToken'
+type AuthUseCase_IntrospectToken_Call struct {
+	*mock.Call
+}
+
+// IntrospectToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+//   - clientSecret string
+//   - token string
+func (_e *AuthUseCase_Expecter) IntrospectToken(ctx interface{}, clientID interface{}, clientSecret interface{}, token interface{}) *AuthUseCase_IntrospectToken_Call {
+	return &AuthUseCase_IntrospectToken_Call{Call: _e.mock.On("IntrospectToken", ctx, clientID, clientSecret, token)}
+}
+
+func (_c *AuthUseCase_IntrospectToken_Call) Run(run func(ctx context.Context, clientID string, clientSecret string, token string)) *AuthUseCase_IntrospectToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_IntrospectToken_Call) Return(_a0 *domain.IntrospectionResponse, _a1 error) *AuthUseCase_IntrospectToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthUseCase_IntrospectToken_Call) RunAndReturn(run func(context.Context, string, string, string) (*domain.IntrospectionResponse, error)) *AuthUseCase_IntrospectToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessions provides a mock function with given fields: ctx, userID, currentSessionID
+func (_m *AuthUseCase) ListSessions(ctx context.Context, userID int64, currentSessionID string) ([]*domain.Session, error) {
+	ret := _m.Called(ctx, userID, currentSessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 []*domain.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) ([]*domain.Session, error)); ok {
+		return rf(ctx, userID, currentSessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) []*domain.Session); ok {
+		r0 = rf(ctx, userID, currentSessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, userID, currentSessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthUseCase_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type AuthUseCase_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - currentSessionID string
+func (_e *AuthUseCase_Expecter) ListSessions(ctx interface{}, userID interface{}, currentSessionID interface{}) *AuthUseCase_ListSessions_Call {
+	return &AuthUseCase_ListSessions_Call{Call: _e.mock.On("ListSessions", ctx, userID, currentSessionID)}
+}
+
+func (_c *AuthUseCase_ListSessions_Call) Run(run func(ctx context.Context, userID int64, currentSessionID string)) *AuthUseCase_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_ListSessions_Call) Return(_a0 []*domain.Session, _a1 error) *AuthUseCase_ListSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthUseCase_ListSessions_Call) RunAndReturn(run func(context.Context, int64, string) ([]*domain.Session, error)) *AuthUseCase_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Login provides a mock function with given fields: ctx, email, password
 func (_m *AuthUseCase) Login(ctx context.Context, email string, password string) (*domain.LoginResponse, error) {
 	ret := _m.Called(ctx, email, password)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Login")
+		panic("no return value specified for Login")
+	}
+
+	var r0 *domain.LoginResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.LoginResponse, error)); ok {
+		return rf(ctx, email, password)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.LoginResponse); ok {
+		r0 = rf(ctx, email, password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LoginResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, email, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthUseCase_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type AuthUseCase_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+//   - password string
+func (_e *AuthUseCase_Expecter) Login(ctx interface{}, email interface{}, password interface{}) *AuthUseCase_Login_Call {
+	return &AuthUseCase_Login_Call{Call: _e.mock.On("Login", ctx, email, password)}
+}
+
+func (_c *AuthUseCase_Login_Call) Run(run func(ctx context.Context, email string, password string)) *AuthUseCase_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_Login_Call) Return(_a0 *domain.LoginResponse, _a1 error) *AuthUseCase_Login_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthUseCase_Login_Call) RunAndReturn(run func(context.Context, string, string) (*domain.LoginResponse, error)) *AuthUseCase_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginMFA provides a mock function with given fields: ctx, req
+func (_m *AuthUseCase) LoginMFA(ctx context.Context, req *domain.MFALoginRequest) (*domain.LoginResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginMFA")
+	}
+
+	var r0 *domain.LoginResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.MFALoginRequest) (*domain.LoginResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.MFALoginRequest) *domain.LoginResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LoginResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.MFALoginRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthUseCase_LoginMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginMFA'
+type AuthUseCase_LoginMFA_Call struct {
+	*mock.Call
+}
+
+// LoginMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.MFALoginRequest
+func (_e *AuthUseCase_Expecter) LoginMFA(ctx interface{}, req interface{}) *AuthUseCase_LoginMFA_Call {
+	return &AuthUseCase_LoginMFA_Call{Call: _e.mock.On("LoginMFA", ctx, req)}
+}
+
+func (_c *AuthUseCase_LoginMFA_Call) Run(run func(ctx context.Context, req *domain.MFALoginRequest)) *AuthUseCase_LoginMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.MFALoginRequest))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_LoginMFA_Call) Return(_a0 *domain.LoginResponse, _a1 error) *AuthUseCase_LoginMFA_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthUseCase_LoginMFA_Call) RunAndReturn(run func(context.Context, *domain.MFALoginRequest) (*domain.LoginResponse, error)) *AuthUseCase_LoginMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginOIDC provides a mock function with given fields: ctx, code, codeVerifier, nonce
+func (_m *AuthUseCase) LoginOIDC(ctx context.Context, code string, codeVerifier string, nonce string) (*domain.LoginResponse, error) {
+	ret := _m.Called(ctx, code, codeVerifier, nonce)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginOIDC")
+	}
+
+	var r0 *domain.LoginResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*domain.LoginResponse, error)); ok {
+		return rf(ctx, code, codeVerifier, nonce)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *domain.LoginResponse); ok {
+		r0 = rf(ctx, code, codeVerifier, nonce)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LoginResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, code, codeVerifier, nonce)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthUseCase_LoginOIDC_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginOIDC'
+type AuthUseCase_LoginOIDC_Call struct {
+	*mock.Call
+}
+
+// LoginOIDC is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+//   - codeVerifier string
+//   - nonce string
+func (_e *AuthUseCase_Expecter) LoginOIDC(ctx interface{}, code interface{}, codeVerifier interface{}, nonce interface{}) *AuthUseCase_LoginOIDC_Call {
+	return &AuthUseCase_LoginOIDC_Call{Call: _e.mock.On("LoginOIDC", ctx, code, codeVerifier, nonce)}
+}
+
+func (_c *AuthUseCase_LoginOIDC_Call) Run(run func(ctx context.Context, code string, codeVerifier string, nonce string)) *AuthUseCase_LoginOIDC_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_LoginOIDC_Call) Return(_a0 *domain.LoginResponse, _a1 error) *AuthUseCase_LoginOIDC_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthUseCase_LoginOIDC_Call) RunAndReturn(run func(context.Context, string, string, string) (*domain.LoginResponse, error)) *AuthUseCase_LoginOIDC_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function with given fields: ctx, req
+func (_m *AuthUseCase) Logout(ctx context.Context, req *domain.LogoutRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.LogoutRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthUseCase_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type AuthUseCase_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.LogoutRequest
+func (_e *AuthUseCase_Expecter) Logout(ctx interface{}, req interface{}) *AuthUseCase_Logout_Call {
+	return &AuthUseCase_Logout_Call{Call: _e.mock.On("Logout", ctx, req)}
+}
+
+func (_c *AuthUseCase_Logout_Call) Run(run func(ctx context.Context, req *domain.LogoutRequest)) *AuthUseCase_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.LogoutRequest))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_Logout_Call) Return(_a0 error) *AuthUseCase_Logout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthUseCase_Logout_Call) RunAndReturn(run func(context.Context, *domain.LogoutRequest) error) *AuthUseCase_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshToken provides a mock function with given fields: ctx, refreshToken
+func (_m *AuthUseCase) RefreshToken(ctx context.Context, refreshToken string) (*domain.LoginResponse, error) {
+	ret := _m.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshToken")
 	}
 
 	var r0 *domain.LoginResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.LoginResponse, error)); ok {
-		return rf(ctx, email, password)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.LoginResponse, error)); ok {
+		return rf(ctx, refreshToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.LoginResponse); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LoginResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthUseCase_RefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshToken'
+type AuthUseCase_RefreshToken_Call struct {
+	*mock.Call
+}
+
+// RefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken string
+func (_e *AuthUseCase_Expecter) RefreshToken(ctx interface{}, refreshToken interface{}) *AuthUseCase_RefreshToken_Call {
+	return &AuthUseCase_RefreshToken_Call{Call: _e.mock.On("RefreshToken", ctx, refreshToken)}
+}
+
+func (_c *AuthUseCase_RefreshToken_Call) Run(run func(ctx context.Context, refreshToken string)) *AuthUseCase_RefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_RefreshToken_Call) Return(_a0 *domain.LoginResponse, _a1 error) *AuthUseCase_RefreshToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthUseCase_RefreshToken_Call) RunAndReturn(run func(context.Context, string) (*domain.LoginResponse, error)) *AuthUseCase_RefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResendVerification provides a mock function with given fields: ctx, email
+func (_m *AuthUseCase) ResendVerification(ctx context.Context, email string) error {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendVerification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthUseCase_ResendVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResendVerification'
+type AuthUseCase_ResendVerification_Call struct {
+	*mock.Call
+}
+
+// ResendVerification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *AuthUseCase_Expecter) ResendVerification(ctx interface{}, email interface{}) *AuthUseCase_ResendVerification_Call {
+	return &AuthUseCase_ResendVerification_Call{Call: _e.mock.On("ResendVerification", ctx, email)}
+}
+
+func (_c *AuthUseCase_ResendVerification_Call) Run(run func(ctx context.Context, email string)) *AuthUseCase_ResendVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_ResendVerification_Call) Return(_a0 error) *AuthUseCase_ResendVerification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthUseCase_ResendVerification_Call) RunAndReturn(run func(context.Context, string) error) *AuthUseCase_ResendVerification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetPassword provides a mock function with given fields: ctx, req
+func (_m *AuthUseCase) ResetPassword(ctx context.Context, req *domain.ResetPasswordRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetPassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ResetPasswordRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthUseCase_ResetPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPassword'
+type AuthUseCase_ResetPassword_Call struct {
+	*mock.Call
+}
+
+// ResetPassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.ResetPasswordRequest
+func (_e *AuthUseCase_Expecter) ResetPassword(ctx interface{}, req interface{}) *AuthUseCase_ResetPassword_Call {
+	return &AuthUseCase_ResetPassword_Call{Call: _e.mock.On("ResetPassword", ctx, req)}
+}
+
+func (_c *AuthUseCase_ResetPassword_Call) Run(run func(ctx context.Context, req *domain.ResetPasswordRequest)) *AuthUseCase_ResetPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.ResetPasswordRequest))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_ResetPassword_Call) Return(_a0 error) *AuthUseCase_ResetPassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthUseCase_ResetPassword_Call) RunAndReturn(run func(context.Context, *domain.ResetPasswordRequest) error) *AuthUseCase_ResetPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreUser provides a mock function with given fields: ctx, userID
+func (_m *AuthUseCase) RestoreUser(ctx context.Context, userID int64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthUseCase_RestoreUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreUser'
+type AuthUseCase_RestoreUser_Call struct {
+	*mock.Call
+}
+
+// RestoreUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *AuthUseCase_Expecter) RestoreUser(ctx interface{}, userID interface{}) *AuthUseCase_RestoreUser_Call {
+	return &AuthUseCase_RestoreUser_Call{Call: _e.mock.On("RestoreUser", ctx, userID)}
+}
+
+func (_c *AuthUseCase_RestoreUser_Call) Run(run func(ctx context.Context, userID int64)) *AuthUseCase_RestoreUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_RestoreUser_Call) Return(_a0 error) *AuthUseCase_RestoreUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthUseCase_RestoreUser_Call) RunAndReturn(run func(context.Context, int64) error) *AuthUseCase_RestoreUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeOtherSessions provides a mock function with given fields: ctx, userID, currentSessionID
+func (_m *AuthUseCase) RevokeOtherSessions(ctx context.Context, userID int64, currentSessionID string) error {
+	ret := _m.Called(ctx, userID, currentSessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeOtherSessions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, userID, currentSessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthUseCase_RevokeOtherSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeOtherSessions'
+type AuthUseCase_RevokeOtherSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeOtherSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - currentSessionID string
+func (_e *AuthUseCase_Expecter) RevokeOtherSessions(ctx interface{}, userID interface{}, currentSessionID interface{}) *AuthUseCase_RevokeOtherSessions_Call {
+	return &AuthUseCase_RevokeOtherSessions_Call{Call: _e.mock.On("RevokeOtherSessions", ctx, userID, currentSessionID)}
+}
+
+func (_c *AuthUseCase_RevokeOtherSessions_Call) Run(run func(ctx context.Context, userID int64, currentSessionID string)) *AuthUseCase_RevokeOtherSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_RevokeOtherSessions_Call) Return(_a0 error) *AuthUseCase_RevokeOtherSessions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthUseCase_RevokeOtherSessions_Call) RunAndReturn(run func(context.Context, int64, string) error) *AuthUseCase_RevokeOtherSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeRole provides a mock function with given fields: ctx, userID, role
+func (_m *AuthUseCase) RevokeRole(ctx context.Context, userID int64, role string) (*domain.User, error) {
+	ret := _m.Called(ctx, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeRole")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (*domain.User, error)); ok {
+		return rf(ctx, userID, role)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.LoginResponse); ok {
-		r0 = rf(ctx, email, password)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) *domain.User); ok {
+		r0 = rf(ctx, userID, role)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.LoginResponse)
+			r0 = ret.Get(0).(*domain.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, email, password)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, userID, role)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -52,47 +1429,47 @@ func (_m *AuthUseCase) Login(ctx context.Context, email string, password string)
 	return r0, r1
 }
 
-// AuthUseCase_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
-type AuthUseCase_Login_Call struct {
+// AuthUseCase_RevokeRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeRole'
+type AuthUseCase_RevokeRole_Call struct {
 	*mock.Call
 }
 
-// Login is a helper method to define mock.On call
+// RevokeRole is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email string
-//   - password string
-func (_e *AuthUseCase_Expecter) Login(ctx interface{}, email interface{}, password interface{}) *AuthUseCase_Login_Call {
-	return &AuthUseCase_Login_Call{Call: _e.mock.On("Login", ctx, email, password)}
+//   - userID int64
+//   - role string
+func (_e *AuthUseCase_Expecter) RevokeRole(ctx interface{}, userID interface{}, role interface{}) *AuthUseCase_RevokeRole_Call {
+	return &AuthUseCase_RevokeRole_Call{Call: _e.mock.On("RevokeRole", ctx, userID, role)}
 }
 
-func (_c *AuthUseCase_Login_Call) Run(run func(ctx context.Context, email string, password string)) *AuthUseCase_Login_Call {
+func (_c *AuthUseCase_RevokeRole_Call) Run(run func(ctx context.Context, userID int64, role string)) *AuthUseCase_RevokeRole_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *AuthUseCase_Login_Call) Return(_a0 *domain.LoginResponse, _a1 error) *AuthUseCase_Login_Call {
+func (_c *AuthUseCase_RevokeRole_Call) Return(_a0 *domain.User, _a1 error) *AuthUseCase_RevokeRole_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *AuthUseCase_Login_Call) RunAndReturn(run func(context.Context, string, string) (*domain.LoginResponse, error)) *AuthUseCase_Login_Call {
+func (_c *AuthUseCase_RevokeRole_Call) RunAndReturn(run func(context.Context, int64, string) (*domain.User, error)) *AuthUseCase_RevokeRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Logout provides a mock function with given fields: ctx, userID
-func (_m *AuthUseCase) Logout(ctx context.Context, userID int64) error {
-	ret := _m.Called(ctx, userID)
+// RevokeSession provides a mock function with given fields: ctx, userID, sessionID
+func (_m *AuthUseCase) RevokeSession(ctx context.Context, userID int64, sessionID string) error {
+	ret := _m.Called(ctx, userID, sessionID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Logout")
+		panic("no return value specified for RevokeSession")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, userID, sessionID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -100,90 +1477,79 @@ func (_m *AuthUseCase) Logout(ctx context.Context, userID int64) error {
 	return r0
 }
 
-// AuthUseCase_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
-type AuthUseCase_Logout_Call struct {
+// AuthUseCase_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type AuthUseCase_RevokeSession_Call struct {
 	*mock.Call
 }
 
-// Logout is a helper method to define mock.On call
+// RevokeSession is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID int64
-func (_e *AuthUseCase_Expecter) Logout(ctx interface{}, userID interface{}) *AuthUseCase_Logout_Call {
-	return &AuthUseCase_Logout_Call{Call: _e.mock.On("Logout", ctx, userID)}
+//   - sessionID string
+func (_e *AuthUseCase_Expecter) RevokeSession(ctx interface{}, userID interface{}, sessionID interface{}) *AuthUseCase_RevokeSession_Call {
+	return &AuthUseCase_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, userID, sessionID)}
 }
 
-func (_c *AuthUseCase_Logout_Call) Run(run func(ctx context.Context, userID int64)) *AuthUseCase_Logout_Call {
+func (_c *AuthUseCase_RevokeSession_Call) Run(run func(ctx context.Context, userID int64, sessionID string)) *AuthUseCase_RevokeSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *AuthUseCase_Logout_Call) Return(_a0 error) *AuthUseCase_Logout_Call {
+func (_c *AuthUseCase_RevokeSession_Call) Return(_a0 error) *AuthUseCase_RevokeSession_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *AuthUseCase_Logout_Call) RunAndReturn(run func(context.Context, int64) error) *AuthUseCase_Logout_Call {
+func (_c *AuthUseCase_RevokeSession_Call) RunAndReturn(run func(context.Context, int64, string) error) *AuthUseCase_RevokeSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RefreshToken provides a mock function with given fields: ctx, refreshToken
-func (_m *AuthUseCase) RefreshToken(ctx context.Context, refreshToken string) (*domain.LoginResponse, error) {
-	ret := _m.Called(ctx, refreshToken)
+// RevokeUserTokens provides a mock function with given fields: ctx, userID
+func (_m *AuthUseCase) RevokeUserTokens(ctx context.Context, userID int64) error {
+	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RefreshToken")
-	}
-
-	var r0 *domain.LoginResponse
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.LoginResponse, error)); ok {
-		return rf(ctx, refreshToken)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.LoginResponse); ok {
-		r0 = rf(ctx, refreshToken)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.LoginResponse)
-		}
+		panic("no return value specified for RevokeUserTokens")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, refreshToken)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, userID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// AuthUseCase_RefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshToken'
-type AuthUseCase_RefreshToken_Call struct {
+// AuthUseCase_RevokeUserTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserTokens'
+type AuthUseCase_RevokeUserTokens_Call struct {
 	*mock.Call
 }
 
-// RefreshToken is a helper method to define mock.On call
+// RevokeUserTokens is a helper method to define mock.On call
 //   - ctx context.Context
-//   - refreshToken string
-func (_e *AuthUseCase_Expecter) RefreshToken(ctx interface{}, refreshToken interface{}) *AuthUseCase_RefreshToken_Call {
-	return &AuthUseCase_RefreshToken_Call{Call: _e.mock.On("RefreshToken", ctx, refreshToken)}
+//   - userID int64
+func (_e *AuthUseCase_Expecter) RevokeUserTokens(ctx interface{}, userID interface{}) *AuthUseCase_RevokeUserTokens_Call {
+	return &AuthUseCase_RevokeUserTokens_Call{Call: _e.mock.On("RevokeUserTokens", ctx, userID)}
 }
 
-func (_c *AuthUseCase_RefreshToken_Call) Run(run func(ctx context.Context, refreshToken string)) *AuthUseCase_RefreshToken_Call {
+func (_c *AuthUseCase_RevokeUserTokens_Call) Run(run func(ctx context.Context, userID int64)) *AuthUseCase_RevokeUserTokens_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *AuthUseCase_RefreshToken_Call) Return(_a0 *domain.LoginResponse, _a1 error) *AuthUseCase_RefreshToken_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *AuthUseCase_RevokeUserTokens_Call) Return(_a0 error) *AuthUseCase_RevokeUserTokens_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *AuthUseCase_RefreshToken_Call) RunAndReturn(run func(context.Context, string) (*domain.LoginResponse, error)) *AuthUseCase_RefreshToken_Call {
+func (_c *AuthUseCase_RevokeUserTokens_Call) RunAndReturn(run func(context.Context, int64) error) *AuthUseCase_RevokeUserTokens_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -247,6 +1613,277 @@ func (_c *AuthUseCase_SignUpUser_Call) RunAndReturn(run func(context.Context, *d
 	return _c
 }
 
+// StartOIDCLogin provides a mock function with given fields: ctx
+func (_m *AuthUseCase) StartOIDCLogin(ctx context.Context) (*domain.OIDCAuthRequest, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartOIDCLogin")
+	}
+
+	var r0 *domain.OIDCAuthRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*domain.OIDCAuthRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *domain.OIDCAuthRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.OIDCAuthRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthUseCase_StartOIDCLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartOIDCLogin'
+type AuthUseCase_StartOIDCLogin_Call struct {
+	*mock.Call
+}
+
+// StartOIDCLogin is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AuthUseCase_Expecter) StartOIDCLogin(ctx interface{}) *AuthUseCase_StartOIDCLogin_Call {
+	return &AuthUseCase_StartOIDCLogin_Call{Call: _e.mock.On("StartOIDCLogin", ctx)}
+}
+
+func (_c *AuthUseCase_StartOIDCLogin_Call) Run(run func(ctx context.Context)) *AuthUseCase_StartOIDCLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_StartOIDCLogin_Call) Return(_a0 *domain.OIDCAuthRequest, _a1 error) *AuthUseCase_StartOIDCLogin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthUseCase_StartOIDCLogin_Call) RunAndReturn(run func(context.Context) (*domain.OIDCAuthRequest, error)) *AuthUseCase_StartOIDCLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnlockUser provides a mock function with given fields: ctx, userID
+func (_m *AuthUseCase) UnlockUser(ctx context.Context, userID int64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthUseCase_UnlockUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlockUser'
+type AuthUseCase_UnlockUser_Call struct {
+	*mock.Call
+}
+
+// UnlockUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *AuthUseCase_Expecter) UnlockUser(ctx interface{}, userID interface{}) *AuthUseCase_UnlockUser_Call {
+	return &AuthUseCase_UnlockUser_Call{Call: _e.mock.On("UnlockUser", ctx, userID)}
+}
+
+func (_c *AuthUseCase_UnlockUser_Call) Run(run func(ctx context.Context, userID int64)) *AuthUseCase_UnlockUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_UnlockUser_Call) Return(_a0 error) *AuthUseCase_UnlockUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthUseCase_UnlockUser_Call) RunAndReturn(run func(context.Context, int64) error) *AuthUseCase_UnlockUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProfile provides a mock function with given fields: ctx, userID, req
+func (_m *AuthUseCase) UpdateProfile(ctx context.Context, userID int64, req *domain.UpdateProfileRequest) (*domain.User, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfile")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *domain.UpdateProfileRequest) (*domain.User, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *domain.UpdateProfileRequest) *domain.User); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *domain.UpdateProfileRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthUseCase_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type AuthUseCase_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - req *domain.UpdateProfileRequest
+func (_e *AuthUseCase_Expecter) UpdateProfile(ctx interface{}, userID interface{}, req interface{}) *AuthUseCase_UpdateProfile_Call {
+	return &AuthUseCase_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", ctx, userID, req)}
+}
+
+func (_c *AuthUseCase_UpdateProfile_Call) Run(run func(ctx context.Context, userID int64, req *domain.UpdateProfileRequest)) *AuthUseCase_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*domain.UpdateProfileRequest))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_UpdateProfile_Call) Return(_a0 *domain.User, _a1 error) *AuthUseCase_UpdateProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthUseCase_UpdateProfile_Call) RunAndReturn(run func(context.Context, int64, *domain.UpdateProfileRequest) (*domain.User, error)) *AuthUseCase_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UserInfo provides a mock function with given fields: ctx, userID
+func (_m *AuthUseCase) UserInfo(ctx context.Context, userID int64) (*domain.UserInfoResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserInfo")
+	}
+
+	var r0 *domain.UserInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.UserInfoResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.UserInfoResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UserInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthUseCase_UserInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserInfo'
+type AuthUseCase_UserInfo_Call struct {
+	*mock.Call
+}
+
+// UserInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *AuthUseCase_Expecter) UserInfo(ctx interface{}, userID interface{}) *AuthUseCase_UserInfo_Call {
+	return &AuthUseCase_UserInfo_Call{Call: _e.mock.On("UserInfo", ctx, userID)}
+}
+
+func (_c *AuthUseCase_UserInfo_Call) Run(run func(ctx context.Context, userID int64)) *AuthUseCase_UserInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_UserInfo_Call) Return(_a0 *domain.UserInfoResponse, _a1 error) *AuthUseCase_UserInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthUseCase_UserInfo_Call) RunAndReturn(run func(context.Context, int64) (*domain.UserInfoResponse, error)) *AuthUseCase_UserInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyEmail provides a mock function with given fields: ctx, token
+func (_m *AuthUseCase) VerifyEmail(ctx context.Context, token string) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthUseCase_VerifyEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyEmail'
+type AuthUseCase_VerifyEmail_Call struct {
+	*mock.Call
+}
+
+// VerifyEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *AuthUseCase_Expecter) VerifyEmail(ctx interface{}, token interface{}) *AuthUseCase_VerifyEmail_Call {
+	return &AuthUseCase_VerifyEmail_Call{Call: _e.mock.On("VerifyEmail", ctx, token)}
+}
+
+func (_c *AuthUseCase_VerifyEmail_Call) Run(run func(ctx context.Context, token string)) *AuthUseCase_VerifyEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_VerifyEmail_Call) Return(_a0 error) *AuthUseCase_VerifyEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthUseCase_VerifyEmail_Call) RunAndReturn(run func(context.Context, string) error) *AuthUseCase_VerifyEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewAuthUseCase creates a new instance of AuthUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAuthUseCase(t interface {
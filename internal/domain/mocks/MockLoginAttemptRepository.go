@@ -0,0 +1,248 @@
+// Code generated by mockery v2.52.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// LoginAttemptRepository is an autogenerated mock type for the LoginAttemptRepository type
+type LoginAttemptRepository struct {
+	mock.Mock
+}
+
+type LoginAttemptRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LoginAttemptRepository) EXPECT() *LoginAttemptRepository_Expecter {
+	return &LoginAttemptRepository_Expecter{mock: &_m.Mock}
+}
+
+// Lock provides a mock function with given fields: ctx, key, until
+func (_m *LoginAttemptRepository) Lock(ctx context.Context, key string, until time.Time) error {
+	ret := _m.Called(ctx, key, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, key, until)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LoginAttemptRepository_Lock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lock'
+type LoginAttemptRepository_Lock_Call struct {
+	*mock.Call
+}
+
+// Lock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - until time.Time
+func (_e *LoginAttemptRepository_Expecter) Lock(ctx interface{}, key interface{}, until interface{}) *LoginAttemptRepository_Lock_Call {
+	return &LoginAttemptRepository_Lock_Call{Call: _e.mock.On("Lock", ctx, key, until)}
+}
+
+func (_c *LoginAttemptRepository_Lock_Call) Run(run func(ctx context.Context, key string, until time.Time)) *LoginAttemptRepository_Lock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *LoginAttemptRepository_Lock_Call) Return(_a0 error) *LoginAttemptRepository_Lock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LoginAttemptRepository_Lock_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *LoginAttemptRepository_Lock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockedUntil provides a mock function with given fields: ctx, key
+func (_m *LoginAttemptRepository) LockedUntil(ctx context.Context, key string) (time.Time, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockedUntil")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (time.Time, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) time.Time); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoginAttemptRepository_LockedUntil_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockedUntil'
+type LoginAttemptRepository_LockedUntil_Call struct {
+	*mock.Call
+}
+
+// LockedUntil is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *LoginAttemptRepository_Expecter) LockedUntil(ctx interface{}, key interface{}) *LoginAttemptRepository_LockedUntil_Call {
+	return &LoginAttemptRepository_LockedUntil_Call{Call: _e.mock.On("LockedUntil", ctx, key)}
+}
+
+func (_c *LoginAttemptRepository_LockedUntil_Call) Run(run func(ctx context.Context, key string)) *LoginAttemptRepository_LockedUntil_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LoginAttemptRepository_LockedUntil_Call) Return(_a0 time.Time, _a1 error) *LoginAttemptRepository_LockedUntil_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LoginAttemptRepository_LockedUntil_Call) RunAndReturn(run func(context.Context, string) (time.Time, error)) *LoginAttemptRepository_LockedUntil_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordFailure provides a mock function with given fields: ctx, key, window
+func (_m *LoginAttemptRepository) RecordFailure(ctx context.Context, key string, window time.Duration) (int, error) {
+	ret := _m.Called(ctx, key, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordFailure")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) (int, error)); ok {
+		return rf(ctx, key, window)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) int); ok {
+		r0 = rf(ctx, key, window)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Duration) error); ok {
+		r1 = rf(ctx, key, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoginAttemptRepository_RecordFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordFailure'
+type LoginAttemptRepository_RecordFailure_Call struct {
+	*mock.Call
+}
+
+// RecordFailure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - window time.Duration
+func (_e *LoginAttemptRepository_Expecter) RecordFailure(ctx interface{}, key interface{}, window interface{}) *LoginAttemptRepository_RecordFailure_Call {
+	return &LoginAttemptRepository_RecordFailure_Call{Call: _e.mock.On("RecordFailure", ctx, key, window)}
+}
+
+func (_c *LoginAttemptRepository_RecordFailure_Call) Run(run func(ctx context.Context, key string, window time.Duration)) *LoginAttemptRepository_RecordFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *LoginAttemptRepository_RecordFailure_Call) Return(_a0 int, _a1 error) *LoginAttemptRepository_RecordFailure_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LoginAttemptRepository_RecordFailure_Call) RunAndReturn(run func(context.Context, string, time.Duration) (int, error)) *LoginAttemptRepository_RecordFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reset provides a mock function with given fields: ctx, key
+func (_m *LoginAttemptRepository) Reset(ctx context.Context, key string) error {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reset")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LoginAttemptRepository_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
+type LoginAttemptRepository_Reset_Call struct {
+	*mock.Call
+}
+
+// Reset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *LoginAttemptRepository_Expecter) Reset(ctx interface{}, key interface{}) *LoginAttemptRepository_Reset_Call {
+	return &LoginAttemptRepository_Reset_Call{Call: _e.mock.On("Reset", ctx, key)}
+}
+
+func (_c *LoginAttemptRepository_Reset_Call) Run(run func(ctx context.Context, key string)) *LoginAttemptRepository_Reset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LoginAttemptRepository_Reset_Call) Return(_a0 error) *LoginAttemptRepository_Reset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LoginAttemptRepository_Reset_Call) RunAndReturn(run func(context.Context, string) error) *LoginAttemptRepository_Reset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLoginAttemptRepository creates a new instance of LoginAttemptRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLoginAttemptRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LoginAttemptRepository {
+	mock := &LoginAttemptRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,393 @@
+// Code generated by mockery v2.52.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/nicewook/gocore/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MFARepository is an autogenerated mock type for the MFARepository type
+type MFARepository struct {
+	mock.Mock
+}
+
+type MFARepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MFARepository) EXPECT() *MFARepository_Expecter {
+	return &MFARepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, userID
+func (_m *MFARepository) Delete(ctx context.Context, userID int64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MFARepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MFARepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *MFARepository_Expecter) Delete(ctx interface{}, userID interface{}) *MFARepository_Delete_Call {
+	return &MFARepository_Delete_Call{Call: _e.mock.On("Delete", ctx, userID)}
+}
+
+func (_c *MFARepository_Delete_Call) Run(run func(ctx context.Context, userID int64)) *MFARepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MFARepository_Delete_Call) Return(_a0 error) *MFARepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MFARepository_Delete_Call) RunAndReturn(run func(context.Context, int64) error) *MFARepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Enable provides a mock function with given fields: ctx, userID, recoveryCodeHashes
+func (_m *MFARepository) Enable(ctx context.Context, userID int64, recoveryCodeHashes []string) error {
+	ret := _m.Called(ctx, userID, recoveryCodeHashes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enable")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) error); ok {
+		r0 = rf(ctx, userID, recoveryCodeHashes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MFARepository_Enable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enable'
+type MFARepository_Enable_Call struct {
+	*mock.Call
+}
+
+// Enable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - recoveryCodeHashes []string
+func (_e *MFARepository_Expecter) Enable(ctx interface{}, userID interface{}, recoveryCodeHashes interface{}) *MFARepository_Enable_Call {
+	return &MFARepository_Enable_Call{Call: _e.mock.On("Enable", ctx, userID, recoveryCodeHashes)}
+}
+
+func (_c *MFARepository_Enable_Call) Run(run func(ctx context.Context, userID int64, recoveryCodeHashes []string)) *MFARepository_Enable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MFARepository_Enable_Call) Return(_a0 error) *MFARepository_Enable_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MFARepository_Enable_Call) RunAndReturn(run func(context.Context, int64, []string) error) *MFARepository_Enable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUserID provides a mock function with given fields: ctx, userID
+func (_m *MFARepository) GetByUserID(ctx context.Context, userID int64) (*domain.MFA, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserID")
+	}
+
+	var r0 *domain.MFA
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.MFA, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.MFA); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.MFA)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MFARepository_GetByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserID'
+type MFARepository_GetByUserID_Call struct {
+	*mock.Call
+}
+
+// GetByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *MFARepository_Expecter) GetByUserID(ctx interface{}, userID interface{}) *MFARepository_GetByUserID_Call {
+	return &MFARepository_GetByUserID_Call{Call: _e.mock.On("GetByUserID", ctx, userID)}
+}
+
+func (_c *MFARepository_GetByUserID_Call) Run(run func(ctx context.Context, userID int64)) *MFARepository_GetByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MFARepository_GetByUserID_Call) Return(_a0 *domain.MFA, _a1 error) *MFARepository_GetByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MFARepository_GetByUserID_Call) RunAndReturn(run func(context.Context, int64) (*domain.MFA, error)) *MFARepository_GetByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUnusedRecoveryCodes provides a mock function with given fields: ctx, userID
+func (_m *MFARepository) GetUnusedRecoveryCodes(ctx context.Context, userID int64) ([]domain.RecoveryCode, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnusedRecoveryCodes")
+	}
+
+	var r0 []domain.RecoveryCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]domain.RecoveryCode, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []domain.RecoveryCode); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.RecoveryCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MFARepository_GetUnusedRecoveryCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnusedRecoveryCodes'
+type MFARepository_GetUnusedRecoveryCodes_Call struct {
+	*mock.Call
+}
+
+// GetUnusedRecoveryCodes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *MFARepository_Expecter) GetUnusedRecoveryCodes(ctx interface{}, userID interface{}) *MFARepository_GetUnusedRecoveryCodes_Call {
+	return &MFARepository_GetUnusedRecoveryCodes_Call{Call: _e.mock.On("GetUnusedRecoveryCodes", ctx, userID)}
+}
+
+func (_c *MFARepository_GetUnusedRecoveryCodes_Call) Run(run func(ctx context.Context, userID int64)) *MFARepository_GetUnusedRecoveryCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MFARepository_GetUnusedRecoveryCodes_Call) Return(_a0 []domain.RecoveryCode, _a1 error) *MFARepository_GetUnusedRecoveryCodes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MFARepository_GetUnusedRecoveryCodes_Call) RunAndReturn(run func(context.Context, int64) ([]domain.RecoveryCode, error)) *MFARepository_GetUnusedRecoveryCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveSecret provides a mock function with given fields: ctx, userID, secret
+func (_m *MFARepository) SaveSecret(ctx context.Context, userID int64, secret string) error {
+	ret := _m.Called(ctx, userID, secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSecret")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, userID, secret)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MFARepository_SaveSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSecret'
+type MFARepository_SaveSecret_Call struct {
+	*mock.Call
+}
+
+// SaveSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - secret string
+func (_e *MFARepository_Expecter) SaveSecret(ctx interface{}, userID interface{}, secret interface{}) *MFARepository_SaveSecret_Call {
+	return &MFARepository_SaveSecret_Call{Call: _e.mock.On("SaveSecret", ctx, userID, secret)}
+}
+
+func (_c *MFARepository_SaveSecret_Call) Run(run func(ctx context.Context, userID int64, secret string)) *MFARepository_SaveSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MFARepository_SaveSecret_Call) Return(_a0 error) *MFARepository_SaveSecret_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MFARepository_SaveSecret_Call) RunAndReturn(run func(context.Context, int64, string) error) *MFARepository_SaveSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLastUsedStep provides a mock function with given fields: ctx, userID, step
+func (_m *MFARepository) UpdateLastUsedStep(ctx context.Context, userID int64, step int64) error {
+	ret := _m.Called(ctx, userID, step)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLastUsedStep")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, userID, step)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MFARepository_UpdateLastUsedStep_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLastUsedStep'
+type MFARepository_UpdateLastUsedStep_Call struct {
+	*mock.Call
+}
+
+// UpdateLastUsedStep is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - step int64
+func (_e *MFARepository_Expecter) UpdateLastUsedStep(ctx interface{}, userID interface{}, step interface{}) *MFARepository_UpdateLastUsedStep_Call {
+	return &MFARepository_UpdateLastUsedStep_Call{Call: _e.mock.On("UpdateLastUsedStep", ctx, userID, step)}
+}
+
+func (_c *MFARepository_UpdateLastUsedStep_Call) Run(run func(ctx context.Context, userID int64, step int64)) *MFARepository_UpdateLastUsedStep_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MFARepository_UpdateLastUsedStep_Call) Return(_a0 error) *MFARepository_UpdateLastUsedStep_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MFARepository_UpdateLastUsedStep_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MFARepository_UpdateLastUsedStep_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseRecoveryCode provides a mock function with given fields: ctx, id
+func (_m *MFARepository) UseRecoveryCode(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseRecoveryCode")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MFARepository_UseRecoveryCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseRecoveryCode'
+type MFARepository_UseRecoveryCode_Call struct {
+	*mock.Call
+}
+
+// UseRecoveryCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MFARepository_Expecter) UseRecoveryCode(ctx interface{}, id interface{}) *MFARepository_UseRecoveryCode_Call {
+	return &MFARepository_UseRecoveryCode_Call{Call: _e.mock.On("UseRecoveryCode", ctx, id)}
+}
+
+func (_c *MFARepository_UseRecoveryCode_Call) Run(run func(ctx context.Context, id int64)) *MFARepository_UseRecoveryCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MFARepository_UseRecoveryCode_Call) Return(_a0 error) *MFARepository_UseRecoveryCode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MFARepository_UseRecoveryCode_Call) RunAndReturn(run func(context.Context, int64) error) *MFARepository_UseRecoveryCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMFARepository creates a new instance of MFARepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMFARepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MFARepository {
+	mock := &MFARepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
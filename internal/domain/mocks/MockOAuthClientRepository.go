@@ -0,0 +1,260 @@
+// Code generated by mockery v2.52.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/nicewook/gocore/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OAuthClientRepository is an autogenerated mock type for the OAuthClientRepository type
+type OAuthClientRepository struct {
+	mock.Mock
+}
+
+type OAuthClientRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OAuthClientRepository) EXPECT() *OAuthClientRepository_Expecter {
+	return &OAuthClientRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *OAuthClientRepository) Delete(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OAuthClientRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type OAuthClientRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *OAuthClientRepository_Expecter) Delete(ctx interface{}, id interface{}) *OAuthClientRepository_Delete_Call {
+	return &OAuthClientRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *OAuthClientRepository_Delete_Call) Run(run func(ctx context.Context, id int64)) *OAuthClientRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *OAuthClientRepository_Delete_Call) Return(_a0 error) *OAuthClientRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OAuthClientRepository_Delete_Call) RunAndReturn(run func(context.Context, int64) error) *OAuthClientRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *OAuthClientRepository) GetAll(ctx context.Context) ([]*domain.OAuthClient, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []*domain.OAuthClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.OAuthClient, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.OAuthClient); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.OAuthClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OAuthClientRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type OAuthClientRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OAuthClientRepository_Expecter) GetAll(ctx interface{}) *OAuthClientRepository_GetAll_Call {
+	return &OAuthClientRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *OAuthClientRepository_GetAll_Call) Run(run func(ctx context.Context)) *OAuthClientRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OAuthClientRepository_GetAll_Call) Return(_a0 []*domain.OAuthClient, _a1 error) *OAuthClientRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OAuthClientRepository_GetAll_Call) RunAndReturn(run func(context.Context) ([]*domain.OAuthClient, error)) *OAuthClientRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByClientID provides a mock function with given fields: ctx, clientID
+func (_m *OAuthClientRepository) GetByClientID(ctx context.Context, clientID string) (*domain.OAuthClient, error) {
+	ret := _m.Called(ctx, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByClientID")
+	}
+
+	var r0 *domain.OAuthClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.OAuthClient, error)); ok {
+		return rf(ctx, clientID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.OAuthClient); ok {
+		r0 = rf(ctx, clientID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.OAuthClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OAuthClientRepository_GetByClientID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByClientID'
+type OAuthClientRepository_GetByClientID_Call struct {
+	*mock.Call
+}
+
+// GetByClientID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+func (_e *OAuthClientRepository_Expecter) GetByClientID(ctx interface{}, clientID interface{}) *OAuthClientRepository_GetByClientID_Call {
+	return &OAuthClientRepository_GetByClientID_Call{Call: _e.mock.On("GetByClientID", ctx, clientID)}
+}
+
+func (_c *OAuthClientRepository_GetByClientID_Call) Run(run func(ctx context.Context, clientID string)) *OAuthClientRepository_GetByClientID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OAuthClientRepository_GetByClientID_Call) Return(_a0 *domain.OAuthClient, _a1 error) *OAuthClientRepository_GetByClientID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OAuthClientRepository_GetByClientID_Call) RunAndReturn(run func(context.Context, string) (*domain.OAuthClient, error)) *OAuthClientRepository_GetByClientID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, client
+func (_m *OAuthClientRepository) Save(ctx context.Context, client *domain.OAuthClient) (*domain.OAuthClient, error) {
+	ret := _m.Called(ctx, client)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 *domain.OAuthClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.OAuthClient) (*domain.OAuthClient, error)); ok {
+		return rf(ctx, client)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.OAuthClient) *domain.OAuthClient); ok {
+		r0 = rf(ctx, client)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.OAuthClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.OAuthClient) error); ok {
+		r1 = rf(ctx, client)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OAuthClientRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type OAuthClientRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - client *domain.OAuthClient
+func (_e *OAuthClientRepository_Expecter) Save(ctx interface{}, client interface{}) *OAuthClientRepository_Save_Call {
+	return &OAuthClientRepository_Save_Call{Call: _e.mock.On("Save", ctx, client)}
+}
+
+func (_c *OAuthClientRepository_Save_Call) Run(run func(ctx context.Context, client *domain.OAuthClient)) *OAuthClientRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.OAuthClient))
+	})
+	return _c
+}
+
+func (_c *OAuthClientRepository_Save_Call) Return(_a0 *domain.OAuthClient, _a1 error) *OAuthClientRepository_Save_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OAuthClientRepository_Save_Call) RunAndReturn(run func(context.Context, *domain.OAuthClient) (*domain.OAuthClient, error)) *OAuthClientRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOAuthClientRepository creates a new instance of OAuthClientRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOAuthClientRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OAuthClientRepository {
+	mock := &OAuthClientRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
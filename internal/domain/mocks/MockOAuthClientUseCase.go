@@ -0,0 +1,201 @@
+// Code generated by mockery v2.52.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/nicewook/gocore/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OAuthClientUseCase is an autogenerated mock type for the OAuthClientUseCase type
+type OAuthClientUseCase struct {
+	mock.Mock
+}
+
+type OAuthClientUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OAuthClientUseCase) EXPECT() *OAuthClientUseCase_Expecter {
+	return &OAuthClientUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *OAuthClientUseCase) Create(ctx context.Context, req *domain.CreateOAuthClientRequest) (*domain.CreateOAuthClientResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.CreateOAuthClientResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateOAuthClientRequest) (*domain.CreateOAuthClientResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateOAuthClientRequest) *domain.CreateOAuthClientResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CreateOAuthClientResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateOAuthClientRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OAuthClientUseCase_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type OAuthClientUseCase_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreateOAuthClientRequest
+func (_e *OAuthClientUseCase_Expecter) Create(ctx interface{}, req interface{}) *OAuthClientUseCase_Create_Call {
+	return &OAuthClientUseCase_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *OAuthClientUseCase_Create_Call) Run(run func(ctx context.Context, req *domain.CreateOAuthClientRequest)) *OAuthClientUseCase_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreateOAuthClientRequest))
+	})
+	return _c
+}
+
+func (_c *OAuthClientUseCase_Create_Call) Return(_a0 *domain.CreateOAuthClientResponse, _a1 error) *OAuthClientUseCase_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OAuthClientUseCase_Create_Call) RunAndReturn(run func(context.Context, *domain.CreateOAuthClientRequest) (*domain.CreateOAuthClientResponse, error)) *OAuthClientUseCase_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *OAuthClientUseCase) Delete(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OAuthClientUseCase_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type OAuthClientUseCase_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *OAuthClientUseCase_Expecter) Delete(ctx interface{}, id interface{}) *OAuthClientUseCase_Delete_Call {
+	return &OAuthClientUseCase_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *OAuthClientUseCase_Delete_Call) Run(run func(ctx context.Context, id int64)) *OAuthClientUseCase_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *OAuthClientUseCase_Delete_Call) Return(_a0 error) *OAuthClientUseCase_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OAuthClientUseCase_Delete_Call) RunAndReturn(run func(context.Context, int64) error) *OAuthClientUseCase_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *OAuthClientUseCase) GetAll(ctx context.Context) ([]*domain.OAuthClient, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []*domain.OAuthClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.OAuthClient, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.OAuthClient); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.OAuthClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OAuthClientUseCase_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type OAuthClientUseCase_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OAuthClientUseCase_Expecter) GetAll(ctx interface{}) *OAuthClientUseCase_GetAll_Call {
+	return &OAuthClientUseCase_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *OAuthClientUseCase_GetAll_Call) Run(run func(ctx context.Context)) *OAuthClientUseCase_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OAuthClientUseCase_GetAll_Call) Return(_a0 []*domain.OAuthClient, _a1 error) *OAuthClientUseCase_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OAuthClientUseCase_GetAll_Call) RunAndReturn(run func(context.Context) ([]*domain.OAuthClient, error)) *OAuthClientUseCase_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOAuthClientUseCase creates a new instance of OAuthClientUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOAuthClientUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OAuthClientUseCase {
+	mock := &OAuthClientUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,324 @@
+// Code generated by mockery v2.52.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/nicewook/gocore/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// OneTimeTokenRepository is an autogenerated mock type for the OneTimeTokenRepository type
+type OneTimeTokenRepository struct {
+	mock.Mock
+}
+
+type OneTimeTokenRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OneTimeTokenRepository) EXPECT() *OneTimeTokenRepository_Expecter {
+	return &OneTimeTokenRepository_Expecter{mock: &_m.Mock}
+}
+
+// Consume provides a mock function with given fields: ctx, purpose, tokenHash
+func (_m *OneTimeTokenRepository) Consume(ctx context.Context, purpose domain.OneTimeTokenPurpose, tokenHash string) (*domain.OneTimeToken, error) {
+	ret := _m.Called(ctx, purpose, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Consume")
+	}
+
+	var r0 *domain.OneTimeToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.OneTimeTokenPurpose, string) (*domain.OneTimeToken, error)); ok {
+		return rf(ctx, purpose, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.OneTimeTokenPurpose, string) *domain.OneTimeToken); ok {
+		r0 = rf(ctx, purpose, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.OneTimeToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.OneTimeTokenPurpose, string) error); ok {
+		r1 = rf(ctx, purpose, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OneTimeTokenRepository_Consume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Consume'
+type OneTimeTokenRepository_Consume_Call struct {
+	*mock.Call
+}
+
+// Consume is a helper method to define mock.On call
+//   - ctx context.Context
+//   - purpose domain.OneTimeTokenPurpose
+//   - tokenHash string
+func (_e *OneTimeTokenRepository_Expecter) Consume(ctx interface{}, purpose interface{}, tokenHash interface{}) *OneTimeTokenRepository_Consume_Call {
+	return &OneTimeTokenRepository_Consume_Call{Call: _e.mock.On("Consume", ctx, purpose, tokenHash)}
+}
+
+func (_c *OneTimeTokenRepository_Consume_Call) Run(run func(ctx context.Context, purpose domain.OneTimeTokenPurpose, tokenHash string)) *OneTimeTokenRepository_Consume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.OneTimeTokenPurpose), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OneTimeTokenRepository_Consume_Call) Return(_a0 *domain.OneTimeToken, _a1 error) *OneTimeTokenRepository_Consume_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OneTimeTokenRepository_Consume_Call) RunAndReturn(run func(context.Context, domain.OneTimeTokenPurpose, string) (*domain.OneTimeToken, error)) *OneTimeTokenRepository_Consume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByUserID provides a mock function with given fields: ctx, userID, purpose
+func (_m *OneTimeTokenRepository) DeleteByUserID(ctx context.Context, userID int64, purpose domain.OneTimeTokenPurpose) error {
+	ret := _m.Called(ctx, userID, purpose)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, domain.OneTimeTokenPurpose) error); ok {
+		r0 = rf(ctx, userID, purpose)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OneTimeTokenRepository_DeleteByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByUserID'
+type OneTimeTokenRepository_DeleteByUserID_Call struct {
+	*mock.Call
+}
+
+// DeleteByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - purpose domain.OneTimeTokenPurpose
+func (_e *OneTimeTokenRepository_Expecter) DeleteByUserID(ctx interface{}, userID interface{}, purpose interface{}) *OneTimeTokenRepository_DeleteByUserID_Call {
+	return &OneTimeTokenRepository_DeleteByUserID_Call{Call: _e.mock.On("DeleteByUserID", ctx, userID, purpose)}
+}
+
+func (_c *OneTimeTokenRepository_DeleteByUserID_Call) Run(run func(ctx context.Context, userID int64, purpose domain.OneTimeTokenPurpose)) *OneTimeTokenRepository_DeleteByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(domain.OneTimeTokenPurpose))
+	})
+	return _c
+}
+
+func (_c *OneTimeTokenRepository_DeleteByUserID_Call) Return(_a0 error) *OneTimeTokenRepository_DeleteByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OneTimeTokenRepository_DeleteByUserID_Call) RunAndReturn(run func(context.Context, int64, domain.OneTimeTokenPurpose) error) *OneTimeTokenRepository_DeleteByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function with given fields: ctx, purpose, tokenHash
+func (_m *OneTimeTokenRepository) Find(ctx context.Context, purpose domain.OneTimeTokenPurpose, tokenHash string) (*domain.OneTimeToken, error) {
+	ret := _m.Called(ctx, purpose, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *domain.OneTimeToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.OneTimeTokenPurpose, string) (*domain.OneTimeToken, error)); ok {
+		return rf(ctx, purpose, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.OneTimeTokenPurpose, string) *domain.OneTimeToken); ok {
+		r0 = rf(ctx, purpose, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.OneTimeToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.OneTimeTokenPurpose, string) error); ok {
+		r1 = rf(ctx, purpose, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OneTimeTokenRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type OneTimeTokenRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - purpose domain.OneTimeTokenPurpose
+//   - tokenHash string
+func (_e *OneTimeTokenRepository_Expecter) Find(ctx interface{}, purpose interface{}, tokenHash interface{}) *OneTimeTokenRepository_Find_Call {
+	return &OneTimeTokenRepository_Find_Call{Call: _e.mock.On("Find", ctx, purpose, tokenHash)}
+}
+
+func (_c *OneTimeTokenRepository_Find_Call) Run(run func(ctx context.Context, purpose domain.OneTimeTokenPurpose, tokenHash string)) *OneTimeTokenRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.OneTimeTokenPurpose), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OneTimeTokenRepository_Find_Call) Return(_a0 *domain.OneTimeToken, _a1 error) *OneTimeTokenRepository_Find_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OneTimeTokenRepository_Find_Call) RunAndReturn(run func(context.Context, domain.OneTimeTokenPurpose, string) (*domain.OneTimeToken, error)) *OneTimeTokenRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LatestCreatedAt provides a mock function with given fields: ctx, userID, purpose
+func (_m *OneTimeTokenRepository) LatestCreatedAt(ctx context.Context, userID int64, purpose domain.OneTimeTokenPurpose) (time.Time, error) {
+	ret := _m.Called(ctx, userID, purpose)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestCreatedAt")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, domain.OneTimeTokenPurpose) (time.Time, error)); ok {
+		return rf(ctx, userID, purpose)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, domain.OneTimeTokenPurpose) time.Time); ok {
+		r0 = rf(ctx, userID, purpose)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, domain.OneTimeTokenPurpose) error); ok {
+		r1 = rf(ctx, userID, purpose)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OneTimeTokenRepository_LatestCreatedAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LatestCreatedAt'
+type OneTimeTokenRepository_LatestCreatedAt_Call struct {
+	*mock.Call
+}
+
+// LatestCreatedAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - purpose domain.OneTimeTokenPurpose
+func (_e *OneTimeTokenRepository_Expecter) LatestCreatedAt(ctx interface{}, userID interface{}, purpose interface{}) *OneTimeTokenRepository_LatestCreatedAt_Call {
+	return &OneTimeTokenRepository_LatestCreatedAt_Call{Call: _e.mock.On("LatestCreatedAt", ctx, userID, purpose)}
+}
+
+func (_c *OneTimeTokenRepository_LatestCreatedAt_Call) Run(run func(ctx context.Context, userID int64, purpose domain.OneTimeTokenPurpose)) *OneTimeTokenRepository_LatestCreatedAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(domain.OneTimeTokenPurpose))
+	})
+	return _c
+}
+
+func (_c *OneTimeTokenRepository_LatestCreatedAt_Call) Return(_a0 time.Time, _a1 error) *OneTimeTokenRepository_LatestCreatedAt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OneTimeTokenRepository_LatestCreatedAt_Call) RunAndReturn(run func(context.Context, int64, domain.OneTimeTokenPurpose) (time.Time, error)) *OneTimeTokenRepository_LatestCreatedAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, token
+func (_m *OneTimeTokenRepository) Save(ctx context.Context, token *domain.OneTimeToken) (*domain.OneTimeToken, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 *domain.OneTimeToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.OneTimeToken) (*domain.OneTimeToken, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.OneTimeToken) *domain.OneTimeToken); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.OneTimeToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.OneTimeToken) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OneTimeTokenRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type OneTimeTokenRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *domain.OneTimeToken
+func (_e *OneTimeTokenRepository_Expecter) Save(ctx interface{}, token interface{}) *OneTimeTokenRepository_Save_Call {
+	return &OneTimeTokenRepository_Save_Call{Call: _e.mock.On("Save", ctx, token)}
+}
+
+func (_c *OneTimeTokenRepository_Save_Call) Run(run func(ctx context.Context, token *domain.OneTimeToken)) *OneTimeTokenRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.OneTimeToken))
+	})
+	return _c
+}
+
+func (_c *OneTimeTokenRepository_Save_Call) Return(_a0 *domain.OneTimeToken, _a1 error) *OneTimeTokenRepository_Save_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OneTimeTokenRepository_Save_Call) RunAndReturn(run func(context.Context, *domain.OneTimeToken) (*domain.OneTimeToken, error)) *OneTimeTokenRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOneTimeTokenRepository creates a new instance of OneTimeTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOneTimeTokenRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OneTimeTokenRepository {
+	mock := &OneTimeTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
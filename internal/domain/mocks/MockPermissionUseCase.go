@@ -0,0 +1,153 @@
+// Code generated by mockery v2.52.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PermissionUseCase is an autogenerated mock type for the PermissionUseCase type
+type PermissionUseCase struct {
+	mock.Mock
+}
+
+type PermissionUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PermissionUseCase) EXPECT() *PermissionUseCase_Expecter {
+	return &PermissionUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Resolve provides a mock function with given fields: ctx, roles
+func (_m *PermissionUseCase) Resolve(ctx context.Context, roles []string) ([]string, error) {
+	ret := _m.Called(ctx, roles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resolve")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]string, error)); ok {
+		return rf(ctx, roles)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = rf(ctx, roles)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, roles)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PermissionUseCase_Resolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resolve'
+type PermissionUseCase_Resolve_Call struct {
+	*mock.Call
+}
+
+// Resolve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roles []string
+func (_e *PermissionUseCase_Expecter) Resolve(ctx interface{}, roles interface{}) *PermissionUseCase_Resolve_Call {
+	return &PermissionUseCase_Resolve_Call{Call: _e.mock.On("Resolve", ctx, roles)}
+}
+
+func (_c *PermissionUseCase_Resolve_Call) Run(run func(ctx context.Context, roles []string)) *PermissionUseCase_Resolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *PermissionUseCase_Resolve_Call) Return(_a0 []string, _a1 error) *PermissionUseCase_Resolve_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PermissionUseCase_Resolve_Call) RunAndReturn(run func(context.Context, []string) ([]string, error)) *PermissionUseCase_Resolve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RolePermissions provides a mock function with given fields: ctx
+func (_m *PermissionUseCase) RolePermissions(ctx context.Context) (map[string][]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RolePermissions")
+	}
+
+	var r0 map[string][]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string][]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string][]string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PermissionUseCase_RolePermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RolePermissions'
+type PermissionUseCase_RolePermissions_Call struct {
+	*mock.Call
+}
+
+// RolePermissions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PermissionUseCase_Expecter) RolePermissions(ctx interface{}) *PermissionUseCase_RolePermissions_Call {
+	return &PermissionUseCase_RolePermissions_Call{Call: _e.mock.On("RolePermissions", ctx)}
+}
+
+func (_c *PermissionUseCase_RolePermissions_Call) Run(run func(ctx context.Context)) *PermissionUseCase_RolePermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PermissionUseCase_RolePermissions_Call) Return(_a0 map[string][]string, _a1 error) *PermissionUseCase_RolePermissions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PermissionUseCase_RolePermissions_Call) RunAndReturn(run func(context.Context) (map[string][]string, error)) *PermissionUseCase_RolePermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPermissionUseCase creates a new instance of PermissionUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPermissionUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PermissionUseCase {
+	mock := &PermissionUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,296 @@
+// Code generated by mockery v2.52.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/nicewook/gocore/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RefreshTokenRepository is an autogenerated mock type for the RefreshTokenRepository type
+type RefreshTokenRepository struct {
+	mock.Mock
+}
+
+type RefreshTokenRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RefreshTokenRepository) EXPECT() *RefreshTokenRepository_Expecter {
+	return &RefreshTokenRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetByHash provides a mock function with given fields: ctx, tokenHash
+func (_m *RefreshTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByHash")
+	}
+
+	var r0 *domain.RefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.RefreshToken, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.RefreshToken); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RefreshToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshTokenRepository_GetByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByHash'
+type RefreshTokenRepository_GetByHash_Call struct {
+	*mock.Call
+}
+
+// GetByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *RefreshTokenRepository_Expecter) GetByHash(ctx interface{}, tokenHash interface{}) *RefreshTokenRepository_GetByHash_Call {
+	return &RefreshTokenRepository_GetByHash_Call{Call: _e.mock.On("GetByHash", ctx, tokenHash)}
+}
+
+func (_c *RefreshTokenRepository_GetByHash_Call) Run(run func(ctx context.Context, tokenHash string)) *RefreshTokenRepository_GetByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RefreshTokenRepository_GetByHash_Call) Return(_a0 *domain.RefreshToken, _a1 error) *RefreshTokenRepository_GetByHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RefreshTokenRepository_GetByHash_Call) RunAndReturn(run func(context.Context, string) (*domain.RefreshToken, error)) *RefreshTokenRepository_GetByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkRotated provides a mock function with given fields: ctx, id
+func (_m *RefreshTokenRepository) MarkRotated(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRotated")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefreshTokenRepository_MarkRotated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkRotated'
+type RefreshTokenRepository_MarkRotated_Call struct {
+	*mock.Call
+}
+
+// MarkRotated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *RefreshTokenRepository_Expecter) MarkRotated(ctx interface{}, id interface{}) *RefreshTokenRepository_MarkRotated_Call {
+	return &RefreshTokenRepository_MarkRotated_Call{Call: _e.mock.On("MarkRotated", ctx, id)}
+}
+
+func (_c *RefreshTokenRepository_MarkRotated_Call) Run(run func(ctx context.Context, id int64)) *RefreshTokenRepository_MarkRotated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *RefreshTokenRepository_MarkRotated_Call) Return(_a0 error) *RefreshTokenRepository_MarkRotated_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RefreshTokenRepository_MarkRotated_Call) RunAndReturn(run func(context.Context, int64) error) *RefreshTokenRepository_MarkRotated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAllByUserID provides a mock function with given fields: ctx, userID
+func (_m *RefreshTokenRepository) RevokeAllByUserID(ctx context.Context, userID int64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAllByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefreshTokenRepository_RevokeAllByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllByUserID'
+type RefreshTokenRepository_RevokeAllByUserID_Call struct {
+	*mock.Call
+}
+
+// RevokeAllByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *RefreshTokenRepository_Expecter) RevokeAllByUserID(ctx interface{}, userID interface{}) *RefreshTokenRepository_RevokeAllByUserID_Call {
+	return &RefreshTokenRepository_RevokeAllByUserID_Call{Call: _e.mock.On("RevokeAllByUserID", ctx, userID)}
+}
+
+func (_c *RefreshTokenRepository_RevokeAllByUserID_Call) Run(run func(ctx context.Context, userID int64)) *RefreshTokenRepository_RevokeAllByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *RefreshTokenRepository_RevokeAllByUserID_Call) Return(_a0 error) *RefreshTokenRepository_RevokeAllByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RefreshTokenRepository_RevokeAllByUserID_Call) RunAndReturn(run func(context.Context, int64) error) *RefreshTokenRepository_RevokeAllByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeFamily provides a mock function with given fields: ctx, familyID
+func (_m *RefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeFamily")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefreshTokenRepository_RevokeFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeFamily'
+type RefreshTokenRepository_RevokeFamily_Call struct {
+	*mock.Call
+}
+
+// RevokeFamily is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID string
+func (_e *RefreshTokenRepository_Expecter) RevokeFamily(ctx interface{}, familyID interface{}) *RefreshTokenRepository_RevokeFamily_Call {
+	return &RefreshTokenRepository_RevokeFamily_Call{Call: _e.mock.On("RevokeFamily", ctx, familyID)}
+}
+
+func (_c *RefreshTokenRepository_RevokeFamily_Call) Run(run func(ctx context.Context, familyID string)) *RefreshTokenRepository_RevokeFamily_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RefreshTokenRepository_RevokeFamily_Call) Return(_a0 error) *RefreshTokenRepository_RevokeFamily_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RefreshTokenRepository_RevokeFamily_Call) RunAndReturn(run func(context.Context, string) error) *RefreshTokenRepository_RevokeFamily_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, token
+func (_m *RefreshTokenRepository) Save(ctx context.Context, token *domain.RefreshToken) (*domain.RefreshToken, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 *domain.RefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.RefreshToken) (*domain.RefreshToken, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.RefreshToken) *domain.RefreshToken); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RefreshToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.RefreshToken) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshTokenRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type RefreshTokenRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *domain.RefreshToken
+func (_e *RefreshTokenRepository_Expecter) Save(ctx interface{}, token interface{}) *RefreshTokenRepository_Save_Call {
+	return &RefreshTokenRepository_Save_Call{Call: _e.mock.On("Save", ctx, token)}
+}
+
+func (_c *RefreshTokenRepository_Save_Call) Run(run func(ctx context.Context, token *domain.RefreshToken)) *RefreshTokenRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.RefreshToken))
+	})
+	return _c
+}
+
+func (_c *RefreshTokenRepository_Save_Call) Return(_a0 *domain.RefreshToken, _a1 error) *RefreshTokenRepository_Save_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RefreshTokenRepository_Save_Call) RunAndReturn(run func(context.Context, *domain.RefreshToken) (*domain.RefreshToken, error)) *RefreshTokenRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRefreshTokenRepository creates a new instance of RefreshTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRefreshTokenRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RefreshTokenRepository {
+	mock := &RefreshTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
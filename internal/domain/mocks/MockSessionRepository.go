@@ -0,0 +1,289 @@
+// Code generated by mockery v2.52.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/nicewook/gocore/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SessionRepository is an autogenerated mock type for the SessionRepository type
+type SessionRepository struct {
+	mock.Mock
+}
+
+type SessionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SessionRepository) EXPECT() *SessionRepository_Expecter {
+	return &SessionRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, session
+func (_m *SessionRepository) Create(ctx context.Context, session *domain.Session) error {
+	ret := _m.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Session) error); ok {
+		r0 = rf(ctx, session)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SessionRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type SessionRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session *domain.Session
+func (_e *SessionRepository_Expecter) Create(ctx interface{}, session interface{}) *SessionRepository_Create_Call {
+	return &SessionRepository_Create_Call{Call: _e.mock.On("Create", ctx, session)}
+}
+
+func (_c *SessionRepository_Create_Call) Run(run func(ctx context.Context, session *domain.Session)) *SessionRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Session))
+	})
+	return _c
+}
+
+func (_c *SessionRepository_Create_Call) Return(_a0 error) *SessionRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.Session) error) *SessionRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, userID, id
+func (_m *SessionRepository) Delete(ctx context.Context, userID int64, id string) error {
+	ret := _m.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SessionRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type SessionRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - id string
+func (_e *SessionRepository_Expecter) Delete(ctx interface{}, userID interface{}, id interface{}) *SessionRepository_Delete_Call {
+	return &SessionRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, userID, id)}
+}
+
+func (_c *SessionRepository_Delete_Call) Run(run func(ctx context.Context, userID int64, id string)) *SessionRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SessionRepository_Delete_Call) Return(_a0 error) *SessionRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionRepository_Delete_Call) RunAndReturn(run func(context.Context, int64, string) error) *SessionRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAllByUserID provides a mock function with given fields: ctx, userID
+func (_m *SessionRepository) DeleteAllByUserID(ctx context.Context, userID int64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAllByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SessionRepository_DeleteAllByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllByUserID'
+type SessionRepository_DeleteAllByUserID_Call struct {
+	*mock.Call
+}
+
+// DeleteAllByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *SessionRepository_Expecter) DeleteAllByUserID(ctx interface{}, userID interface{}) *SessionRepository_DeleteAllByUserID_Call {
+	return &SessionRepository_DeleteAllByUserID_Call{Call: _e.mock.On("DeleteAllByUserID", ctx, userID)}
+}
+
+func (_c *SessionRepository_DeleteAllByUserID_Call) Run(run func(ctx context.Context, userID int64)) *SessionRepository_DeleteAllByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *SessionRepository_DeleteAllByUserID_Call) Return(_a0 error) *SessionRepository_DeleteAllByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionRepository_DeleteAllByUserID_Call) RunAndReturn(run func(context.Context, int64) error) *SessionRepository_DeleteAllByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserID provides a mock function with given fields: ctx, userID
+func (_m *SessionRepository) ListByUserID(ctx context.Context, userID int64) ([]*domain.Session, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUserID")
+	}
+
+	var r0 []*domain.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*domain.Session, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*domain.Session); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionRepository_ListByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserID'
+type SessionRepository_ListByUserID_Call struct {
+	*mock.Call
+}
+
+// ListByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *SessionRepository_Expecter) ListByUserID(ctx interface{}, userID interface{}) *SessionRepository_ListByUserID_Call {
+	return &SessionRepository_ListByUserID_Call{Call: _e.mock.On("ListByUserID", ctx, userID)}
+}
+
+func (_c *SessionRepository_ListByUserID_Call) Run(run func(ctx context.Context, userID int64)) *SessionRepository_ListByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *SessionRepository_ListByUserID_Call) Return(_a0 []*domain.Session, _a1 error) *SessionRepository_ListByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SessionRepository_ListByUserID_Call) RunAndReturn(run func(context.Context, int64) ([]*domain.Session, error)) *SessionRepository_ListByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Touch provides a mock function with given fields: ctx, id, ip, expiresAt
+func (_m *SessionRepository) Touch(ctx context.Context, id string, ip string, expiresAt time.Time) error {
+	ret := _m.Called(ctx, id, ip, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Touch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = rf(ctx, id, ip, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SessionRepository_Touch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Touch'
+type SessionRepository_Touch_Call struct {
+	*mock.Call
+}
+
+// Touch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - ip string
+//   - expiresAt time.Time
+func (_e *SessionRepository_Expecter) Touch(ctx interface{}, id interface{}, ip interface{}, expiresAt interface{}) *SessionRepository_Touch_Call {
+	return &SessionRepository_Touch_Call{Call: _e.mock.On("Touch", ctx, id, ip, expiresAt)}
+}
+
+func (_c *SessionRepository_Touch_Call) Run(run func(ctx context.Context, id string, ip string, expiresAt time.Time)) *SessionRepository_Touch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *SessionRepository_Touch_Call) Return(_a0 error) *SessionRepository_Touch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionRepository_Touch_Call) RunAndReturn(run func(context.Context, string, string, time.Time) error) *SessionRepository_Touch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSessionRepository creates a new instance of SessionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSessionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SessionRepository {
+	mock := &SessionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
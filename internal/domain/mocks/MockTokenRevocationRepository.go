@@ -0,0 +1,194 @@
+// Code generated by mockery v2.52.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// TokenRevocationRepository is an autogenerated mock type for the TokenRevocationRepository type
+type TokenRevocationRepository struct {
+	mock.Mock
+}
+
+type TokenRevocationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TokenRevocationRepository) EXPECT() *TokenRevocationRepository_Expecter {
+	return &TokenRevocationRepository_Expecter{mock: &_m.Mock}
+}
+
+// IsRevoked provides a mock function with given fields: ctx, jti, userID, issuedAt
+func (_m *TokenRevocationRepository) IsRevoked(ctx context.Context, jti string, userID int64, issuedAt time.Time) (bool, error) {
+	ret := _m.Called(ctx, jti, userID, issuedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsRevoked")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, time.Time) (bool, error)); ok {
+		return rf(ctx, jti, userID, issuedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, time.Time) bool); ok {
+		r0 = rf(ctx, jti, userID, issuedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, time.Time) error); ok {
+		r1 = rf(ctx, jti, userID, issuedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenRevocationRepository_IsRevoked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsRevoked'
+type TokenRevocationRepository_IsRevoked_Call struct {
+	*mock.Call
+}
+
+// IsRevoked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jti string
+//   - userID int64
+//   - issuedAt time.Time
+func (_e *TokenRevocationRepository_Expecter) IsRevoked(ctx interface{}, jti interface{}, userID interface{}, issuedAt interface{}) *TokenRevocationRepository_IsRevoked_Call {
+	return &TokenRevocationRepository_IsRevoked_Call{Call: _e.mock.On("IsRevoked", ctx, jti, userID, issuedAt)}
+}
+
+func (_c *TokenRevocationRepository_IsRevoked_Call) Run(run func(ctx context.Context, jti string, userID int64, issuedAt time.Time)) *TokenRevocationRepository_IsRevoked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TokenRevocationRepository_IsRevoked_Call) Return(_a0 bool, _a1 error) *TokenRevocationRepository_IsRevoked_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TokenRevocationRepository_IsRevoked_Call) RunAndReturn(run func(context.Context, string, int64, time.Time) (bool, error)) *TokenRevocationRepository_IsRevoked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeToken provides a mock function with given fields: ctx, jti, expiresAt
+func (_m *TokenRevocationRepository) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	ret := _m.Called(ctx, jti, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, jti, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TokenRevocationRepository_RevokeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeToken'
+type TokenRevocationRepository_RevokeToken_Call struct {
+	*mock.Call
+}
+
+// RevokeToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jti string
+//   - expiresAt time.Time
+func (_e *TokenRevocationRepository_Expecter) RevokeToken(ctx interface{}, jti interface{}, expiresAt interface{}) *TokenRevocationRepository_RevokeToken_Call {
+	return &TokenRevocationRepository_RevokeToken_Call{Call: _e.mock.On("RevokeToken", ctx, jti, expiresAt)}
+}
+
+func (_c *TokenRevocationRepository_RevokeToken_Call) Run(run func(ctx context.Context, jti string, expiresAt time.Time)) *TokenRevocationRepository_RevokeToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TokenRevocationRepository_RevokeToken_Call) Return(_a0 error) *TokenRevocationRepository_RevokeToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenRevocationRepository_RevokeToken_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *TokenRevocationRepository_RevokeToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeUserTokens provides a mock function with given fields: ctx, userID, issuedBefore, expiresAt
+func (_m *TokenRevocationRepository) RevokeUserTokens(ctx context.Context, userID int64, issuedBefore time.Time, expiresAt time.Time) error {
+	ret := _m.Called(ctx, userID, issuedBefore, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserTokens")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) error); ok {
+		r0 = rf(ctx, userID, issuedBefore, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TokenRevocationRepository_RevokeUserTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserTokens'
+type TokenRevocationRepository_RevokeUserTokens_Call struct {
+	*mock.Call
+}
+
+// RevokeUserTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - issuedBefore time.Time
+//   - expiresAt time.Time
+func (_e *TokenRevocationRepository_Expecter) RevokeUserTokens(ctx interface{}, userID interface{}, issuedBefore interface{}, expiresAt interface{}) *TokenRevocationRepository_RevokeUserTokens_Call {
+	return &TokenRevocationRepository_RevokeUserTokens_Call{Call: _e.mock.On("RevokeUserTokens", ctx, userID, issuedBefore, expiresAt)}
+}
+
+func (_c *TokenRevocationRepository_RevokeUserTokens_Call) Run(run func(ctx context.Context, userID int64, issuedBefore time.Time, expiresAt time.Time)) *TokenRevocationRepository_RevokeUserTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TokenRevocationRepository_RevokeUserTokens_Call) Return(_a0 error) *TokenRevocationRepository_RevokeUserTokens_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenRevocationRepository_RevokeUserTokens_Call) RunAndReturn(run func(context.Context, int64, time.Time, time.Time) error) *TokenRevocationRepository_RevokeUserTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTokenRevocationRepository creates a new instance of TokenRevocationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTokenRevocationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TokenRevocationRepository {
+	mock := &TokenRevocationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
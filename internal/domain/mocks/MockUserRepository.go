@@ -7,6 +7,8 @@ import (
 
 	domain "github.com/nicewook/gocore/internal/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // UserRepository is an autogenerated mock type for the UserRepository type
@@ -22,6 +24,65 @@ func (_m *UserRepository) EXPECT() *UserRepository_Expecter {
 	return &UserRepository_Expecter{mock: &_m.Mock}
 }
 
+// EraseDeleted provides a mock function with given fields: ctx, deletedBefore
+func (_m *UserRepository) EraseDeleted(ctx context.Context, deletedBefore time.Time) ([]int64, error) {
+	ret := _m.Called(ctx, deletedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EraseDeleted")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]int64, error)); ok {
+		return rf(ctx, deletedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []int64); ok {
+		r0 = rf(ctx, deletedBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, deletedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_EraseDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EraseDeleted'
+type UserRepository_EraseDeleted_Call struct {
+	*mock.Call
+}
+
+// EraseDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deletedBefore time.Time
+func (_e *UserRepository_Expecter) EraseDeleted(ctx interface{}, deletedBefore interface{}) *UserRepository_EraseDeleted_Call {
+	return &UserRepository_EraseDeleted_Call{Call: _e.mock.On("EraseDeleted", ctx, deletedBefore)}
+}
+
+func (_c *UserRepository_EraseDeleted_Call) Run(run func(ctx context.Context, deletedBefore time.Time)) *UserRepository_EraseDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *UserRepository_EraseDeleted_Call) Return(_a0 []int64, _a1 error) *UserRepository_EraseDeleted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_EraseDeleted_Call) RunAndReturn(run func(context.Context, time.Time) ([]int64, error)) *UserRepository_EraseDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAll provides a mock function with given fields: ctx, req
 func (_m *UserRepository) GetAll(ctx context.Context, req *domain.GetAllUsersRequest) (*domain.GetAllResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -81,6 +142,64 @@ func (_c *UserRepository_GetAll_Call) RunAndReturn(run func(context.Context, *do
 	return _c
 }
 
+// GetAllRoles provides a mock function with given fields: ctx
+func (_m *UserRepository) GetAllRoles(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllRoles")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_GetAllRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllRoles'
+type UserRepository_GetAllRoles_Call struct {
+	*mock.Call
+}
+
+// GetAllRoles is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserRepository_Expecter) GetAllRoles(ctx interface{}) *UserRepository_GetAllRoles_Call {
+	return &UserRepository_GetAllRoles_Call{Call: _e.mock.On("GetAllRoles", ctx)}
+}
+
+func (_c *UserRepository_GetAllRoles_Call) Run(run func(ctx context.Context)) *UserRepository_GetAllRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UserRepository_GetAllRoles_Call) Return(_a0 []string, _a1 error) *UserRepository_GetAllRoles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_GetAllRoles_Call) RunAndReturn(run func(context.Context) ([]string, error)) *UserRepository_GetAllRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByID provides a mock function with given fields: ctx, id
 func (_m *UserRepository) GetByID(ctx context.Context, id int64) (*domain.User, error) {
 	ret := _m.Called(ctx, id)
@@ -199,6 +318,196 @@ func (_c *UserRepository_GetUserByEmail_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GrantRole provides a mock function with given fields: ctx, userID, role
+func (_m *UserRepository) GrantRole(ctx context.Context, userID int64, role string) error {
+	ret := _m.Called(ctx, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GrantRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, userID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_GrantRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GrantRole'
+type UserRepository_GrantRole_Call struct {
+	*mock.Call
+}
+
+// GrantRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - role string
+func (_e *UserRepository_Expecter) GrantRole(ctx interface{}, userID interface{}, role interface{}) *UserRepository_GrantRole_Call {
+	return &UserRepository_GrantRole_Call{Call: _e.mock.On("GrantRole", ctx, userID, role)}
+}
+
+func (_c *UserRepository_GrantRole_Call) Run(run func(ctx context.Context, userID int64, role string)) *UserRepository_GrantRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UserRepository_GrantRole_Call) Return(_a0 error) *UserRepository_GrantRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepository_GrantRole_Call) RunAndReturn(run func(context.Context, int64, string) error) *UserRepository_GrantRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkVerified provides a mock function with given fields: ctx, id
+func (_m *UserRepository) MarkVerified(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkVerified")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_MarkVerified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkVerified'
+type UserRepository_MarkVerified_Call struct {
+	*mock.Call
+}
+
+// MarkVerified is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *UserRepository_Expecter) MarkVerified(ctx interface{}, id interface{}) *UserRepository_MarkVerified_Call {
+	return &UserRepository_MarkVerified_Call{Call: _e.mock.On("MarkVerified", ctx, id)}
+}
+
+func (_c *UserRepository_MarkVerified_Call) Run(run func(ctx context.Context, id int64)) *UserRepository_MarkVerified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *UserRepository_MarkVerified_Call) Return(_a0 error) *UserRepository_MarkVerified_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepository_MarkVerified_Call) RunAndReturn(run func(context.Context, int64) error) *UserRepository_MarkVerified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, id
+func (_m *UserRepository) Restore(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type UserRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *UserRepository_Expecter) Restore(ctx interface{}, id interface{}) *UserRepository_Restore_Call {
+	return &UserRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *UserRepository_Restore_Call) Run(run func(ctx context.Context, id int64)) *UserRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *UserRepository_Restore_Call) Return(_a0 error) *UserRepository_Restore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepository_Restore_Call) RunAndReturn(run func(context.Context, int64) error) *UserRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeRole provides a mock function with given fields: ctx, userID, role
+func (_m *UserRepository) RevokeRole(ctx context.Context, userID int64, role string) error {
+	ret := _m.Called(ctx, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, userID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_RevokeRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeRole'
+type UserRepository_RevokeRole_Call struct {
+	*mock.Call
+}
+
+// RevokeRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - role string
+func (_e *UserRepository_Expecter) RevokeRole(ctx interface{}, userID interface{}, role interface{}) *UserRepository_RevokeRole_Call {
+	return &UserRepository_RevokeRole_Call{Call: _e.mock.On("RevokeRole", ctx, userID, role)}
+}
+
+func (_c *UserRepository_RevokeRole_Call) Run(run func(ctx context.Context, userID int64, role string)) *UserRepository_RevokeRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UserRepository_RevokeRole_Call) Return(_a0 error) *UserRepository_RevokeRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepository_RevokeRole_Call) RunAndReturn(run func(context.Context, int64, string) error) *UserRepository_RevokeRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function with given fields: ctx, user
 func (_m *UserRepository) Save(ctx context.Context, user *domain.User) (*domain.User, error) {
 	ret := _m.Called(ctx, user)
@@ -258,6 +567,206 @@ func (_c *UserRepository_Save_Call) RunAndReturn(run func(context.Context, *doma
 	return _c
 }
 
+// SetDisabled provides a mock function with given fields: ctx, id, disabled
+func (_m *UserRepository) SetDisabled(ctx context.Context, id int64, disabled bool) error {
+	ret := _m.Called(ctx, id, disabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDisabled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, bool) error); ok {
+		r0 = rf(ctx, id, disabled)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_SetDisabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDisabled'
+type UserRepository_SetDisabled_Call struct {
+	*mock.Call
+}
+
+// SetDisabled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - disabled bool
+func (_e *UserRepository_Expecter) SetDisabled(ctx interface{}, id interface{}, disabled interface{}) *UserRepository_SetDisabled_Call {
+	return &UserRepository_SetDisabled_Call{Call: _e.mock.On("SetDisabled", ctx, id, disabled)}
+}
+
+func (_c *UserRepository_SetDisabled_Call) Run(run func(ctx context.Context, id int64, disabled bool)) *UserRepository_SetDisabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *UserRepository_SetDisabled_Call) Return(_a0 error) *UserRepository_SetDisabled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepository_SetDisabled_Call) RunAndReturn(run func(context.Context, int64, bool) error) *UserRepository_SetDisabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SoftDelete provides a mock function with given fields: ctx, id
+func (_m *UserRepository) SoftDelete(ctx context.Context, id int64) (time.Time, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDelete")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (time.Time, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) time.Time); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_SoftDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SoftDelete'
+type UserRepository_SoftDelete_Call struct {
+	*mock.Call
+}
+
+// SoftDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *UserRepository_Expecter) SoftDelete(ctx interface{}, id interface{}) *UserRepository_SoftDelete_Call {
+	return &UserRepository_SoftDelete_Call{Call: _e.mock.On("SoftDelete", ctx, id)}
+}
+
+func (_c *UserRepository_SoftDelete_Call) Run(run func(ctx context.Context, id int64)) *UserRepository_SoftDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *UserRepository_SoftDelete_Call) Return(_a0 time.Time, _a1 error) *UserRepository_SoftDelete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_SoftDelete_Call) RunAndReturn(run func(context.Context, int64) (time.Time, error)) *UserRepository_SoftDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, user
+func (_m *UserRepository) Update(ctx context.Context, user *domain.User) error {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.User) error); ok {
+		r0 = rf(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type UserRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *domain.User
+func (_e *UserRepository_Expecter) Update(ctx interface{}, user interface{}) *UserRepository_Update_Call {
+	return &UserRepository_Update_Call{Call: _e.mock.On("Update", ctx, user)}
+}
+
+func (_c *UserRepository_Update_Call) Run(run func(ctx context.Context, user *domain.User)) *UserRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.User))
+	})
+	return _c
+}
+
+func (_c *UserRepository_Update_Call) Return(_a0 error) *UserRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepository_Update_Call) RunAndReturn(run func(context.Context, *domain.User) error) *UserRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePassword provides a mock function with given fields: ctx, id, hashedPassword
+func (_m *UserRepository) UpdatePassword(ctx context.Context, id int64, hashedPassword string) error {
+	ret := _m.Called(ctx, id, hashedPassword)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, id, hashedPassword)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_UpdatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePassword'
+type UserRepository_UpdatePassword_Call struct {
+	*mock.Call
+}
+
+// UpdatePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - hashedPassword string
+func (_e *UserRepository_Expecter) UpdatePassword(ctx interface{}, id interface{}, hashedPassword interface{}) *UserRepository_UpdatePassword_Call {
+	return &UserRepository_UpdatePassword_Call{Call: _e.mock.On("UpdatePassword", ctx, id, hashedPassword)}
+}
+
+func (_c *UserRepository_UpdatePassword_Call) Run(run func(ctx context.Context, id int64, hashedPassword string)) *UserRepository_UpdatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UserRepository_UpdatePassword_Call) Return(_a0 error) *UserRepository_UpdatePassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepository_UpdatePassword_Call) RunAndReturn(run func(context.Context, int64, string) error) *UserRepository_UpdatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewUserRepository creates a new instance of UserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserRepository(t interface {
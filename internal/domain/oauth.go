@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// GrantTypeClientCredentials 는 사용자 없이 서비스 간 호출용 토큰을 발급하는 OAuth2 grant type 이다
+const GrantTypeClientCredentials = "client_credentials"
+
+// 서비스 클라이언트에 허용할 수 있는 scope. scope 가 부여하는 권한은 ScopePermissions 에 정의한다
+const (
+	ScopeOrdersRead = "orders:read"
+	ScopeUsersRead  = "users:read"
+)
+
+// OAuthClient 는 client_credentials 로 토큰을 발급받을 수 있도록 등록된 서비스이다.
+// 클라이언트 시크릿은 등록 응답에서만 확인할 수 있고, 서버에는 해시만 저장한다.
+type OAuthClient struct {
+	ID         int64     `json:"id"`
+	ClientID   string    `json:"client_id"`
+	Name       string    `json:"name"`
+	SecretHash string    `json:"-"`
+	Scopes     []string  `json:"scopes"` // 클라이언트가 요청할 수 있는 scope
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type CreateOAuthClientRequest struct {
+	Name   string   `json:"name" validate:"required,min=1,max=100"`
+	Scopes []string `json:"scopes" validate:"required,min=1,dive,oneof=orders:read users:read"`
+}
+
+type CreateOAuthClientResponse struct {
+	*OAuthClient
+	ClientSecret string `json:"client_secret"` // 이 응답에서만 확인할 수 있다
+}
+
+// TokenRequest 는 POST /oauth/token 요청이다 (application/x-www-form-urlencoded)
+// 클라이언트 인증은 HTTP Basic 을 권장하며, client_id 와 client_secret 을 본문으로 보낼 수도 있다
+type TokenRequest struct {
+	GrantType    string `form:"grant_type" json:"grant_type"`
+	Scope        string `form:"scope" json:"scope"` // 공백으로 구분. 비어 있으면 클라이언트에 허용된 모든 scope
+	ClientID     string `form:"client_id" json:"client_id"`
+	ClientSecret string `form:"client_secret" json:"client_secret"`
+}
+
+// TokenResponse 는 RFC 6749 5.1 형식의 토큰 응답이다
+type TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"` // 초
+	Scope       string `json:"scope,omitempty"`
+}
+
+// IntrospectionRequest 는 POST /oauth/introspect 요청이다 (RFC 7662 2.1)
+// 클라이언트 인증은 /oauth/token 과 같이 HTTP Basic 또는 본문으로 한다
+type IntrospectionRequest struct {
+	Token         string `form:"token" json:"token"`
+	TokenTypeHint string `form:"token_type_hint" json:"token_type_hint"` // access_token 만 지원하므로 무시한다
+	ClientID      string `form:"client_id" json:"client_id"`
+	ClientSecret  string `form:"client_secret" json:"client_secret"`
+}
+
+// IntrospectionResponse 는 RFC 7662 2.2 형식의 응답이다. 유효하지 않은 토큰은 active 만 false 로 반환한다
+type IntrospectionResponse struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Username  string `json:"username,omitempty"` // 사용자 토큰의 이메일
+	TokenType string `json:"token_type,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+	Sub       string `json:"sub,omitempty"` // 사용자 토큰은 사용자 ID, 서비스 토큰은 클라이언트 ID
+	Aud       string `json:"aud,omitempty"`
+	Iss       string `json:"iss,omitempty"`
+	Jti       string `json:"jti,omitempty"`
+
+	Roles []string `json:"roles,omitempty"` // 사용자 토큰의 현재 역할
+
+	Act *IntrospectionActor `json:"act,omitempty"` // 대리 로그인 토큰을 발급받은 관리자 (RFC 8693 act)
+}
+
+type IntrospectionActor struct {
+	Sub string `json:"sub"` // 관리자의 사용자 ID
+}
+
+// UserInfoResponse 는 GET /userinfo 응답이다 (OpenID Connect Core 5.3.2)
+type UserInfoResponse struct {
+	Sub           string   `json:"sub"`
+	Email         string   `json:"email"`
+	EmailVerified bool     `json:"email_verified"`
+	Name          string   `json:"name,omitempty"`
+	Roles         []string `json:"roles"`
+}
+
+type OAuthClientRepository interface {
+	// Save 는 클라이언트를 저장한다. client_id 가 이미 있으면 ErrAlreadyExists 를 반환한다
+	Save(ctx context.Context, client *OAuthClient) (*OAuthClient, error)
+	GetByClientID(ctx context.Context, clientID string) (*OAuthClient, error)
+	GetAll(ctx context.Context) ([]*OAuthClient, error)
+	Delete(ctx context.Context, id int64) error
+}
+
+type OAuthClientUseCase interface {
+	Create(ctx context.Context, req *CreateOAuthClientRequest) (*CreateOAuthClientResponse, error)
+	GetAll(ctx context.Context) ([]*OAuthClient, error)
+	Delete(ctx context.Context, id int64) error
+}
@@ -0,0 +1,37 @@
+package domain
+
+import "context"
+
+// OIDCIdentity 는 외부 OpenID Connect 공급자가 ID 토큰으로 확인해 준 사용자 정보이다
+type OIDCIdentity struct {
+	Subject       string // 공급자의 사용자 식별자 (sub)
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// OIDCAuthRequest 는 공급자 로그인 페이지 주소와, 콜백에서 확인할 값이다
+// State, Nonce, CodeVerifier 는 브라우저 쿠키에 저장했다가 콜백에서 돌려받는다
+type OIDCAuthRequest struct {
+	URL          string
+	State        string
+	Nonce        string
+	CodeVerifier string
+}
+
+// OIDCCallbackRequest 는 공급자가 콜백 주소로 전달하는 인가 응답이다
+type OIDCCallbackRequest struct {
+	Code             string `query:"code"`
+	State            string `query:"state"`
+	Error            string `query:"error"`
+	ErrorDescription string `query:"error_description"`
+}
+
+// OIDCProvider 는 인가 코드(PKCE) 방식으로 외부 공급자와 통신한다
+type OIDCProvider interface {
+	// AuthCodeURL 은 공급자 로그인 페이지 주소를 만든다. codeChallenge 는 S256 PKCE challenge 이다
+	AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	// Exchange 는 인가 코드를 토큰으로 교환하고, ID 토큰의 서명과 nonce 를 검증한 뒤 사용자 정보를 반환한다
+	// 코드나 ID 토큰이 유효하지 않으면 ErrOIDCLoginFailed 를 반환한다
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*OIDCIdentity, error)
+}
@@ -4,7 +4,7 @@ import "context"
 
 type Order struct {
 	ID              int64  `json:"id"`
-	UserID          int64  `json:"user_id" validate:"required,gt=0"`
+	UserID          int64  `json:"user_id" validate:"omitempty,gt=0"` // 비어 있으면 토큰의 사용자로 주문한다
 	ProductID       int64  `json:"product_id" validate:"required,gt=0"`
 	Quantity        int    `json:"quantity" validate:"required,gt=0"`
 	TotalPriceInKRW int64  `json:"total_price_in_krw" validate:"required,gt=0"`
@@ -17,6 +17,8 @@ type OrderRepository interface {
 	GetAll(ctx context.Context) ([]Order, error)
 }
 
+// OrderUseCase 는 context 의 Principal 로 소유권을 확인한다.
+// 사용자는 자신의 주문만 만들고 조회할 수 있으며, 다른 사용자의 주문은 :any 권한이 있어야 한다
 type OrderUseCase interface {
 	CreateOrder(ctx context.Context, order *Order) (*Order, error)
 	GetByID(ctx context.Context, id int64) (*Order, error)
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"context"
+	"slices"
+)
+
+// 경로마다 필요한 권한. RequirePermission 에 지정하며, 역할에는 설정이나 DB 의 매핑으로 권한을 부여한다
+// 이름은 <리소스>:<동작>[:<범위>] 형식이다. own 은 자신의 리소스, any 는 모든 사용자의 리소스를 뜻한다
+const (
+	PermProductsWrite      = "products:write"
+	PermOrdersCreate       = "orders:create"
+	PermOrdersCreateAny    = "orders:create:any" // 다른 사용자의 주문 생성
+	PermOrdersReadOwn      = "orders:read:own"
+	PermOrdersReadAny      = "orders:read:any"
+	PermUsersReadOwn       = "users:read:own"
+	PermUsersReadAny       = "users:read:any"
+	PermUsersManage        = "users:manage"
+	PermUsersImpersonate   = "users:impersonate" // 사용자로 대리 로그인
+	PermOAuthClientsManage = "oauth_clients:manage"
+	PermPermissionsRead    = "permissions:read"
+	PermAccountManage      = "account:manage" // 자신의 프로필, 비밀번호, 세션, MFA, API 키 관리
+)
+
+// AllPermissions 는 정의된 모든 권한이다. 설정이나 DB 의 매핑에 없는 권한이 있는지 확인할 때 사용한다
+var AllPermissions = []string{
+	PermProductsWrite,
+	PermOrdersCreate,
+	PermOrdersCreateAny,
+	PermOrdersReadOwn,
+	PermOrdersReadAny,
+	PermUsersReadOwn,
+	PermUsersReadAny,
+	PermUsersManage,
+	PermUsersImpersonate,
+	PermOAuthClientsManage,
+	PermPermissionsRead,
+	PermAccountManage,
+}
+
+// DefaultRolePermissions 는 매핑을 설정하지 않았을 때 사용하는 역할별 권한이다
+var DefaultRolePermissions = map[string][]string{
+	RoleAdmin: AllPermissions,
+	RoleManager: {
+		PermProductsWrite,
+		PermOrdersCreate,
+		PermOrdersCreateAny,
+		PermOrdersReadOwn,
+		PermOrdersReadAny,
+		PermUsersReadOwn,
+		PermUsersReadAny,
+		PermAccountManage,
+	},
+	RoleUser: {
+		PermOrdersCreate,
+		PermOrdersReadOwn,
+		PermUsersReadOwn,
+		PermAccountManage,
+	},
+}
+
+// ScopePermissions 는 서비스 주체(client_credentials 토큰)의 scope 가 부여하는 권한이다
+var ScopePermissions = map[string][]string{
+	ScopeOrdersRead: {PermOrdersReadAny},
+	ScopeUsersRead:  {PermUsersReadAny},
+}
+
+// IsValidPermission 은 정의된 권한인지 확인한다
+func IsValidPermission(permission string) bool {
+	return slices.Contains(AllPermissions, permission)
+}
+
+// RoutePermission 은 경로 하나에 필요한 권한과, 그 권한으로 접근할 수 있는 역할과 scope 이다
+type RoutePermission struct {
+	Method      string   `json:"method"`
+	Path        string   `json:"path"`
+	Public      bool     `json:"public"`                // 인증 없이 접근 가능
+	Permissions []string `json:"permissions,omitempty"` // 이 중 하나가 있으면 접근할 수 있다
+	Roles       []string `json:"roles,omitempty"`       // 접근할 수 있는 역할
+	Scopes      []string `json:"scopes,omitempty"`      // 접근할 수 있는 서비스 scope
+}
+
+// PermissionMatrix 는 GET /admin/permissions 응답으로, 현재 적용 중인 권한 매핑과 경로별 권한이다
+type PermissionMatrix struct {
+	Roles  map[string][]string `json:"roles"`
+	Scopes map[string][]string `json:"scopes"`
+	Routes []RoutePermission   `json:"routes"`
+}
+
+type PermissionRepository interface {
+	// GetRolePermissions 는 역할별 권한 매핑을 반환한다
+	GetRolePermissions(ctx context.Context) (map[string][]string, error)
+}
+
+type PermissionUseCase interface {
+	// Resolve 는 역할들이 가진 권한의 합집합을 반환한다
+	Resolve(ctx context.Context, roles []string) ([]string, error)
+	// RolePermissions 는 현재 적용 중인 역할별 권한 매핑을 반환한다
+	RolePermissions(ctx context.Context) (map[string][]string, error)
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// RefreshToken 은 발급된 리프레시 토큰의 서버측 기록이다.
+// 토큰 원문은 저장하지 않고 SHA-256 해시만 저장한다.
+type RefreshToken struct {
+	ID        int64
+	UserID    int64
+	FamilyID  string     // 한 번의 로그인에서 시작되어 교체(rotation)로 이어지는 토큰 묶음의 ID
+	TokenHash string     // 토큰 원문의 SHA-256 해시
+	ExpiresAt time.Time  // 만료 시각
+	RotatedAt *time.Time // 새 토큰으로 교체된 시각. 교체된 토큰이 다시 사용되면 재사용(탈취)으로 간주한다
+	RevokedAt *time.Time // 폐기된 시각
+	CreatedAt time.Time
+}
+
+// IsActive checks if the token is neither rotated, revoked nor expired
+func (t *RefreshToken) IsActive(now time.Time) bool {
+	return t.RotatedAt == nil && t.RevokedAt == nil && now.Before(t.ExpiresAt)
+}
+
+type RefreshTokenRepository interface {
+	Save(ctx context.Context, token *RefreshToken) (*RefreshToken, error)
+	GetByHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	// MarkRotated 는 아직 교체/폐기되지 않은 토큰만 교체 처리하며, 그렇지 않으면 ErrNotFound 를 반환한다
+	MarkRotated(ctx context.Context, id int64) error
+	RevokeFamily(ctx context.Context, familyID string) error
+	RevokeAllByUserID(ctx context.Context, userID int64) error
+}
+
+// Session 은 한 번의 로그인으로 시작된 세션이다. ID 는 리프레시 토큰 family ID 와 같으며,
+// 리프레시 토큰으로 재발급할 때마다 마지막 사용 시각과 IP, 만료 시각이 갱신된다.
+type Session struct {
+	ID         string    `json:"id"`
+	UserID     int64     `json:"-"`
+	UserAgent  string    `json:"user_agent"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current"` // 요청한 액세스 토큰이 발급된 세션인지 여부
+}
+
+type SessionRepository interface {
+	Create(ctx context.Context, session *Session) error
+	// Touch 는 마지막 사용 시각, IP, 만료 시각을 갱신한다. 세션이 없으면 ErrNotFound 를 반환한다
+	Touch(ctx context.Context, id string, ip string, expiresAt time.Time) error
+	// ListByUserID 는 만료되지 않은 세션을 최근 사용 순으로 반환한다
+	ListByUserID(ctx context.Context, userID int64) ([]*Session, error)
+	// Delete 는 사용자의 세션을 삭제한다. 사용자의 세션이 아니면 ErrNotFound 를 반환한다
+	Delete(ctx context.Context, userID int64, id string) error
+	DeleteAllByUserID(ctx context.Context, userID int64) error
+}
+
+// TokenRevocationRepository 는 만료 전에 무효화된 액세스 토큰 목록을 관리한다.
+// 폐기 기록은 토큰이 어차피 만료되는 expiresAt 이후에는 유지할 필요가 없다.
+type TokenRevocationRepository interface {
+	// RevokeToken 은 jti 로 식별되는 토큰 하나를 폐기한다
+	RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error
+	// RevokeUserTokens 는 issuedBefore 이전에 발급된 사용자의 모든 토큰을 폐기한다
+	RevokeUserTokens(ctx context.Context, userID int64, issuedBefore, expiresAt time.Time) error
+	IsRevoked(ctx context.Context, jti string, userID int64, issuedAt time.Time) (bool, error)
+}
+
+// OneTimeTokenPurpose 는 일회용 토큰의 용도이다
+type OneTimeTokenPurpose string
+
+const (
+	PurposePasswordReset     OneTimeTokenPurpose = "password_reset"
+	PurposeEmailVerification OneTimeTokenPurpose = "email_verification"
+)
+
+// OneTimeToken 은 메일 링크 등으로 전달되어 한 번만 사용할 수 있는 토큰이다.
+// 리프레시 토큰과 마찬가지로 토큰 원문은 저장하지 않고 SHA-256 해시만 저장한다.
+type OneTimeToken struct {
+	ID        int64
+	UserID    int64
+	Purpose   OneTimeTokenPurpose
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time // 사용된 시각. 사용된 토큰은 다시 사용할 수 없다
+	CreatedAt time.Time
+}
+
+type OneTimeTokenRepository interface {
+	Save(ctx context.Context, token *OneTimeToken) (*OneTimeToken, error)
+	// Find 는 사용되지 않았고 만료되지 않은 토큰을 사용 처리하지 않고 반환하며, 그렇지 않으면 ErrNotFound 를 반환한다
+	// 토큰을 사용하기 전에 요청을 검증해야 할 때 사용하고, 실제 사용은 Consume 으로 한다
+	Find(ctx context.Context, purpose OneTimeTokenPurpose, tokenHash string) (*OneTimeToken, error)
+	// Consume 은 사용되지 않았고 만료되지 않은 토큰을 사용 처리하여 반환하며, 그렇지 않으면 ErrNotFound 를 반환한다
+	Consume(ctx context.Context, purpose OneTimeTokenPurpose, tokenHash string) (*OneTimeToken, error)
+	// DeleteByUserID 는 사용자의 해당 용도 토큰을 모두 삭제한다. 새 토큰을 발급하면 이전 토큰은 쓸 수 없게 한다
+	DeleteByUserID(ctx context.Context, userID int64, purpose OneTimeTokenPurpose) error
+	// LatestCreatedAt 은 사용자의 해당 용도 토큰 중 가장 최근 발급 시각을 반환한다. 없으면 ErrNotFound 를 반환한다
+	LatestCreatedAt(ctx context.Context, userID int64, purpose OneTimeTokenPurpose) (time.Time, error)
+}
+
+// LoginAttemptRepository 는 로그인 실패 횟수와 잠금 상태를 key(이메일, IP 등) 단위로 관리한다
+type LoginAttemptRepository interface {
+	// RecordFailure 는 실패 횟수를 1 증가시키고 증가된 횟수를 반환한다. 마지막 실패 후 window 가 지났으면 1 부터 다시 센다
+	RecordFailure(ctx context.Context, key string, window time.Duration) (int, error)
+	// Lock 은 until 까지 로그인을 막는다
+	Lock(ctx context.Context, key string, until time.Time) error
+	// LockedUntil 은 잠금 해제 시각을 반환한다. 잠겨 있지 않으면 zero time 을 반환한다
+	LockedUntil(ctx context.Context, key string) (time.Time, error)
+	// Reset 은 실패 기록과 잠금을 삭제한다
+	Reset(ctx context.Context, key string) error
+}
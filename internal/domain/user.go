@@ -3,16 +3,20 @@ package domain
 import (
 	"context"
 	"strings"
+	"time"
 )
 
 // Role 상수 정의
+// 경로에는 역할 대신 권한을 지정하며, 역할별 권한은 DefaultRolePermissions 또는 permissions 설정을 따른다
 const (
-	RolePublic  = "Public" // 공개 접근 가능, 토큰 불필요
 	RoleAdmin   = "Admin"
 	RoleManager = "Manager"
 	RoleUser    = "User"
 )
 
+// DefaultRoles 는 처음 실행할 때 roles 테이블에 등록하는 역할이다
+var DefaultRoles = []string{RoleAdmin, RoleManager, RoleUser}
+
 // GetByIDRequest represents a request to get a user by ID
 type GetByIDRequest struct {
 	ID int64 `param:"id" validate:"required,min=1"`
@@ -44,12 +48,60 @@ type GetAllResponse struct {
 	HasMore    bool   `json:"has_more"`    // 더 가져올 데이터가 있는지 여부
 }
 
+// UserRoleRequest 는 관리자가 사용자에게 역할을 부여하거나 회수하는 요청이다
+// 부여할 때는 본문의 role, 회수할 때는 경로의 :role 을 사용한다
+type UserRoleRequest struct {
+	ID   int64  `param:"id" validate:"required,min=1"`
+	Role string `json:"role" param:"role" validate:"required,max=50"`
+}
+
+// UpdateProfileRequest 는 로그인한 사용자가 자신의 정보를 수정하는 요청이다. 비어 있는 필드는 바꾸지 않는다
+// 이메일을 바꾸려면 현재 비밀번호가 필요하며, 바꾸면 인증 상태가 초기화되고 새 주소로 인증 메일을 보낸다
+type UpdateProfileRequest struct {
+	Name            string `json:"name" validate:"omitempty,min=2,max=100"`
+	Email           string `json:"email" validate:"omitempty,email"`
+	CurrentPassword string `json:"current_password"`
+}
+
+// DeleteAccountRequest 는 로그인한 사용자가 비밀번호를 확인하고 계정 삭제를 요청하는 것이다
+// 삭제를 요청한 계정은 바로 사용할 수 없게 되고, 유예 기간이 지나면 개인정보가 지워진다
+type DeleteAccountRequest struct {
+	Password string `json:"password" validate:"required"`
+}
+
+// DeleteAccountResponse 는 계정 삭제 요청의 응답이다. EraseAfter 전까지는 관리자가 복구할 수 있다
+type DeleteAccountResponse struct {
+	DeletedAt  time.Time `json:"deleted_at"`
+	EraseAfter time.Time `json:"erase_after"`
+}
+
+// ChangePasswordRequest 는 로그인한 사용자가 현재 비밀번호를 확인하고 비밀번호를 바꾸는 요청이다
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required"`
+}
+
 type User struct {
-	ID       int64    `json:"id"`
-	Name     string   `json:"name" validate:"omitempty,min=2,max=100"`
-	Email    string   `json:"email" validate:"required,email"`
-	Password string   `json:"-" validate:"required,min=8"`
-	Roles    []string `json:"roles"`
+	ID         int64      `json:"id"`
+	Name       string     `json:"name" validate:"omitempty,min=2,max=100"`
+	Email      string     `json:"email" validate:"required,email"`
+	Password   string     `json:"-" validate:"required,min=8"`
+	Roles      []string   `json:"roles"`
+	VerifiedAt *time.Time `json:"verified_at,omitempty"` // 이메일 인증 시각. 인증 전이면 nil
+	DisabledAt *time.Time `json:"disabled_at,omitempty"` // 관리자가 비활성화한 시각. 비활성화된 사용자는 로그인, 토큰 갱신을 할 수 없다
+
+	// 관리자가 만든 계정처럼 다른 사람이 정한 비밀번호를 사용하는 경우, 로그인하면 토큰 대신 비밀번호 변경을 요구한다
+	PasswordChangeRequired bool `json:"password_change_required,omitempty"`
+}
+
+// IsVerified checks if user has verified the email address
+func (u *User) IsVerified() bool {
+	return u.VerifiedAt != nil
+}
+
+// IsDisabled checks if the account has been disabled by an admin
+func (u *User) IsDisabled() bool {
+	return u.DisabledAt != nil
 }
 
 // RolesToString converts roles slice to comma-separated string for storage
@@ -85,13 +137,38 @@ func (u *User) IsManager() bool {
 	return u.HasRole(RoleManager)
 }
 
+// 삭제된(deleted_at 이 있는) 사용자는 Restore, EraseDeleted 를 제외한 모든 메서드에서 없는 사용자로 취급한다
 type UserRepository interface {
 	Save(ctx context.Context, user *User) (*User, error)
 	GetByID(ctx context.Context, id int64) (*User, error)
 	GetAll(ctx context.Context, req *GetAllUsersRequest) (*GetAllResponse, error)
 	GetUserByEmail(ctx context.Context, email string) (*User, error)
+	// Update 는 이름, 이메일, 이메일 인증 시각을 저장한다. 이메일이 중복되면 ErrAlreadyExists 를 반환한다
+	Update(ctx context.Context, user *User) error
+	// UpdatePassword 는 해싱된 비밀번호로 교체하고 비밀번호 변경 요구를 해제한다
+	UpdatePassword(ctx context.Context, id int64, hashedPassword string) error
+	// MarkVerified 는 이메일 인증 시각을 기록한다. 이미 인증된 사용자는 기존 시각을 유지한다
+	MarkVerified(ctx context.Context, id int64) error
+	// SetDisabled 는 사용자를 비활성화하거나 다시 활성화한다
+	SetDisabled(ctx context.Context, id int64, disabled bool) error
+	// SoftDelete 는 사용자를 삭제된 것으로 표시한다. 삭제된 사용자는 다른 메서드에서 조회되지 않는다
+	SoftDelete(ctx context.Context, id int64) (time.Time, error)
+	// Restore 는 아직 개인정보가 지워지지 않은 삭제된 사용자를 복구한다. 없으면 ErrNotFound 를 반환한다
+	Restore(ctx context.Context, id int64) error
+	// EraseDeleted 는 deletedBefore 이전에 삭제된 사용자의 개인정보를 익명화하고 인증 정보를 지운다
+	// 주문 기록을 남기기 위해 사용자 행은 지우지 않는다. 익명화한 사용자의 ID 를 반환한다
+	EraseDeleted(ctx context.Context, deletedBefore time.Time) ([]int64, error)
+	// GetAllRoles 는 등록된 모든 역할 이름을 반환한다
+	GetAllRoles(ctx context.Context) ([]string, error)
+	// GrantRole 은 사용자에게 역할을 부여한다. 이미 가진 역할이면 아무것도 하지 않는다
+	// 사용자가 없으면 ErrNotFound, 등록되지 않은 역할이면 ErrUnknownRole 을 반환한다
+	GrantRole(ctx context.Context, userID int64, role string) error
+	// RevokeRole 은 사용자의 역할을 회수한다. 가지고 있지 않은 역할이면 ErrNotFound 를 반환한다
+	RevokeRole(ctx context.Context, userID int64, role string) error
 }
 
+// UserUseCase 는 context 의 Principal 로 소유권을 확인한다.
+// 사용자는 자신의 정보만 조회할 수 있으며, 다른 사용자의 정보는 users:read:any 권한이 있어야 한다
 type UserUseCase interface {
 	GetByID(ctx context.Context, id int64) (*User, error)
 	GetAll(ctx context.Context, req *GetAllUsersRequest) (*GetAllResponse, error)
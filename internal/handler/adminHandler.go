@@ -0,0 +1,231 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/internal/middlewares"
+)
+
+// AdminHandler handles administrative operations on user accounts
+type AdminHandler struct {
+	echo              *echo.Echo
+	authUseCase       domain.AuthUseCase
+	permissionUseCase domain.PermissionUseCase
+}
+
+func NewAdminHandler(e *echo.Echo, authUseCase domain.AuthUseCase, permissionUseCase domain.PermissionUseCase) *AdminHandler {
+	handler := &AdminHandler{
+		echo:              e,
+		authUseCase:       authUseCase,
+		permissionUseCase: permissionUseCase,
+	}
+
+	group := e.Group("/admin")
+	middlewares.Route(group, http.MethodPost, "/users/:id/revoke-tokens", handler.RevokeUserTokens, domain.PermUsersManage)
+	middlewares.Route(group, http.MethodPost, "/users/:id/unlock", handler.UnlockUser, domain.PermUsersManage)
+	middlewares.Route(group, http.MethodPost, "/users/:id/disable", handler.DisableUser, domain.PermUsersManage)
+	middlewares.Route(group, http.MethodPost, "/users/:id/enable", handler.EnableUser, domain.PermUsersManage)
+	middlewares.Route(group, http.MethodPost, "/users/:id/restore", handler.RestoreUser, domain.PermUsersManage)
+	middlewares.Route(group, http.MethodPost, "/users/:id/impersonate", handler.Impersonate, domain.PermUsersImpersonate)
+	middlewares.Route(group, http.MethodGet, "/roles", handler.GetRoles, domain.PermUsersManage)
+	middlewares.Route(group, http.MethodPost, "/users/:id/roles", handler.GrantRole, domain.PermUsersManage)
+	middlewares.Route(group, http.MethodDelete, "/users/:id/roles/:role", handler.RevokeRole, domain.PermUsersManage)
+	middlewares.Route(group, http.MethodGet, "/permissions", handler.GetPermissions, domain.PermPermissionsRead)
+
+	return handler
+}
+
+// RevokeUserTokens 는 지정한 사용자의 모든 액세스 토큰과 리프레시 토큰을 폐기한다
+func (h *AdminHandler) RevokeUserTokens(c echo.Context) error {
+	req := new(domain.GetByIDRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	err := h.authUseCase.RevokeUserTokens(ctx, req.ID)
+	if err == nil {
+		return c.JSON(http.StatusOK, map[string]string{
+			"message": "All tokens of the user have been revoked.",
+			"status":  "success",
+		})
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return c.JSON(http.StatusNotFound, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
+
+// Impersonate 는 관리자가 사용자로 대리 로그인할 수 있는 짧은 액세스 토큰을 발급한다
+// 리프레시 토큰 쿠키는 설정하지 않으며, 대리 로그인은 토큰이 만료되거나 /auth/logout 을 호출하면 끝난다
+func (h *AdminHandler) Impersonate(c echo.Context) error {
+	req := new(domain.GetByIDRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	response, err := h.authUseCase.Impersonate(ctx, req.ID)
+	if err == nil {
+		return c.JSON(http.StatusOK, response)
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrUnauthorized):
+		return c.JSON(http.StatusUnauthorized, ErrResponse(err))
+	case errors.Is(err, domain.ErrForbidden):
+		return c.JSON(http.StatusForbidden, ErrResponse(err))
+	case errors.Is(err, domain.ErrNotFound):
+		return c.JSON(http.StatusNotFound, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
+
+// GetRoles 는 부여할 수 있는 역할 목록을 반환한다
+func (h *AdminHandler) GetRoles(c echo.Context) error {
+	ctx := c.Request().Context()
+	roles, err := h.authUseCase.GetRoles(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+
+	return c.JSON(http.StatusOK, map[string][]string{"roles": roles})
+}
+
+// GrantRole 은 사용자에게 역할을 부여한다. 역할이 바뀌면 사용자의 모든 토큰이 폐기된다
+func (h *AdminHandler) GrantRole(c echo.Context) error {
+	return h.changeRole(c, h.authUseCase.GrantRole)
+}
+
+// RevokeRole 은 사용자의 역할을 회수한다. 사용자의 모든 토큰이 폐기된다
+func (h *AdminHandler) RevokeRole(c echo.Context) error {
+	return h.changeRole(c, h.authUseCase.RevokeRole)
+}
+
+func (h *AdminHandler) changeRole(c echo.Context, change func(ctx context.Context, userID int64, role string) (*domain.User, error)) error {
+	req := new(domain.UserRoleRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	user, err := change(ctx, req.ID, req.Role)
+	if err == nil {
+		return c.JSON(http.StatusOK, user)
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrUnknownRole):
+		return c.JSON(http.StatusBadRequest, ErrResponse(err))
+	case errors.Is(err, domain.ErrNotFound):
+		return c.JSON(http.StatusNotFound, ErrResponse(err))
+	case errors.Is(err, domain.ErrForbidden):
+		return c.JSON(http.StatusForbidden, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
+
+// GetPermissions 는 등록된 모든 경로에 필요한 권한과, 현재 매핑에서 그 권한을 가진 역할과 scope 를 반환한다
+func (h *AdminHandler) GetPermissions(c echo.Context) error {
+	ctx := c.Request().Context()
+	rolePermissions, err := h.permissionUseCase.RolePermissions(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+
+	return c.JSON(http.StatusOK, middlewares.PermissionMatrix(h.echo.Routes(), rolePermissions))
+}
+
+// UnlockUser 는 로그인 실패로 잠긴 사용자의 잠금을 해제한다
+func (h *AdminHandler) UnlockUser(c echo.Context) error {
+	req := new(domain.GetByIDRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	err := h.authUseCase.UnlockUser(ctx, req.ID)
+	if err == nil {
+		return c.JSON(http.StatusOK, map[string]string{
+			"message": "The user has been unlocked.",
+			"status":  "success",
+		})
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return c.JSON(http.StatusNotFound, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
+
+// DisableUser 는 사용자를 비활성화한다. 로그인과 토큰 갱신이 막히고 발급된 토큰은 모두 폐기된다
+func (h *AdminHandler) DisableUser(c echo.Context) error {
+	return h.changeAccountState(c, h.authUseCase.DisableUser, "The user has been disabled.")
+}
+
+// EnableUser 는 비활성화된 사용자를 다시 활성화한다
+func (h *AdminHandler) EnableUser(c echo.Context) error {
+	return h.changeAccountState(c, h.authUseCase.EnableUser, "The user has been enabled.")
+}
+
+// RestoreUser 는 삭제를 요청했지만 아직 개인정보가 지워지지 않은 사용자를 복구한다
+func (h *AdminHandler) RestoreUser(c echo.Context) error {
+	return h.changeAccountState(c, h.authUseCase.RestoreUser, "The user has been restored.")
+}
+
+func (h *AdminHandler) changeAccountState(c echo.Context, change func(ctx context.Context, userID int64) error, message string) error {
+	req := new(domain.GetByIDRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	err := change(ctx, req.ID)
+	if err == nil {
+		return c.JSON(http.StatusOK, map[string]string{
+			"message": message,
+			"status":  "success",
+		})
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return c.JSON(http.StatusNotFound, ErrResponse(err))
+	case errors.Is(err, domain.ErrForbidden):
+		return c.JSON(http.StatusForbidden, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
@@ -0,0 +1,521 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/internal/domain/mocks"
+	"github.com/nicewook/gocore/pkg/contextutil"
+	"github.com/nicewook/gocore/pkg/validatorutil"
+)
+
+func TestAdminHandler_RevokeUserTokens(t *testing.T) {
+	tests := []struct {
+		name           string
+		pathParam      string
+		mockUserID     int64
+		mockError      error
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "Success",
+			pathParam:      "1",
+			mockUserID:     1,
+			mockError:      nil,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"message":"All tokens of the user have been revoked.","status":"success"}`,
+		},
+		{
+			name:           "User Not Found",
+			pathParam:      "999",
+			mockUserID:     999,
+			mockError:      domain.ErrNotFound,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   fmt.Sprintf(`{"error":"%s"}`, domain.ErrNotFound.Error()),
+		},
+		{
+			name:           "Invalid ID",
+			pathParam:      "invalid",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":"invalid input"}`,
+		},
+		{
+			name:           "Internal Error",
+			pathParam:      "1",
+			mockUserID:     1,
+			mockError:      domain.ErrInternal,
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   `{"error":"internal error"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = validatorutil.NewValidator()
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/admin/users/:id/revoke-tokens")
+			c.SetParamNames("id")
+			c.SetParamValues(tt.pathParam)
+
+			mockUseCase := new(mocks.AuthUseCase)
+			if tt.mockUserID != 0 {
+				mockUseCase.On("RevokeUserTokens", mock.Anything, tt.mockUserID).Return(tt.mockError)
+			}
+
+			handler := NewAdminHandler(e, mockUseCase, new(mocks.PermissionUseCase))
+			err := handler.RevokeUserTokens(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAdminHandler_UnlockUser(t *testing.T) {
+	tests := []struct {
+		name           string
+		pathParam      string
+		mockUserID     int64
+		mockError      error
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "Success",
+			pathParam:      "1",
+			mockUserID:     1,
+			mockError:      nil,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"message":"The user has been unlocked.","status":"success"}`,
+		},
+		{
+			name:           "User Not Found",
+			pathParam:      "999",
+			mockUserID:     999,
+			mockError:      domain.ErrNotFound,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   fmt.Sprintf(`{"error":"%s"}`, domain.ErrNotFound.Error()),
+		},
+		{
+			name:           "Invalid ID",
+			pathParam:      "invalid",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":"invalid input"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = validatorutil.NewValidator()
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/admin/users/:id/unlock")
+			c.SetParamNames("id")
+			c.SetParamValues(tt.pathParam)
+
+			mockUseCase := new(mocks.AuthUseCase)
+			if tt.mockUserID != 0 {
+				mockUseCase.On("UnlockUser", mock.Anything, tt.mockUserID).Return(tt.mockError)
+			}
+
+			handler := NewAdminHandler(e, mockUseCase, new(mocks.PermissionUseCase))
+			err := handler.UnlockUser(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAdminHandler_GetPermissions(t *testing.T) {
+	rolePermissions := map[string][]string{
+		domain.RoleAdmin:   domain.AllPermissions,
+		domain.RoleManager: {domain.PermProductsWrite, domain.PermOrdersReadAny},
+		domain.RoleUser:    {domain.PermOrdersReadOwn},
+	}
+
+	t.Run("Route Matrix", func(t *testing.T) {
+		e := echo.New()
+		NewProductHandler(e, new(mocks.ProductUseCase))
+		NewOrderHandler(e, new(mocks.OrderUseCase))
+
+		mockPermissionUseCase := new(mocks.PermissionUseCase)
+		mockPermissionUseCase.On("RolePermissions", mock.Anything).Return(rolePermissions, nil)
+		handler := NewAdminHandler(e, new(mocks.AuthUseCase), mockPermissionUseCase)
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/permissions", nil)
+		rec := httptest.NewRecorder()
+		err := handler.GetPermissions(e.NewContext(req, rec))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var matrix domain.PermissionMatrix
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &matrix))
+		assert.Equal(t, rolePermissions, matrix.Roles)
+
+		routes := map[string]domain.RoutePermission{}
+		for _, route := range matrix.Routes {
+			routes[route.Method+" "+route.Path] = route
+		}
+
+		assert.True(t, routes["GET /products"].Public)
+		assert.Equal(t, domain.RoutePermission{
+			Method:      http.MethodGet,
+			Path:        "/orders",
+			Permissions: []string{domain.PermOrdersReadAny},
+			Roles:       []string{domain.RoleAdmin, domain.RoleManager},
+			Scopes:      []string{domain.ScopeOrdersRead},
+		}, routes["GET /orders"])
+		assert.Equal(t, []string{domain.RoleAdmin, domain.RoleManager, domain.RoleUser}, routes["GET /orders/:id"].Roles)
+		assert.Equal(t, []string{domain.RoleAdmin}, routes["GET /admin/permissions"].Roles)
+
+		mockPermissionUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		e := echo.New()
+		mockPermissionUseCase := new(mocks.PermissionUseCase)
+		mockPermissionUseCase.On("RolePermissions", mock.Anything).Return(nil, errors.New("db error"))
+		handler := NewAdminHandler(e, new(mocks.AuthUseCase), mockPermissionUseCase)
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/permissions", nil)
+		rec := httptest.NewRecorder()
+		err := handler.GetPermissions(e.NewContext(req, rec))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func TestAdminHandler_RequiresPermission(t *testing.T) {
+	tests := []struct {
+		name           string
+		permissions    []string
+		withPrincipal  bool
+		expectedStatus int
+	}{
+		{
+			name:           "Granted",
+			permissions:    []string{domain.PermPermissionsRead},
+			withPrincipal:  true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Missing Permission",
+			permissions:    []string{domain.PermUsersManage},
+			withPrincipal:  true,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Unauthenticated",
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			if tt.withPrincipal {
+				e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+					return func(c echo.Context) error {
+						contextutil.SetPrincipal(c, &contextutil.Principal{UserID: 1, Permissions: tt.permissions})
+						return next(c)
+					}
+				})
+			}
+
+			mockPermissionUseCase := new(mocks.PermissionUseCase)
+			mockPermissionUseCase.On("RolePermissions", mock.Anything).Return(map[string][]string{}, nil).Maybe()
+			NewAdminHandler(e, new(mocks.AuthUseCase), mockPermissionUseCase)
+
+			req := httptest.NewRequest(http.MethodGet, "/admin/permissions", nil)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestAdminHandler_GrantRole(t *testing.T) {
+	tests := []struct {
+		name           string
+		pathParam      string
+		body           string
+		expectCall     bool
+		mockReturn     *domain.User
+		mockError      error
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "Success",
+			pathParam:      "1",
+			body:           `{"role":"Manager"}`,
+			expectCall:     true,
+			mockReturn:     &domain.User{ID: 1, Name: "John", Email: "john@example.com", Roles: []string{domain.RoleManager, domain.RoleUser}},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"id":1,"name":"John","email":"john@example.com","roles":["Manager","User"]}`,
+		},
+		{
+			name:           "Unknown Role",
+			pathParam:      "1",
+			body:           `{"role":"SuperUser"}`,
+			expectCall:     true,
+			mockError:      domain.ErrUnknownRole,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":"unknown role"}`,
+		},
+		{
+			name:           "Own Roles",
+			pathParam:      "1",
+			body:           `{"role":"Manager"}`,
+			expectCall:     true,
+			mockError:      domain.ErrForbidden,
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"error":"access to this resource is not allowed"}`,
+		},
+		{
+			name:           "Missing Role",
+			pathParam:      "1",
+			body:           `{}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":"invalid input"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = validatorutil.NewValidator()
+
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/admin/users/:id/roles")
+			c.SetParamNames("id")
+			c.SetParamValues(tt.pathParam)
+
+			mockUseCase := new(mocks.AuthUseCase)
+			if tt.expectCall {
+				mockUseCase.On("GrantRole", mock.Anything, int64(1), mock.AnythingOfType("string")).Return(tt.mockReturn, tt.mockError)
+			}
+
+			handler := NewAdminHandler(e, mockUseCase, new(mocks.PermissionUseCase))
+			err := handler.GrantRole(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAdminHandler_RevokeRole(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "Success",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Role Not Granted",
+			mockError:      domain.ErrNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = validatorutil.NewValidator()
+
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/admin/users/:id/roles/:role")
+			c.SetParamNames("id", "role")
+			c.SetParamValues("1", domain.RoleManager)
+
+			var mockReturn *domain.User
+			if tt.mockError == nil {
+				mockReturn = &domain.User{ID: 1, Roles: []string{domain.RoleUser}}
+			}
+			mockUseCase := new(mocks.AuthUseCase)
+			mockUseCase.On("RevokeRole", mock.Anything, int64(1), domain.RoleManager).Return(mockReturn, tt.mockError)
+
+			handler := NewAdminHandler(e, mockUseCase, new(mocks.PermissionUseCase))
+			err := handler.RevokeRole(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAdminHandler_Impersonate(t *testing.T) {
+	tests := []struct {
+		name           string
+		pathParam      string
+		expectCall     bool
+		mockReturn     *domain.ImpersonationResponse
+		mockError      error
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "Success",
+			pathParam:      "2",
+			expectCall:     true,
+			mockReturn:     &domain.ImpersonationResponse{AccessToken: "impersonation-token", TokenType: "Bearer", ExpiresIn: 900, ExpiresAt: time.Date(2025, 1, 1, 0, 15, 0, 0, time.UTC), UserID: 2, Email: "user@example.com", ActorID: 1},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"access_token":"impersonation-token","token_type":"Bearer","expires_in":900,"expires_at":"2025-01-01T00:15:00Z","user_id":2,"email":"user@example.com","actor_id":1}`,
+		},
+		{
+			name:           "Target Is Admin",
+			pathParam:      "2",
+			expectCall:     true,
+			mockError:      domain.ErrForbidden,
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"error":"access to this resource is not allowed"}`,
+		},
+		{
+			name:           "User Not Found",
+			pathParam:      "2",
+			expectCall:     true,
+			mockError:      domain.ErrNotFound,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":"not found"}`,
+		},
+		{
+			name:           "Invalid ID",
+			pathParam:      "abc",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":"invalid input"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = validatorutil.NewValidator()
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/admin/users/:id/impersonate")
+			c.SetParamNames("id")
+			c.SetParamValues(tt.pathParam)
+
+			mockUseCase := new(mocks.AuthUseCase)
+			if tt.expectCall {
+				mockUseCase.On("Impersonate", mock.Anything, int64(2)).Return(tt.mockReturn, tt.mockError)
+			}
+
+			handler := NewAdminHandler(e, mockUseCase, new(mocks.PermissionUseCase))
+			err := handler.Impersonate(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAdminHandler_DisableUser(t *testing.T) {
+	tests := []struct {
+		name           string
+		pathParam      string
+		expectCall     bool
+		mockError      error
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "Success",
+			pathParam:      "1",
+			expectCall:     true,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"message":"The user has been disabled.","status":"success"}`,
+		},
+		{
+			name:           "User Not Found",
+			pathParam:      "1",
+			expectCall:     true,
+			mockError:      domain.ErrNotFound,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":"not found"}`,
+		},
+		{
+			name:           "Self",
+			pathParam:      "1",
+			expectCall:     true,
+			mockError:      domain.ErrForbidden,
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"error":"access to this resource is not allowed"}`,
+		},
+		{
+			name:           "Invalid ID",
+			pathParam:      "0",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":"invalid input"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = validatorutil.NewValidator()
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/admin/users/:id/disable")
+			c.SetParamNames("id")
+			c.SetParamValues(tt.pathParam)
+
+			mockUseCase := new(mocks.AuthUseCase)
+			if tt.expectCall {
+				mockUseCase.On("DisableUser", mock.Anything, int64(1)).Return(tt.mockError)
+			}
+
+			handler := NewAdminHandler(e, mockUseCase, new(mocks.PermissionUseCase))
+			err := handler.DisableUser(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
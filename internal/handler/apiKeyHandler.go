@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/internal/middlewares"
+	"github.com/nicewook/gocore/pkg/contextutil"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+// APIKeyHandler handles personal API keys of the authenticated user
+type APIKeyHandler struct {
+	apiKeyUseCase domain.APIKeyUseCase
+}
+
+func NewAPIKeyHandler(e *echo.Echo, apiKeyUseCase domain.APIKeyUseCase) *APIKeyHandler {
+	handler := &APIKeyHandler{apiKeyUseCase: apiKeyUseCase}
+
+	group := e.Group("/me/api-keys")
+	middlewares.Route(group, http.MethodPost, "", handler.Create, domain.PermAccountManage)
+	middlewares.Route(group, http.MethodGet, "", handler.List, domain.PermAccountManage)
+	middlewares.Route(group, http.MethodDelete, "/:id", handler.Revoke, domain.PermAccountManage)
+
+	return handler
+}
+
+// principalForKeyManagement 는 API 키 관리를 요청한 주체를 반환한다.
+// 유출된 API 키로 새 키를 발급하거나 다른 키를 지우지 못하도록 API 키로 인증한 요청은 거부한다
+func principalForKeyManagement(c echo.Context) (*contextutil.Principal, int) {
+	principal, err := contextutil.GetPrincipal(c)
+	if err != nil {
+		return nil, http.StatusUnauthorized
+	}
+	if principal.TokenType == security.APIKey {
+		return nil, http.StatusForbidden
+	}
+	return principal, http.StatusOK
+}
+
+// Create issues a new API key. The key is only returned in this response
+func (h *APIKeyHandler) Create(c echo.Context) error {
+	principal, status := principalForKeyManagement(c)
+	if principal == nil {
+		return c.JSON(status, ErrResponse(domain.ErrUnauthorized))
+	}
+
+	req := new(domain.CreateAPIKeyRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	response, err := h.apiKeyUseCase.Create(ctx, principal.UserID, req)
+	if err == nil {
+		return c.JSON(http.StatusCreated, response)
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrInvalidScope):
+		return c.JSON(http.StatusBadRequest, ErrResponse(err))
+	case errors.Is(err, domain.ErrInvalidInput):
+		return c.JSON(http.StatusBadRequest, ErrResponse(err))
+	case errors.Is(err, domain.ErrImpersonated):
+		return c.JSON(http.StatusForbidden, ErrResponse(err))
+	case errors.Is(err, domain.ErrNotFound):
+		return c.JSON(http.StatusNotFound, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
+
+// List returns the authenticated user's API keys without the key values
+func (h *APIKeyHandler) List(c echo.Context) error {
+	principal, status := principalForKeyManagement(c)
+	if principal == nil {
+		return c.JSON(status, ErrResponse(domain.ErrUnauthorized))
+	}
+
+	ctx := c.Request().Context()
+	keys, err := h.apiKeyUseCase.List(ctx, principal.UserID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+
+	return c.JSON(http.StatusOK, keys)
+}
+
+// Revoke deletes one of the authenticated user's API keys
+func (h *APIKeyHandler) Revoke(c echo.Context) error {
+	principal, status := principalForKeyManagement(c)
+	if principal == nil {
+		return c.JSON(status, ErrResponse(domain.ErrUnauthorized))
+	}
+
+	req := new(domain.GetByIDRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	err := h.apiKeyUseCase.Revoke(ctx, principal.UserID, req.ID)
+	if err == nil {
+		return c.JSON(http.StatusOK, map[string]string{
+			"message": "The API key has been revoked.",
+			"status":  "success",
+		})
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return c.JSON(http.StatusNotFound, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
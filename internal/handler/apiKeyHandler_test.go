@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/internal/domain/mocks"
+	"github.com/nicewook/gocore/pkg/contextutil"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+func TestAPIKeyHandler_Create(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		viaAPIKey      bool
+		mockRequest    *domain.CreateAPIKeyRequest
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "Success",
+			requestBody:    `{"name":"batch","scopes":["User"]}`,
+			mockRequest:    &domain.CreateAPIKeyRequest{Name: "batch", Scopes: []string{domain.RoleUser}},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "Missing Name",
+			requestBody:    `{"scopes":["User"]}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Scope Not Held",
+			requestBody:    `{"name":"batch","scopes":["Admin"]}`,
+			mockRequest:    &domain.CreateAPIKeyRequest{Name: "batch", Scopes: []string{domain.RoleAdmin}},
+			mockError:      domain.ErrInvalidScope,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Impersonated",
+			requestBody:    `{"name":"batch"}`,
+			mockRequest:    &domain.CreateAPIKeyRequest{Name: "batch"},
+			mockError:      domain.ErrImpersonated,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "API Key Cannot Create API Keys",
+			requestBody:    `{"name":"batch"}`,
+			viaAPIKey:      true,
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, rec, e := newMeTestContext(http.MethodPost, "/me/api-keys", tt.requestBody)
+			if tt.viaAPIKey {
+				contextutil.SetPrincipal(c, contextutil.NewAPIKeyPrincipal(1, "john@example.com", []string{domain.RoleUser}, 7, nil))
+			}
+
+			mockAPIKeyUseCase := new(mocks.APIKeyUseCase)
+			if tt.mockRequest != nil {
+				var mockReturn *domain.CreateAPIKeyResponse
+				if tt.mockError == nil {
+					mockReturn = &domain.CreateAPIKeyResponse{
+						APIKey: &domain.APIKey{ID: 1, Name: "batch", Prefix: "gk_abcdefgh", KeyHash: "hash", Scopes: tt.mockRequest.Scopes},
+						Key:    "gk_abcdefgh-secret",
+					}
+				}
+				mockAPIKeyUseCase.On("Create", mock.Anything, int64(1), tt.mockRequest).Return(mockReturn, tt.mockError)
+			}
+
+			handler := NewAPIKeyHandler(e, mockAPIKeyUseCase)
+
+			err := handler.Create(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusCreated {
+				var response map[string]interface{}
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, "gk_abcdefgh-secret", response["key"])
+				assert.NotContains(t, response, "key_hash")
+			}
+
+			mockAPIKeyUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAPIKeyHandler_List(t *testing.T) {
+	c, rec, e := newMeTestContext(http.MethodGet, "/me/api-keys", "")
+
+	mockAPIKeyUseCase := new(mocks.APIKeyUseCase)
+	mockAPIKeyUseCase.On("List", mock.Anything, int64(1)).
+		Return([]*domain.APIKey{{ID: 1, Name: "batch", Prefix: "gk_abcdefgh", KeyHash: "hash"}}, nil)
+
+	handler := NewAPIKeyHandler(e, mockAPIKeyUseCase)
+
+	err := handler.List(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "hash")
+
+	mockAPIKeyUseCase.AssertExpectations(t)
+}
+
+func TestAPIKeyHandler_Revoke(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		mockError      error
+		expectCall     bool
+		expectedStatus int
+	}{
+		{
+			name:           "Success",
+			id:             "1",
+			expectCall:     true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Not Own Key",
+			id:             "2",
+			mockError:      domain.ErrNotFound,
+			expectCall:     true,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Invalid ID",
+			id:             "abc",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, rec, e := newMeTestContext(http.MethodDelete, "/me/api-keys/"+tt.id, "")
+			c.SetParamNames("id")
+			c.SetParamValues(tt.id)
+
+			mockAPIKeyUseCase := new(mocks.APIKeyUseCase)
+			if tt.expectCall {
+				mockAPIKeyUseCase.On("Revoke", mock.Anything, int64(1), mock.AnythingOfType("int64")).Return(tt.mockError)
+			}
+
+			handler := NewAPIKeyHandler(e, mockAPIKeyUseCase)
+
+			err := handler.Revoke(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			mockAPIKeyUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+// API 키로 인증한 요청은 API 키를 관리할 수 없다
+func TestPrincipalForKeyManagement(t *testing.T) {
+	c, _, _ := newMeTestContext(http.MethodGet, "/me/api-keys", "")
+	principal, status := principalForKeyManagement(c)
+	assert.NotNil(t, principal)
+	assert.Equal(t, http.StatusOK, status)
+
+	contextutil.SetPrincipal(c, &contextutil.Principal{UserID: 1, TokenType: security.APIKey})
+	principal, status = principalForKeyManagement(c)
+	assert.Nil(t, principal)
+	assert.Equal(t, http.StatusForbidden, status)
+}
@@ -29,12 +29,22 @@ func NewAuthHandler(e *echo.Echo, authUseCase domain.AuthUseCase, config *config
 		config:      config,
 	}
 
-	group := e.Group("/auth", middlewares.AllowRoles(domain.RolePublic))
+	group := e.Group("/auth")
 	group.POST("/signup", handler.SignUpUser)
 	group.POST("/login", handler.Login)
+	group.POST("/login/mfa", handler.LoginMFA)
+	group.POST("/login/mfa/enroll", handler.EnrollMFAWithChallenge)
 	group.POST("/refresh-token", handler.RefreshToken)
-	group.POST("/logout", handler.Logout, middlewares.AllowRoles(
-		domain.RoleAdmin, domain.RoleManager, domain.RoleUser))
+	group.POST("/password/forgot", handler.ForgotPassword)
+	group.POST("/password/reset", handler.ResetPassword)
+	group.GET("/verify", handler.VerifyEmail)
+	group.POST("/verify/resend", handler.ResendVerification)
+	middlewares.Route(group, http.MethodPost, "/logout", handler.Logout, domain.PermAccountManage)
+
+	mfaGroup := group.Group("/mfa")
+	middlewares.Route(mfaGroup, http.MethodPost, "/enroll", handler.EnrollMFA, domain.PermAccountManage)
+	middlewares.Route(mfaGroup, http.MethodPost, "/confirm", handler.ConfirmMFA, domain.PermAccountManage)
+	middlewares.Route(mfaGroup, http.MethodPost, "/disable", handler.DisableMFA, domain.PermAccountManage)
 
 	return handler
 }
@@ -64,6 +74,8 @@ func (h *AuthHandler) SignUpUser(c echo.Context) error {
 	}
 
 	switch {
+	case errors.Is(err, domain.ErrWeakPassword):
+		return c.JSON(http.StatusBadRequest, PasswordPolicyResponse(err))
 	case errors.Is(err, domain.ErrInvalidInput):
 		return c.JSON(http.StatusBadRequest, ErrResponse(err))
 	case errors.Is(err, domain.ErrAlreadyExists):
@@ -86,6 +98,11 @@ func (h *AuthHandler) Login(c echo.Context) error {
 	ctx := c.Request().Context()
 	loginResponse, err := h.authUseCase.Login(ctx, req.Email, req.Password)
 	if err == nil {
+		// MFA 나 비밀번호 변경이 필요하면 리프레시 토큰 없이 다음 단계의 토큰만 반환한다
+		if loginResponse.MFARequired || loginResponse.PasswordChangeRequired {
+			return c.JSON(http.StatusOK, loginResponse)
+		}
+
 		// Set refresh token as HTTP-only cookie
 		cookie := h.createRefreshTokenCookie(
 			loginResponse.RefreshToken,
@@ -96,8 +113,12 @@ func (h *AuthHandler) Login(c echo.Context) error {
 	}
 
 	switch {
-	case errors.Is(err, domain.ErrInvalidInput):
-		return c.JSON(http.StatusUnauthorized, ErrResponse(errors.New("invalid email or password")))
+	case errors.Is(err, domain.ErrInvalidCredentials), errors.Is(err, domain.ErrInvalidInput):
+		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrInvalidCredentials))
+	case errors.Is(err, domain.ErrTooManyAttempts):
+		return c.JSON(http.StatusTooManyRequests, ErrResponse(err))
+	case errors.Is(err, domain.ErrEmailNotVerified), errors.Is(err, domain.ErrAccountDisabled):
+		return c.JSON(http.StatusForbidden, ErrResponse(err))
 	default:
 		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
 	}
@@ -105,8 +126,11 @@ func (h *AuthHandler) Login(c echo.Context) error {
 
 // createRefreshTokenCookie creates a secure HTTP-only cookie for the refresh token
 func (h *AuthHandler) createRefreshTokenCookie(tokenValue string, expiration time.Time) *http.Cookie {
+	return newRefreshTokenCookie(h.config.Secure.JWT.Cookie, tokenValue, expiration)
+}
 
-	cookieConfig := h.config.Secure.JWT.Cookie
+// newRefreshTokenCookie 는 리프레시 토큰 쿠키를 만든다. 토큰을 새로 발급하는 다른 핸들러에서도 사용한다
+func newRefreshTokenCookie(cookieConfig config.CookieConfig, tokenValue string, expiration time.Time) *http.Cookie {
 
 	// Parse SameSite value
 	sameSite := http.SameSiteLaxMode
@@ -140,32 +164,35 @@ func (h *AuthHandler) CreateLogoutCookie() *http.Cookie {
 }
 
 // Logout handles user logout by invalidating tokens
+// 현재 액세스 토큰(jti)을 폐기 목록에 추가하고, 쿠키로 전달된 리프레시 토큰의 family 를 서버에서 폐기한다.
 // TODO: 향후 보안 강화를 위한 개선 사항
-// 1. 리프레시 토큰 블랙리스트 구현:
-//   - Redis 또는 다른 인메모리 저장소를 사용하여 무효화된 토큰 관리
-//   - 토큰의 jti(JWT ID)를 블랙리스트에 추가하고 만료 시간까지 유지
-//   - 모든 인증 요청에서 토큰이 블랙리스트에 있는지 확인
-//
-// 2. 다중 디바이스 지원:
-//   - 사용자별로 발급된 모든 토큰 정보 저장
-//   - 각 로그인 세션마다 고유 디바이스/세션 ID 발급
-//   - JWT 클레임에 디바이스/세션 ID 포함
+// 1. 다중 디바이스 지원:
+//   - 리프레시 토큰 family 를 로그인 세션으로 보고 디바이스 정보 저장
 //   - 특정 디바이스만 로그아웃하거나 모든 디바이스에서 로그아웃 기능 구현
 //
-// 3. 보안 강화:
+// 2. 보안 강화:
 //   - 액세스 토큰 수명 단축 (5-15분)
 //   - 중요 작업 수행 시 재인증 요구
 //   - 비정상적인 접근 패턴 감지 및 차단
 func (h *AuthHandler) Logout(c echo.Context) error {
-	// 컨텍스트에서 사용자 ID 가져오기
-	userID, _, _, err := contextutil.TokenToUser(c)
+	// 컨텍스트에서 인증된 사용자 정보 가져오기
+	principal, err := contextutil.GetPrincipal(c)
 	if err != nil {
 		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrUnauthorized))
 	}
 
+	req := &domain.LogoutRequest{
+		UserID:               principal.UserID,
+		ActorID:              principal.ActorID,
+		AccessTokenID:        principal.TokenID,
+		AccessTokenExpiresAt: principal.ExpiresAt,
+	}
+	if cookie, err := c.Cookie(refreshTokenCookieName); err == nil {
+		req.RefreshToken = cookie.Value
+	}
+
 	ctx := c.Request().Context()
-	// 로그아웃 처리 - 현재는 간단하게 구현
-	err = h.authUseCase.Logout(ctx, userID)
+	err = h.authUseCase.Logout(ctx, req)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
 	}
@@ -197,6 +224,8 @@ func (h *AuthHandler) RefreshToken(c echo.Context) error {
 			return c.JSON(http.StatusUnauthorized, ErrResponse(errors.New("invalid or expired refresh token")))
 		case errors.Is(err, domain.ErrUnauthorized):
 			return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrUnauthorized))
+		case errors.Is(err, domain.ErrAccountDisabled):
+			return c.JSON(http.StatusForbidden, ErrResponse(err))
 		default:
 			return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
 		}
@@ -209,3 +238,261 @@ func (h *AuthHandler) RefreshToken(c echo.Context) error {
 	// Return new access token
 	return c.JSON(http.StatusOK, loginResponse)
 }
+
+// ForgotPassword sends a password reset link to the email
+// 가입 여부를 알 수 없도록 가입되지 않은 이메일이어도 같은 응답을 반환한다
+func (h *AuthHandler) ForgotPassword(c echo.Context) error {
+	req := new(domain.ForgotPasswordRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	if err := h.authUseCase.ForgotPassword(ctx, req.Email); err != nil {
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "If an account with that email exists, a password reset link has been sent.",
+		"status":  "success",
+	})
+}
+
+// ResetPassword changes the password with a reset token sent by email
+func (h *AuthHandler) ResetPassword(c echo.Context) error {
+	req := new(domain.ResetPasswordRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	err := h.authUseCase.ResetPassword(ctx, req)
+	if err == nil {
+		return c.JSON(http.StatusOK, map[string]string{
+			"message": "Password has been reset. Please log in with your new password.",
+			"status":  "success",
+		})
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrWeakPassword):
+		return c.JSON(http.StatusBadRequest, PasswordPolicyResponse(err))
+	case errors.Is(err, domain.ErrInvalidToken):
+		return c.JSON(http.StatusBadRequest, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
+
+// VerifyEmail verifies the email address with the token sent by email
+func (h *AuthHandler) VerifyEmail(c echo.Context) error {
+	req := new(domain.VerifyEmailRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	err := h.authUseCase.VerifyEmail(ctx, req.Token)
+	if err == nil {
+		return c.JSON(http.StatusOK, map[string]string{
+			"message": "Email has been verified.",
+			"status":  "success",
+		})
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrInvalidToken):
+		return c.JSON(http.StatusBadRequest, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
+
+// ResendVerification sends the verification email again
+// 가입 여부를 알 수 없도록 항상 같은 응답을 반환한다
+func (h *AuthHandler) ResendVerification(c echo.Context) error {
+	req := new(domain.ResendVerificationRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	if err := h.authUseCase.ResendVerification(ctx, req.Email); err != nil {
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "If the email is registered and not yet verified, a verification link has been sent.",
+		"status":  "success",
+	})
+}
+
+// LoginMFA completes login with the MFA token and a TOTP or recovery code
+func (h *AuthHandler) LoginMFA(c echo.Context) error {
+	req := new(domain.MFALoginRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	loginResponse, err := h.authUseCase.LoginMFA(ctx, req)
+	if err == nil {
+		cookie := h.createRefreshTokenCookie(
+			loginResponse.RefreshToken,
+			loginResponse.RefreshTokenExpiration)
+		c.SetCookie(cookie)
+
+		return c.JSON(http.StatusOK, loginResponse)
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrUnauthorized), errors.Is(err, domain.ErrInvalidMFACode):
+		return c.JSON(http.StatusUnauthorized, ErrResponse(err))
+	case errors.Is(err, domain.ErrTooManyAttempts):
+		return c.JSON(http.StatusTooManyRequests, ErrResponse(err))
+	case errors.Is(err, domain.ErrMFAEnrollmentNeeded):
+		return c.JSON(http.StatusBadRequest, ErrResponse(err))
+	case errors.Is(err, domain.ErrAccountDisabled):
+		return c.JSON(http.StatusForbidden, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
+
+// EnrollMFAWithChallenge starts MFA enrollment during login for roles that require MFA
+func (h *AuthHandler) EnrollMFAWithChallenge(c echo.Context) error {
+	req := new(domain.MFAChallengeRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	enrollResponse, err := h.authUseCase.EnrollMFAWithChallenge(ctx, req.MFAToken)
+	if err != nil {
+		return h.mfaErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, enrollResponse)
+}
+
+// EnrollMFA issues a new TOTP secret for the authenticated user
+// 유출된 API 키로 공격자의 인증 앱을 등록하지 못하도록 API 키로 인증한 요청은 거부한다
+func (h *AuthHandler) EnrollMFA(c echo.Context) error {
+	principal, err := contextutil.GetPrincipal(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrUnauthorized))
+	}
+	if principal.TokenType == security.APIKey {
+		return c.JSON(http.StatusForbidden, ErrResponse(domain.ErrForbidden))
+	}
+
+	ctx := c.Request().Context()
+	enrollResponse, err := h.authUseCase.EnrollMFA(ctx, principal.UserID)
+	if err != nil {
+		return h.mfaErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, enrollResponse)
+}
+
+// ConfirmMFA enables MFA with a code from the authenticator app and returns recovery codes
+// EnrollMFA 와 같이 API 키로 인증한 요청은 거부한다
+func (h *AuthHandler) ConfirmMFA(c echo.Context) error {
+	principal, err := contextutil.GetPrincipal(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrUnauthorized))
+	}
+	if principal.TokenType == security.APIKey {
+		return c.JSON(http.StatusForbidden, ErrResponse(domain.ErrForbidden))
+	}
+
+	req := new(domain.MFACodeRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	confirmResponse, err := h.authUseCase.ConfirmMFA(ctx, principal.UserID, req.Code)
+	if err != nil {
+		return h.mfaErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, confirmResponse)
+}
+
+// DisableMFA disables MFA after checking a TOTP or recovery code
+// EnrollMFA 와 같이 API 키로 인증한 요청은 거부한다
+func (h *AuthHandler) DisableMFA(c echo.Context) error {
+	principal, err := contextutil.GetPrincipal(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrUnauthorized))
+	}
+	if principal.TokenType == security.APIKey {
+		return c.JSON(http.StatusForbidden, ErrResponse(domain.ErrForbidden))
+	}
+
+	req := new(domain.MFACodeRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	if err := h.authUseCase.DisableMFA(ctx, principal.UserID, req.Code); err != nil {
+		return h.mfaErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "MFA has been disabled.",
+		"status":  "success",
+	})
+}
+
+// mfaErrorResponse 는 MFA 등록/확인/해제 에러를 응답 코드로 변환한다
+func (h *AuthHandler) mfaErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrUnauthorized):
+		return c.JSON(http.StatusUnauthorized, ErrResponse(err))
+	case errors.Is(err, domain.ErrImpersonated):
+		return c.JSON(http.StatusForbidden, ErrResponse(err))
+	case errors.Is(err, domain.ErrInvalidMFACode), errors.Is(err, domain.ErrMFAEnrollmentNeeded):
+		return c.JSON(http.StatusBadRequest, ErrResponse(err))
+	case errors.Is(err, domain.ErrMFAAlreadyEnabled):
+		return c.JSON(http.StatusConflict, ErrResponse(err))
+	case errors.Is(err, domain.ErrNotFound):
+		return c.JSON(http.StatusNotFound, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
@@ -2,13 +2,13 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -16,6 +16,7 @@ import (
 	"github.com/nicewook/gocore/internal/config"
 	"github.com/nicewook/gocore/internal/domain"
 	"github.com/nicewook/gocore/internal/domain/mocks"
+	"github.com/nicewook/gocore/pkg/contextutil"
 	"github.com/nicewook/gocore/pkg/security"
 	"github.com/nicewook/gocore/pkg/validatorutil"
 )
@@ -115,6 +116,24 @@ func TestAuthHandler_Login(t *testing.T) {
 			mockError:      nil,
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name:           "MFA Required",
+			loginRequest:   `{"email":"john@example.com","password":"password"}`,
+			mockEmail:      "john@example.com",
+			mockPassword:   "password",
+			mockReturn:     &domain.LoginResponse{ID: 1, Email: "john@example.com", MFARequired: true, MFAToken: "mfa-token"},
+			mockError:      nil,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Password Change Required",
+			loginRequest:   `{"email":"admin@example.com","password":"password"}`,
+			mockEmail:      "admin@example.com",
+			mockPassword:   "password",
+			mockReturn:     &domain.LoginResponse{ID: 1, Email: "admin@example.com", PasswordChangeRequired: true, PasswordChangeToken: "reset-token"},
+			mockError:      nil,
+			expectedStatus: http.StatusOK,
+		},
 		{
 			name:           "Invalid Input",
 			loginRequest:   `{"email":"","password":""}`,
@@ -130,9 +149,18 @@ func TestAuthHandler_Login(t *testing.T) {
 			mockEmail:      "john@example.com",
 			mockPassword:   "wrong",
 			mockReturn:     nil,
-			mockError:      domain.ErrInvalidInput,
+			mockError:      domain.ErrInvalidCredentials,
 			expectedStatus: http.StatusUnauthorized,
 		},
+		{
+			name:           "Too Many Attempts",
+			loginRequest:   `{"email":"john@example.com","password":"wrong"}`,
+			mockEmail:      "john@example.com",
+			mockPassword:   "wrong",
+			mockReturn:     nil,
+			mockError:      domain.ErrTooManyAttempts,
+			expectedStatus: http.StatusTooManyRequests,
+		},
 		{
 			name:           "Internal Error",
 			loginRequest:   `{"email":"john@example.com","password":"password"}`,
@@ -175,8 +203,24 @@ func TestAuthHandler_Login(t *testing.T) {
 				return
 			}
 
-			// 성공 케이스에서는 쿠키가 설정되었는지 확인
+			// MFA 를 마치기 전에는 리프레시 토큰 쿠키를 설정하지 않는다
 			cookies := rec.Result().Cookies()
+			if tt.mockReturn.MFARequired {
+				assert.Empty(t, cookies)
+				assert.Contains(t, rec.Body.String(), `"mfa_token":"mfa-token"`)
+				assert.NotContains(t, rec.Body.String(), "access_token")
+				return
+			}
+
+			// 비밀번호를 바꾸기 전에도 리프레시 토큰 쿠키를 설정하지 않는다
+			if tt.mockReturn.PasswordChangeRequired {
+				assert.Empty(t, cookies)
+				assert.Contains(t, rec.Body.String(), `"password_change_token":"reset-token"`)
+				assert.NotContains(t, rec.Body.String(), "access_token")
+				return
+			}
+
+			// 성공 케이스에서는 쿠키가 설정되었는지 확인
 
 			var refreshTokenCookie *http.Cookie
 			for _, cookie := range cookies {
@@ -194,28 +238,48 @@ func TestAuthHandler_Login(t *testing.T) {
 
 func TestAuthHandler_Logout(t *testing.T) {
 	// 테스트 데이터 정의
+	accessTokenExpiresAt := time.Unix(time.Now().Add(time.Hour).Unix(), 0)
+
 	tests := []struct {
-		name           string
-		setupAuth      func(c echo.Context)
-		expectedStatus int
-		expectedBody   string
+		name                 string
+		setupAuth            func(c echo.Context)
+		cookieValue          string
+		accessTokenID        string
+		accessTokenExpiresAt time.Time
+		expectedStatus       int
+		expectedBody         string
 	}{
 		{
-			name: "Success",
+			name:                 "Success",
+			cookieValue:          "refresh-token",
+			accessTokenID:        "access-jti",
+			accessTokenExpiresAt: accessTokenExpiresAt,
+			setupAuth: func(c echo.Context) {
+				// JWT 토큰을 모킹하는 대신 인증된 주체를 직접 설정
+				contextutil.SetPrincipal(c, &contextutil.Principal{
+					UserID:    1,
+					Email:     "john@example.com",
+					Roles:     []string{"User"},
+					TokenType: security.AccessToken,
+					TokenID:   "access-jti",
+					ExpiresAt: accessTokenExpiresAt,
+				})
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"message":"Successfully logged out. Please remove the access token from your client storage.","status":"success"}`,
+		},
+		{
+			name: "Success Without Refresh Cookie",
 			setupAuth: func(c echo.Context) {
-				// JWT 토큰을 모킹하는 대신 user_id를 직접 설정
-				c.Set("user", &jwt.Token{
-					Claims: jwt.MapClaims{
-						"user_id": float64(1),
-						"email":   "john@example.com",
-						"roles":   []interface{}{"User"},
-					},
+				contextutil.SetPrincipal(c, &contextutil.Principal{
+					UserID: 1,
+					Email:  "john@example.com",
+					Roles:  []string{"User"},
 				})
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody:   `{"message":"Successfully logged out. Please remove the access token from your client storage.","status":"success"}`,
 		},
-		// 다른 테스트 케이스는 필요 시 추가
 	}
 
 	for _, tt := range tests {
@@ -225,6 +289,9 @@ func TestAuthHandler_Logout(t *testing.T) {
 			e.Validator = validatorutil.NewValidator()
 
 			req := httptest.NewRequest(http.MethodPost, "/auth/logout", nil)
+			if tt.cookieValue != "" {
+				req.AddCookie(&http.Cookie{Name: refreshTokenCookieName, Value: tt.cookieValue})
+			}
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 
@@ -234,7 +301,12 @@ func TestAuthHandler_Logout(t *testing.T) {
 			}
 
 			mockUseCase := new(mocks.AuthUseCase)
-			mockUseCase.On("Logout", mock.Anything, int64(1)).Return(nil)
+			mockUseCase.On("Logout", mock.Anything, &domain.LogoutRequest{
+				UserID:               1,
+				AccessTokenID:        tt.accessTokenID,
+				AccessTokenExpiresAt: tt.accessTokenExpiresAt,
+				RefreshToken:         tt.cookieValue,
+			}).Return(nil)
 
 			handler := NewAuthHandler(e, mockUseCase, authConfig)
 
@@ -386,6 +458,7 @@ func TestSignUpRequestValidation(t *testing.T) {
 	tests := []struct {
 		name          string
 		requestBody   string
+		policyError   *domain.PasswordPolicyError // 유스케이스의 비밀번호 정책 검사 결과
 		expectedCode  int
 		expectedError bool
 	}{
@@ -414,8 +487,11 @@ func TestSignUpRequestValidation(t *testing.T) {
 			expectedError: true,
 		},
 		{
-			name:          "Password Too Short",
-			requestBody:   `{"email":"test@example.com","password":"short"}`,
+			name:        "Password Too Short",
+			requestBody: `{"email":"test@example.com","password":"short"}`,
+			policyError: &domain.PasswordPolicyError{Reasons: []domain.PasswordPolicyReason{
+				{Code: "too_short", Message: "must be at least 8 characters"},
+			}},
 			expectedCode:  http.StatusBadRequest,
 			expectedError: true,
 		},
@@ -460,6 +536,11 @@ func TestSignUpRequestValidation(t *testing.T) {
 			// Setup mock
 			mockUseCase := new(mocks.AuthUseCase)
 
+			// 비밀번호 길이 등은 유스케이스에서 정책으로 검사한다
+			if tt.policyError != nil {
+				mockUseCase.On("SignUpUser", mock.Anything, mock.AnythingOfType("*domain.User")).Return(nil, tt.policyError)
+			}
+
 			// 유효한 요청인 경우에만 모킹
 			if !tt.expectedError {
 				mockUser := &domain.User{
@@ -491,6 +572,13 @@ func TestSignUpRequestValidation(t *testing.T) {
 				err := json.Unmarshal(rec.Body.Bytes(), &response)
 				assert.NoError(t, err)
 				assert.Contains(t, response, "error")
+
+				// 정책 위반은 필드별 사유를 함께 반환한다
+				if tt.policyError != nil {
+					fields := response["fields"].(map[string]interface{})
+					reasons := fields["password"].([]interface{})
+					assert.Equal(t, "too_short", reasons[0].(map[string]interface{})["code"])
+				}
 			} else {
 				// 성공 케이스에서는 모킹 함수 호출 확인
 				mockUseCase.AssertExpectations(t)
@@ -505,3 +593,394 @@ func TestSignUpRequestValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthHandler_ForgotPassword(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		mockEmail      string
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "Success",
+			requestBody:    `{"email":"john@example.com"}`,
+			mockEmail:      "john@example.com",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid Email",
+			requestBody:    `{"email":"not-an-email"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Internal Error",
+			requestBody:    `{"email":"john@example.com"}`,
+			mockEmail:      "john@example.com",
+			mockError:      errors.New("database error"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = validatorutil.NewValidator()
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/password/forgot", strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockUseCase := new(mocks.AuthUseCase)
+			if tt.mockEmail != "" {
+				mockUseCase.On("ForgotPassword", mock.Anything, tt.mockEmail).Return(tt.mockError)
+			}
+
+			handler := NewAuthHandler(e, mockUseCase, authConfig)
+
+			err := handler.ForgotPassword(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthHandler_ResetPassword(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		mockRequest    *domain.ResetPasswordRequest
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "Success",
+			requestBody:    `{"token":"reset-token","password":"newpassword123"}`,
+			mockRequest:    &domain.ResetPasswordRequest{Token: "reset-token", Password: "newpassword123"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "Weak Password",
+			requestBody: `{"token":"reset-token","password":"short"}`,
+			mockRequest: &domain.ResetPasswordRequest{Token: "reset-token", Password: "short"},
+			mockError: &domain.PasswordPolicyError{Reasons: []domain.PasswordPolicyReason{
+				{Code: "too_short", Message: "must be at least 8 characters"},
+			}},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Missing Password",
+			requestBody:    `{"token":"reset-token"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid Token",
+			requestBody:    `{"token":"used-token","password":"newpassword123"}`,
+			mockRequest:    &domain.ResetPasswordRequest{Token: "used-token", Password: "newpassword123"},
+			mockError:      domain.ErrInvalidToken,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = validatorutil.NewValidator()
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/password/reset", strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockUseCase := new(mocks.AuthUseCase)
+			if tt.mockRequest != nil {
+				mockUseCase.On("ResetPassword", mock.Anything, tt.mockRequest).Return(tt.mockError)
+			}
+
+			handler := NewAuthHandler(e, mockUseCase, authConfig)
+
+			err := handler.ResetPassword(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthHandler_VerifyEmail(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		mockToken      string
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "Success",
+			query:          "?token=verify-token",
+			mockToken:      "verify-token",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Missing Token",
+			query:          "",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid Token",
+			query:          "?token=used-token",
+			mockToken:      "used-token",
+			mockError:      domain.ErrInvalidToken,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = validatorutil.NewValidator()
+
+			req := httptest.NewRequest(http.MethodGet, "/auth/verify"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockUseCase := new(mocks.AuthUseCase)
+			if tt.mockToken != "" {
+				mockUseCase.On("VerifyEmail", mock.Anything, tt.mockToken).Return(tt.mockError)
+			}
+
+			handler := NewAuthHandler(e, mockUseCase, authConfig)
+
+			err := handler.VerifyEmail(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthHandler_ResendVerification(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		mockEmail      string
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "Success",
+			requestBody:    `{"email":"john@example.com"}`,
+			mockEmail:      "john@example.com",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid Email",
+			requestBody:    `{"email":"not-an-email"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Internal Error",
+			requestBody:    `{"email":"john@example.com"}`,
+			mockEmail:      "john@example.com",
+			mockError:      errors.New("database error"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = validatorutil.NewValidator()
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/verify/resend", strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockUseCase := new(mocks.AuthUseCase)
+			if tt.mockEmail != "" {
+				mockUseCase.On("ResendVerification", mock.Anything, tt.mockEmail).Return(tt.mockError)
+			}
+
+			handler := NewAuthHandler(e, mockUseCase, authConfig)
+
+			err := handler.ResendVerification(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthHandler_LoginMFA(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		mockRequest    *domain.MFALoginRequest
+		mockReturn     *domain.LoginResponse
+		mockError      error
+		expectedStatus int
+		expectCookie   bool
+	}{
+		{
+			name:           "Success",
+			requestBody:    `{"mfa_token":"mfa-token","code":"123456"}`,
+			mockRequest:    &domain.MFALoginRequest{MFAToken: "mfa-token", Code: "123456"},
+			mockReturn:     &domain.LoginResponse{ID: 1, Email: "john@example.com", AccessToken: "jwt-token", RefreshToken: "refresh-token", RefreshTokenExpiration: time.Now().Add(24 * time.Hour)},
+			expectedStatus: http.StatusOK,
+			expectCookie:   true,
+		},
+		{
+			name:           "Missing Code",
+			requestBody:    `{"mfa_token":"mfa-token"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid Code",
+			requestBody:    `{"mfa_token":"mfa-token","code":"000000"}`,
+			mockRequest:    &domain.MFALoginRequest{MFAToken: "mfa-token", Code: "000000"},
+			mockError:      domain.ErrInvalidMFACode,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Invalid MFA Token",
+			requestBody:    `{"mfa_token":"access-token","code":"123456"}`,
+			mockRequest:    &domain.MFALoginRequest{MFAToken: "access-token", Code: "123456"},
+			mockError:      domain.ErrUnauthorized,
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = validatorutil.NewValidator()
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/login/mfa", strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockUseCase := new(mocks.AuthUseCase)
+			if tt.mockRequest != nil {
+				mockUseCase.On("LoginMFA", mock.Anything, tt.mockRequest).Return(tt.mockReturn, tt.mockError)
+			}
+
+			handler := NewAuthHandler(e, mockUseCase, authConfig)
+
+			err := handler.LoginMFA(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectCookie, len(rec.Result().Cookies()) == 1)
+
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthHandler_ConfirmMFA(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		mockCode       string
+		mockReturn     *domain.MFAConfirmResponse
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "Success",
+			requestBody:    `{"code":"123456"}`,
+			mockCode:       "123456",
+			mockReturn:     &domain.MFAConfirmResponse{RecoveryCodes: []string{"abcde-fghij"}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid Code",
+			requestBody:    `{"code":"000000"}`,
+			mockCode:       "000000",
+			mockError:      domain.ErrInvalidMFACode,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Already Enabled",
+			requestBody:    `{"code":"123456"}`,
+			mockCode:       "123456",
+			mockError:      domain.ErrMFAAlreadyEnabled,
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = validatorutil.NewValidator()
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/mfa/confirm", strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			contextutil.SetPrincipal(c, &contextutil.Principal{UserID: 1, Email: "john@example.com", Roles: []string{domain.RoleUser}})
+
+			mockUseCase := new(mocks.AuthUseCase)
+			mockUseCase.On("ConfirmMFA", mock.Anything, int64(1), tt.mockCode).Return(tt.mockReturn, tt.mockError)
+
+			handler := NewAuthHandler(e, mockUseCase, authConfig)
+
+			err := handler.ConfirmMFA(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+// 유출된 API 키로 MFA 를 등록하거나 끄지 못하도록 API 키로 인증한 요청은 usecase 를 호출하지 않고 거부한다
+func TestAuthHandler_MFARejectsAPIKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  string
+		body    string
+		handler func(h *AuthHandler) echo.HandlerFunc
+	}{
+		{
+			name:    "Enroll",
+			target:  "/auth/mfa/enroll",
+			handler: func(h *AuthHandler) echo.HandlerFunc { return h.EnrollMFA },
+		},
+		{
+			name:    "Confirm",
+			target:  "/auth/mfa/confirm",
+			body:    `{"code":"123456"}`,
+			handler: func(h *AuthHandler) echo.HandlerFunc { return h.ConfirmMFA },
+		},
+		{
+			name:    "Disable",
+			target:  "/auth/mfa/disable",
+			body:    `{"code":"123456"}`,
+			handler: func(h *AuthHandler) echo.HandlerFunc { return h.DisableMFA },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, rec, e := newMeTestContext(http.MethodPost, tt.target, tt.body)
+			contextutil.SetPrincipal(c, contextutil.NewAPIKeyPrincipal(1, "john@example.com", []string{domain.RoleUser}, 7, nil))
+
+			mockUseCase := new(mocks.AuthUseCase)
+			handler := NewAuthHandler(e, mockUseCase, authConfig)
+
+			err := tt.handler(handler)(c)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/nicewook/gocore/internal/config"
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/internal/middlewares"
+	"github.com/nicewook/gocore/pkg/contextutil"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+// MeHandler handles requests for the authenticated user's own account
+type MeHandler struct {
+	authUseCase domain.AuthUseCase
+	userUseCase domain.UserUseCase
+	config      *config.Config
+}
+
+func NewMeHandler(e *echo.Echo, authUseCase domain.AuthUseCase, userUseCase domain.UserUseCase, config *config.Config) *MeHandler {
+	handler := &MeHandler{
+		authUseCase: authUseCase,
+		userUseCase: userUseCase,
+		config:      config,
+	}
+
+	group := e.Group("/me")
+	middlewares.Route(group, http.MethodGet, "", handler.GetMe, domain.PermAccountManage)
+	middlewares.Route(group, http.MethodPatch, "", handler.UpdateMe, domain.PermAccountManage)
+	middlewares.Route(group, http.MethodDelete, "", handler.DeleteMe, domain.PermAccountManage)
+	middlewares.Route(group, http.MethodPost, "/password", handler.ChangePassword, domain.PermAccountManage)
+	middlewares.Route(group, http.MethodGet, "/sessions", handler.ListSessions, domain.PermAccountManage)
+	middlewares.Route(group, http.MethodDelete, "/sessions", handler.RevokeOtherSessions, domain.PermAccountManage)
+	middlewares.Route(group, http.MethodDelete, "/sessions/:id", handler.RevokeSession, domain.PermAccountManage)
+
+	return handler
+}
+
+// GetMe returns the authenticated user's account
+func (h *MeHandler) GetMe(c echo.Context) error {
+	principal, err := contextutil.GetPrincipal(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrUnauthorized))
+	}
+
+	ctx := c.Request().Context()
+	user, err := h.userUseCase.GetByID(ctx, principal.UserID)
+	if err == nil {
+		return c.JSON(http.StatusOK, user)
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return c.JSON(http.StatusNotFound, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
+
+// UpdateMe updates the authenticated user's name and email
+// 유출된 API 키로 이메일을 바꿔 계정을 가져가지 못하도록 API 키로 인증한 요청은 거부한다
+func (h *MeHandler) UpdateMe(c echo.Context) error {
+	principal, err := contextutil.GetPrincipal(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrUnauthorized))
+	}
+	if principal.TokenType == security.APIKey {
+		return c.JSON(http.StatusForbidden, ErrResponse(domain.ErrForbidden))
+	}
+
+	req := new(domain.UpdateProfileRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	user, err := h.authUseCase.UpdateProfile(ctx, principal.UserID, req)
+	if err == nil {
+		return c.JSON(http.StatusOK, user)
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrIncorrectPassword):
+		return c.JSON(http.StatusBadRequest, ErrResponse(err))
+	case errors.Is(err, domain.ErrImpersonated):
+		return c.JSON(http.StatusForbidden, ErrResponse(err))
+	case errors.Is(err, domain.ErrTooManyAttempts):
+		return c.JSON(http.StatusTooManyRequests, ErrResponse(err))
+	case errors.Is(err, domain.ErrAlreadyExists):
+		return c.JSON(http.StatusConflict, ErrResponse(domain.ErrAlreadyExists))
+	case errors.Is(err, domain.ErrNotFound):
+		return c.JSON(http.StatusNotFound, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
+
+// DeleteMe deletes the authenticated user's account after checking the password
+// 모든 토큰이 폐기되고 리프레시 토큰 쿠키도 지운다. 유예 기간(erase_after) 동안은 관리자가 복구할 수 있다
+func (h *MeHandler) DeleteMe(c echo.Context) error {
+	principal, err := contextutil.GetPrincipal(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrUnauthorized))
+	}
+
+	req := new(domain.DeleteAccountRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	deleteResponse, err := h.authUseCase.DeleteAccount(ctx, principal.UserID, req)
+	if err == nil {
+		c.SetCookie(newRefreshTokenCookie(h.config.Secure.JWT.Cookie, "", time.Now().Add(-1*time.Hour)))
+		return c.JSON(http.StatusOK, deleteResponse)
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrIncorrectPassword):
+		return c.JSON(http.StatusBadRequest, ErrResponse(err))
+	case errors.Is(err, domain.ErrImpersonated):
+		return c.JSON(http.StatusForbidden, ErrResponse(err))
+	case errors.Is(err, domain.ErrTooManyAttempts):
+		return c.JSON(http.StatusTooManyRequests, ErrResponse(err))
+	case errors.Is(err, domain.ErrNotFound):
+		return c.JSON(http.StatusNotFound, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
+
+// ChangePassword changes the password after checking the current one
+// 다른 세션은 모두 종료되므로 새 액세스 토큰과 리프레시 토큰 쿠키를 함께 반환한다
+func (h *MeHandler) ChangePassword(c echo.Context) error {
+	principal, err := contextutil.GetPrincipal(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrUnauthorized))
+	}
+
+	req := new(domain.ChangePasswordRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	loginResponse, err := h.authUseCase.ChangePassword(ctx, principal.UserID, req)
+	if err == nil {
+		c.SetCookie(newRefreshTokenCookie(
+			h.config.Secure.JWT.Cookie,
+			loginResponse.RefreshToken,
+			loginResponse.RefreshTokenExpiration))
+
+		return c.JSON(http.StatusOK, loginResponse)
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrWeakPassword):
+		return c.JSON(http.StatusBadRequest, PasswordPolicyResponse(err))
+	case errors.Is(err, domain.ErrIncorrectPassword):
+		return c.JSON(http.StatusBadRequest, ErrResponse(err))
+	case errors.Is(err, domain.ErrImpersonated):
+		return c.JSON(http.StatusForbidden, ErrResponse(err))
+	case errors.Is(err, domain.ErrTooManyAttempts):
+		return c.JSON(http.StatusTooManyRequests, ErrResponse(err))
+	case errors.Is(err, domain.ErrNotFound):
+		return c.JSON(http.StatusNotFound, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
+
+// ListSessions returns the authenticated user's active sessions
+func (h *MeHandler) ListSessions(c echo.Context) error {
+	principal, err := contextutil.GetPrincipal(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrUnauthorized))
+	}
+
+	ctx := c.Request().Context()
+	sessions, err := h.authUseCase.ListSessions(ctx, principal.UserID, principal.SessionID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+
+	return c.JSON(http.StatusOK, sessions)
+}
+
+// RevokeSession signs out one of the authenticated user's sessions
+// 현재 세션도 종료할 수 있으며, 이미 발급된 액세스 토큰은 만료될 때까지 유효하다
+// API 키로 인증한 요청은 거부한다
+func (h *MeHandler) RevokeSession(c echo.Context) error {
+	principal, err := contextutil.GetPrincipal(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrUnauthorized))
+	}
+	if principal.TokenType == security.APIKey {
+		return c.JSON(http.StatusForbidden, ErrResponse(domain.ErrForbidden))
+	}
+
+	sessionID := c.Param("id")
+	if sessionID == "" {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	err = h.authUseCase.RevokeSession(ctx, principal.UserID, sessionID)
+	if err == nil {
+		return c.JSON(http.StatusOK, map[string]string{
+			"message": "The session has been signed out.",
+			"status":  "success",
+		})
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return c.JSON(http.StatusNotFound, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
+
+// RevokeOtherSessions signs out every session except the one making the request
+// API 키로 인증한 요청은 세션이 없어 모든 세션이 종료되므로 거부한다
+func (h *MeHandler) RevokeOtherSessions(c echo.Context) error {
+	principal, err := contextutil.GetPrincipal(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrUnauthorized))
+	}
+	if principal.TokenType == security.APIKey {
+		return c.JSON(http.StatusForbidden, ErrResponse(domain.ErrForbidden))
+	}
+
+	ctx := c.Request().Context()
+	if err := h.authUseCase.RevokeOtherSessions(ctx, principal.UserID, principal.SessionID); err != nil {
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "All other sessions have been signed out.",
+		"status":  "success",
+	})
+}
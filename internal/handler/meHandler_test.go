@@ -0,0 +1,405 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/internal/domain/mocks"
+	"github.com/nicewook/gocore/pkg/contextutil"
+	"github.com/nicewook/gocore/pkg/validatorutil"
+)
+
+func newMeTestContext(method, target, body string) (echo.Context, *httptest.ResponseRecorder, *echo.Echo) {
+	e := echo.New()
+	e.Validator = validatorutil.NewValidator()
+
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	contextutil.SetPrincipal(c, &contextutil.Principal{UserID: 1, Email: "john@example.com", Roles: []string{domain.RoleUser}})
+	return c, rec, e
+}
+
+func TestMeHandler_GetMe(t *testing.T) {
+	c, rec, e := newMeTestContext(http.MethodGet, "/me", "")
+
+	mockUserUseCase := new(mocks.UserUseCase)
+	mockUserUseCase.On("GetByID", mock.Anything, int64(1)).
+		Return(&domain.User{ID: 1, Name: "John", Email: "john@example.com", Password: "hash"}, nil)
+
+	handler := NewMeHandler(e, new(mocks.AuthUseCase), mockUserUseCase, authConfig)
+
+	err := handler.GetMe(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "hash")
+
+	var response domain.User
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "john@example.com", response.Email)
+
+	mockUserUseCase.AssertExpectations(t)
+}
+
+func TestMeHandler_UpdateMe(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		apiKey         bool
+		mockRequest    *domain.UpdateProfileRequest
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "Success",
+			requestBody:    `{"name":"Johnny","email":"johnny@example.com","current_password":"password123"}`,
+			mockRequest:    &domain.UpdateProfileRequest{Name: "Johnny", Email: "johnny@example.com", CurrentPassword: "password123"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Incorrect Current Password",
+			requestBody:    `{"email":"johnny@example.com","current_password":"wrongpassword"}`,
+			mockRequest:    &domain.UpdateProfileRequest{Email: "johnny@example.com", CurrentPassword: "wrongpassword"},
+			mockError:      domain.ErrIncorrectPassword,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Too Many Attempts",
+			requestBody:    `{"email":"johnny@example.com","current_password":"wrongpassword"}`,
+			mockRequest:    &domain.UpdateProfileRequest{Email: "johnny@example.com", CurrentPassword: "wrongpassword"},
+			mockError:      domain.ErrTooManyAttempts,
+			expectedStatus: http.StatusTooManyRequests,
+		},
+		{
+			name:           "Impersonated",
+			requestBody:    `{"name":"Johnny"}`,
+			mockRequest:    &domain.UpdateProfileRequest{Name: "Johnny"},
+			mockError:      domain.ErrImpersonated,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "API Key Rejected",
+			requestBody:    `{"name":"Johnny"}`,
+			apiKey:         true,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Invalid Email",
+			requestBody:    `{"email":"not-an-email"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Email Taken",
+			requestBody:    `{"email":"taken@example.com"}`,
+			mockRequest:    &domain.UpdateProfileRequest{Email: "taken@example.com"},
+			mockError:      domain.ErrAlreadyExists,
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, rec, e := newMeTestContext(http.MethodPatch, "/me", tt.requestBody)
+			if tt.apiKey {
+				contextutil.SetPrincipal(c, contextutil.NewAPIKeyPrincipal(1, "john@example.com", []string{domain.RoleUser}, 10, nil))
+			}
+
+			mockAuthUseCase := new(mocks.AuthUseCase)
+			if tt.mockRequest != nil {
+				var mockReturn *domain.User
+				if tt.mockError == nil {
+					mockReturn = &domain.User{ID: 1, Name: tt.mockRequest.Name, Email: tt.mockRequest.Email}
+				}
+				mockAuthUseCase.On("UpdateProfile", mock.Anything, int64(1), tt.mockRequest).Return(mockReturn, tt.mockError)
+			}
+
+			handler := NewMeHandler(e, mockAuthUseCase, new(mocks.UserUseCase), authConfig)
+
+			err := handler.UpdateMe(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			mockAuthUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMeHandler_DeleteMe(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		expectCall     bool
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "Success",
+			requestBody:    `{"password":"password"}`,
+			expectCall:     true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Missing Password",
+			requestBody:    `{}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Incorrect Password",
+			requestBody:    `{"password":"wrong"}`,
+			expectCall:     true,
+			mockError:      domain.ErrIncorrectPassword,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Impersonated",
+			requestBody:    `{"password":"password"}`,
+			expectCall:     true,
+			mockError:      domain.ErrImpersonated,
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, rec, e := newMeTestContext(http.MethodDelete, "/me", tt.requestBody)
+
+			mockAuthUseCase := new(mocks.AuthUseCase)
+			if tt.expectCall {
+				var mockReturn *domain.DeleteAccountResponse
+				if tt.mockError == nil {
+					deletedAt := time.Now()
+					mockReturn = &domain.DeleteAccountResponse{DeletedAt: deletedAt, EraseAfter: deletedAt.Add(30 * 24 * time.Hour)}
+				}
+				mockAuthUseCase.On("DeleteAccount", mock.Anything, int64(1), mock.AnythingOfType("*domain.DeleteAccountRequest")).Return(mockReturn, tt.mockError)
+			}
+
+			handler := NewMeHandler(e, mockAuthUseCase, new(mocks.UserUseCase), authConfig)
+
+			err := handler.DeleteMe(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			// 삭제되면 리프레시 토큰 쿠키를 만료시킨다
+			cookies := rec.Result().Cookies()
+			if tt.expectedStatus == http.StatusOK {
+				assert.Len(t, cookies, 1)
+				assert.Equal(t, refreshTokenCookieName, cookies[0].Name)
+				assert.Empty(t, cookies[0].Value)
+			} else {
+				assert.Empty(t, cookies)
+			}
+			mockAuthUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMeHandler_ChangePassword(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		mockRequest    *domain.ChangePasswordRequest
+		mockError      error
+		expectedStatus int
+		expectCookie   bool
+	}{
+		{
+			name:           "Success",
+			requestBody:    `{"current_password":"oldpassword","new_password":"newpassword123"}`,
+			mockRequest:    &domain.ChangePasswordRequest{CurrentPassword: "oldpassword", NewPassword: "newpassword123"},
+			expectedStatus: http.StatusOK,
+			expectCookie:   true,
+		},
+		{
+			name:           "Missing Current Password",
+			requestBody:    `{"new_password":"newpassword123"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Incorrect Current Password",
+			requestBody:    `{"current_password":"wrong","new_password":"newpassword123"}`,
+			mockRequest:    &domain.ChangePasswordRequest{CurrentPassword: "wrong", NewPassword: "newpassword123"},
+			mockError:      domain.ErrIncorrectPassword,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Weak Password",
+			requestBody: `{"current_password":"oldpassword","new_password":"short"}`,
+			mockRequest: &domain.ChangePasswordRequest{CurrentPassword: "oldpassword", NewPassword: "short"},
+			mockError: &domain.PasswordPolicyError{Reasons: []domain.PasswordPolicyReason{
+				{Code: "too_short", Message: "must be at least 8 characters"},
+			}},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Too Many Attempts",
+			requestBody:    `{"current_password":"oldpassword","new_password":"newpassword123"}`,
+			mockRequest:    &domain.ChangePasswordRequest{CurrentPassword: "oldpassword", NewPassword: "newpassword123"},
+			mockError:      domain.ErrTooManyAttempts,
+			expectedStatus: http.StatusTooManyRequests,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, rec, e := newMeTestContext(http.MethodPost, "/me/password", tt.requestBody)
+
+			mockAuthUseCase := new(mocks.AuthUseCase)
+			if tt.mockRequest != nil {
+				var mockReturn *domain.LoginResponse
+				if tt.mockError == nil {
+					mockReturn = &domain.LoginResponse{
+						ID:                     1,
+						Email:                  "john@example.com",
+						AccessToken:            "new-access-token",
+						RefreshToken:           "new-refresh-token",
+						RefreshTokenExpiration: time.Now().Add(time.Hour),
+					}
+				}
+				mockAuthUseCase.On("ChangePassword", mock.Anything, int64(1), tt.mockRequest).Return(mockReturn, tt.mockError)
+			}
+
+			handler := NewMeHandler(e, mockAuthUseCase, new(mocks.UserUseCase), authConfig)
+
+			err := handler.ChangePassword(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			// 성공하면 새 리프레시 토큰 쿠키로 교체한다
+			cookies := rec.Result().Cookies()
+			if tt.expectCookie {
+				assert.Len(t, cookies, 1)
+				assert.Equal(t, refreshTokenCookieName, cookies[0].Name)
+				assert.Equal(t, "new-refresh-token", cookies[0].Value)
+			} else {
+				assert.Empty(t, cookies)
+			}
+
+			mockAuthUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMeHandler_ListSessions(t *testing.T) {
+	c, rec, e := newMeTestContext(http.MethodGet, "/me/sessions", "")
+	principal, _ := contextutil.GetPrincipal(c)
+	principal.SessionID = "current"
+
+	mockAuthUseCase := new(mocks.AuthUseCase)
+	mockAuthUseCase.On("ListSessions", mock.Anything, int64(1), "current").
+		Return([]*domain.Session{{ID: "current", UserAgent: "test-agent", IP: "203.0.113.1", Current: true}}, nil)
+
+	handler := NewMeHandler(e, mockAuthUseCase, new(mocks.UserUseCase), authConfig)
+
+	err := handler.ListSessions(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response []domain.Session
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Len(t, response, 1)
+	assert.True(t, response[0].Current)
+
+	mockAuthUseCase.AssertExpectations(t)
+}
+
+func TestMeHandler_RevokeSession(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "Success",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Unknown Session",
+			mockError:      domain.ErrNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, rec, e := newMeTestContext(http.MethodDelete, "/me/sessions/session-1", "")
+			c.SetParamNames("id")
+			c.SetParamValues("session-1")
+
+			mockAuthUseCase := new(mocks.AuthUseCase)
+			mockAuthUseCase.On("RevokeSession", mock.Anything, int64(1), "session-1").Return(tt.mockError)
+
+			handler := NewMeHandler(e, mockAuthUseCase, new(mocks.UserUseCase), authConfig)
+
+			err := handler.RevokeSession(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			mockAuthUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMeHandler_RevokeOtherSessions(t *testing.T) {
+	c, rec, e := newMeTestContext(http.MethodDelete, "/me/sessions", "")
+	principal, _ := contextutil.GetPrincipal(c)
+	principal.SessionID = "current"
+
+	mockAuthUseCase := new(mocks.AuthUseCase)
+	mockAuthUseCase.On("RevokeOtherSessions", mock.Anything, int64(1), "current").Return(nil)
+
+	handler := NewMeHandler(e, mockAuthUseCase, new(mocks.UserUseCase), authConfig)
+
+	err := handler.RevokeOtherSessions(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	mockAuthUseCase.AssertExpectations(t)
+}
+
+// API 키로 인증한 요청은 세션이 없어 RevokeOtherSessions 가 모든 세션을 종료시키므로, 세션 종료는 usecase 를 호출하지 않고 거부한다
+func TestMeHandler_SessionsRejectAPIKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  string
+		handler func(h *MeHandler) echo.HandlerFunc
+	}{
+		{
+			name:    "Revoke Session",
+			target:  "/me/sessions/session-1",
+			handler: func(h *MeHandler) echo.HandlerFunc { return h.RevokeSession },
+		},
+		{
+			name:    "Revoke Other Sessions",
+			target:  "/me/sessions",
+			handler: func(h *MeHandler) echo.HandlerFunc { return h.RevokeOtherSessions },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, rec, e := newMeTestContext(http.MethodDelete, tt.target, "")
+			c.SetParamNames("id")
+			c.SetParamValues("session-1")
+			contextutil.SetPrincipal(c, contextutil.NewAPIKeyPrincipal(1, "john@example.com", []string{domain.RoleUser}, 7, nil))
+
+			mockAuthUseCase := new(mocks.AuthUseCase)
+			handler := NewMeHandler(e, mockAuthUseCase, new(mocks.UserUseCase), authConfig)
+
+			err := tt.handler(handler)(c)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+
+			mockAuthUseCase.AssertExpectations(t)
+		})
+	}
+}
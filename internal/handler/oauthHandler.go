@@ -0,0 +1,233 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/internal/middlewares"
+	"github.com/nicewook/gocore/pkg/contextutil"
+)
+
+// OAuthHandler handles the OAuth2 token and introspection endpoints, userinfo, and client registration
+type OAuthHandler struct {
+	authUseCase        domain.AuthUseCase
+	oauthClientUseCase domain.OAuthClientUseCase
+}
+
+func NewOAuthHandler(e *echo.Echo, authUseCase domain.AuthUseCase, oauthClientUseCase domain.OAuthClientUseCase) *OAuthHandler {
+	handler := &OAuthHandler{
+		authUseCase:        authUseCase,
+		oauthClientUseCase: oauthClientUseCase,
+	}
+
+	e.POST("/oauth/token", handler.Token)
+	e.POST("/oauth/introspect", handler.Introspect)
+	middlewares.Route(e, http.MethodGet, "/userinfo", handler.UserInfo, domain.PermAccountManage)
+
+	adminGroup := e.Group("/admin/oauth-clients")
+	middlewares.Route(adminGroup, http.MethodPost, "", handler.CreateClient, domain.PermOAuthClientsManage)
+	middlewares.Route(adminGroup, http.MethodGet, "", handler.GetClients, domain.PermOAuthClientsManage)
+	middlewares.Route(adminGroup, http.MethodDelete, "/:id", handler.DeleteClient, domain.PermOAuthClientsManage)
+
+	return handler
+}
+
+// oauthErrResponse 는 RFC 6749 5.2 형식의 에러 응답이다
+func oauthErrResponse(code string, err error) map[string]string {
+	return map[string]string{
+		"error":             code,
+		"error_description": err.Error(),
+	}
+}
+
+// Token issues an access token for the client_credentials grant
+// 클라이언트 인증은 HTTP Basic(client_secret_basic)과 본문(client_secret_post) 모두 지원한다
+func (h *OAuthHandler) Token(c echo.Context) error {
+	// 토큰 응답은 캐시되면 안 된다 (RFC 6749 5.1)
+	c.Response().Header().Set("Cache-Control", "no-store")
+	c.Response().Header().Set("Pragma", "no-cache")
+
+	req := new(domain.TokenRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, oauthErrResponse("invalid_request", domain.ErrInvalidInput))
+	}
+
+	if req.GrantType != domain.GrantTypeClientCredentials {
+		return c.JSON(http.StatusBadRequest, oauthErrResponse("unsupported_grant_type", domain.ErrUnsupportedGrantType))
+	}
+
+	clientID, clientSecret, usedBasic, err := clientCredentials(c.Request(), req.ClientID, req.ClientSecret)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, oauthErrResponse("invalid_request", err))
+	}
+
+	ctx := c.Request().Context()
+	response, err := h.authUseCase.ClientCredentialsToken(ctx, clientID, clientSecret, req.Scope)
+	if err == nil {
+		return c.JSON(http.StatusOK, response)
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrInvalidClient):
+		return invalidClientResponse(c, usedBasic, err)
+	case errors.Is(err, domain.ErrInvalidScope):
+		return c.JSON(http.StatusBadRequest, oauthErrResponse("invalid_scope", err))
+	default:
+		return c.JSON(http.StatusInternalServerError, oauthErrResponse("server_error", domain.ErrInternal))
+	}
+}
+
+// Introspect tells a resource server whether a token is currently active (RFC 7662)
+// 클라이언트 인증은 /oauth/token 과 같다. 토큰이 유효하지 않아도 200 으로 active=false 를 반환한다
+func (h *OAuthHandler) Introspect(c echo.Context) error {
+	c.Response().Header().Set("Cache-Control", "no-store")
+	c.Response().Header().Set("Pragma", "no-cache")
+
+	req := new(domain.IntrospectionRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, oauthErrResponse("invalid_request", domain.ErrInvalidInput))
+	}
+
+	clientID, clientSecret, usedBasic, err := clientCredentials(c.Request(), req.ClientID, req.ClientSecret)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, oauthErrResponse("invalid_request", err))
+	}
+	if req.Token == "" {
+		return c.JSON(http.StatusBadRequest, oauthErrResponse("invalid_request", domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	response, err := h.authUseCase.IntrospectToken(ctx, clientID, clientSecret, req.Token)
+	if err == nil {
+		return c.JSON(http.StatusOK, response)
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrInvalidClient):
+		return invalidClientResponse(c, usedBasic, err)
+	default:
+		return c.JSON(http.StatusInternalServerError, oauthErrResponse("server_error", domain.ErrInternal))
+	}
+}
+
+// UserInfo returns the profile of the user who owns the access token (OpenID Connect Core 5.3)
+func (h *OAuthHandler) UserInfo(c echo.Context) error {
+	principal, err := contextutil.GetPrincipal(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrUnauthorized))
+	}
+
+	ctx := c.Request().Context()
+	userInfo, err := h.authUseCase.UserInfo(ctx, principal.UserID)
+	if err == nil {
+		return c.JSON(http.StatusOK, userInfo)
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		// 토큰은 유효하지만 사용자가 삭제된 경우 (RFC 6750 3.1)
+		c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrUnauthorized))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
+
+// invalidClientResponse 는 클라이언트 인증 실패 응답이다. Basic 인증을 사용했으면 WWW-Authenticate 를 함께 보낸다 (RFC 6749 5.2)
+func invalidClientResponse(c echo.Context, usedBasic bool, err error) error {
+	if usedBasic {
+		c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Basic realm="oauth"`)
+	}
+	return c.JSON(http.StatusUnauthorized, oauthErrResponse("invalid_client", err))
+}
+
+// clientCredentials 는 HTTP Basic 헤더 또는 요청 본문에서 클라이언트 인증 정보를 꺼낸다.
+// Basic 헤더의 값은 form-urlencoded 되어 있으므로 디코딩한다 (RFC 6749 2.3.1)
+func clientCredentials(req *http.Request, bodyClientID, bodyClientSecret string) (clientID, clientSecret string, usedBasic bool, err error) {
+	if id, secret, ok := req.BasicAuth(); ok {
+		if bodyClientSecret != "" {
+			// 두 가지 방식을 함께 사용하면 안 된다
+			return "", "", true, domain.ErrInvalidInput
+		}
+		if clientID, err = url.QueryUnescape(id); err != nil {
+			return "", "", true, domain.ErrInvalidInput
+		}
+		if clientSecret, err = url.QueryUnescape(secret); err != nil {
+			return "", "", true, domain.ErrInvalidInput
+		}
+		return clientID, clientSecret, true, nil
+	}
+
+	if bodyClientID == "" || bodyClientSecret == "" {
+		return "", "", false, domain.ErrInvalidInput
+	}
+	return bodyClientID, bodyClientSecret, false, nil
+}
+
+// CreateClient registers a new OAuth client. The client secret is only returned in this response
+func (h *OAuthHandler) CreateClient(c echo.Context) error {
+	req := new(domain.CreateOAuthClientRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	response, err := h.oauthClientUseCase.Create(ctx, req)
+	if err == nil {
+		return c.JSON(http.StatusCreated, response)
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrAlreadyExists):
+		return c.JSON(http.StatusConflict, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
+
+// GetClients returns all registered OAuth clients without their secrets
+func (h *OAuthHandler) GetClients(c echo.Context) error {
+	ctx := c.Request().Context()
+	clients, err := h.oauthClientUseCase.GetAll(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+
+	return c.JSON(http.StatusOK, clients)
+}
+
+// DeleteClient deletes an OAuth client so that it can no longer get tokens
+func (h *OAuthHandler) DeleteClient(c echo.Context) error {
+	req := new(domain.GetByIDRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	err := h.oauthClientUseCase.Delete(ctx, req.ID)
+	if err == nil {
+		return c.JSON(http.StatusOK, map[string]string{
+			"message": "The OAuth client has been deleted.",
+			"status":  "success",
+		})
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return c.JSON(http.StatusNotFound, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
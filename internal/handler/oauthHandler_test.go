@@ -0,0 +1,295 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/internal/domain/mocks"
+	"github.com/nicewook/gocore/pkg/contextutil"
+	"github.com/nicewook/gocore/pkg/validatorutil"
+)
+
+func TestOAuthHandler_Token(t *testing.T) {
+	tests := []struct {
+		name           string
+		form           url.Values
+		basicAuth      []string
+		mockCall       []string // clientID, clientSecret, scope
+		mockError      error
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name:           "Success With Basic Auth",
+			form:           url.Values{"grant_type": {"client_credentials"}, "scope": {"orders:read"}},
+			basicAuth:      []string{"billing-service", "client-secret"},
+			mockCall:       []string{"billing-service", "client-secret", "orders:read"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Success With Body Credentials",
+			form:           url.Values{"grant_type": {"client_credentials"}, "client_id": {"billing-service"}, "client_secret": {"client-secret"}},
+			mockCall:       []string{"billing-service", "client-secret", ""},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Unsupported Grant Type",
+			form:           url.Values{"grant_type": {"password"}},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "unsupported_grant_type",
+		},
+		{
+			name:           "Missing Credentials",
+			form:           url.Values{"grant_type": {"client_credentials"}},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "invalid_request",
+		},
+		{
+			name:           "Invalid Client",
+			form:           url.Values{"grant_type": {"client_credentials"}},
+			basicAuth:      []string{"billing-service", "wrong-secret"},
+			mockCall:       []string{"billing-service", "wrong-secret", ""},
+			mockError:      domain.ErrInvalidClient,
+			expectedStatus: http.StatusUnauthorized,
+			expectedError:  "invalid_client",
+		},
+		{
+			name:           "Invalid Scope",
+			form:           url.Values{"grant_type": {"client_credentials"}, "scope": {"admin"}},
+			basicAuth:      []string{"billing-service", "client-secret"},
+			mockCall:       []string{"billing-service", "client-secret", "admin"},
+			mockError:      domain.ErrInvalidScope,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "invalid_scope",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/oauth/token", strings.NewReader(tt.form.Encode()))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+			if tt.basicAuth != nil {
+				req.SetBasicAuth(tt.basicAuth[0], tt.basicAuth[1])
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuthUseCase := new(mocks.AuthUseCase)
+			if tt.mockCall != nil {
+				var mockReturn *domain.TokenResponse
+				if tt.mockError == nil {
+					mockReturn = &domain.TokenResponse{AccessToken: "service-token", TokenType: "Bearer", ExpiresIn: 900}
+				}
+				mockAuthUseCase.On("ClientCredentialsToken", mock.Anything, tt.mockCall[0], tt.mockCall[1], tt.mockCall[2]).
+					Return(mockReturn, tt.mockError)
+			}
+
+			handler := NewOAuthHandler(e, mockAuthUseCase, new(mocks.OAuthClientUseCase))
+
+			err := handler.Token(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+			if tt.expectedError != "" {
+				assert.Contains(t, rec.Body.String(), `"error":"`+tt.expectedError+`"`)
+			} else {
+				assert.Contains(t, rec.Body.String(), "service-token")
+			}
+
+			mockAuthUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestOAuthHandler_Introspect(t *testing.T) {
+	tests := []struct {
+		name           string
+		form           url.Values
+		basicAuth      []string
+		expectCall     bool
+		mockReturn     *domain.IntrospectionResponse
+		mockError      error
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "Active Token",
+			form:           url.Values{"token": {"access-token"}},
+			basicAuth:      []string{"billing-service", "client-secret"},
+			expectCall:     true,
+			mockReturn:     &domain.IntrospectionResponse{Active: true, Sub: "7", TokenType: "Bearer"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"active":true`,
+		},
+		{
+			name:           "Inactive Token",
+			form:           url.Values{"token": {"access-token"}},
+			basicAuth:      []string{"billing-service", "client-secret"},
+			expectCall:     true,
+			mockReturn:     &domain.IntrospectionResponse{Active: false},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"active":false}`,
+		},
+		{
+			name:           "Missing Token",
+			form:           url.Values{},
+			basicAuth:      []string{"billing-service", "client-secret"},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `"error":"invalid_request"`,
+		},
+		{
+			name:           "Missing Client Credentials",
+			form:           url.Values{"token": {"access-token"}},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `"error":"invalid_request"`,
+		},
+		{
+			name:           "Invalid Client",
+			form:           url.Values{"token": {"access-token"}},
+			basicAuth:      []string{"billing-service", "client-secret"},
+			expectCall:     true,
+			mockError:      domain.ErrInvalidClient,
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `"error":"invalid_client"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/oauth/introspect", strings.NewReader(tt.form.Encode()))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+			if tt.basicAuth != nil {
+				req.SetBasicAuth(tt.basicAuth[0], tt.basicAuth[1])
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuthUseCase := new(mocks.AuthUseCase)
+			if tt.expectCall {
+				mockAuthUseCase.On("IntrospectToken", mock.Anything, "billing-service", "client-secret", "access-token").
+					Return(tt.mockReturn, tt.mockError)
+			}
+
+			handler := NewOAuthHandler(e, mockAuthUseCase, new(mocks.OAuthClientUseCase))
+
+			err := handler.Introspect(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+			assert.Contains(t, rec.Body.String(), tt.expectedBody)
+
+			mockAuthUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestOAuthHandler_UserInfo(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockReturn     *domain.UserInfoResponse
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "Success",
+			mockReturn:     &domain.UserInfoResponse{Sub: "1", Email: "john@example.com", Roles: []string{domain.RoleUser}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "User Deleted",
+			mockError:      domain.ErrNotFound,
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/userinfo", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			contextutil.SetPrincipal(c, &contextutil.Principal{UserID: 1, Email: "john@example.com", Roles: []string{domain.RoleUser}})
+
+			mockAuthUseCase := new(mocks.AuthUseCase)
+			mockAuthUseCase.On("UserInfo", mock.Anything, int64(1)).Return(tt.mockReturn, tt.mockError)
+
+			handler := NewOAuthHandler(e, mockAuthUseCase, new(mocks.OAuthClientUseCase))
+
+			err := handler.UserInfo(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.mockError == nil {
+				assert.Contains(t, rec.Body.String(), `"sub":"1"`)
+			} else {
+				assert.Equal(t, `Bearer error="invalid_token"`, rec.Header().Get(echo.HeaderWWWAuthenticate))
+			}
+
+			mockAuthUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestOAuthHandler_CreateClient(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		expectCall     bool
+		expectedStatus int
+	}{
+		{
+			name:           "Success",
+			requestBody:    `{"name":"Billing","scopes":["orders:read"]}`,
+			expectCall:     true,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "Unknown Scope",
+			requestBody:    `{"name":"Billing","scopes":["orders:write"]}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Missing Scopes",
+			requestBody:    `{"name":"Billing"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = validatorutil.NewValidator()
+			req := httptest.NewRequest(http.MethodPost, "/admin/oauth-clients", strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockOAuthClientUseCase := new(mocks.OAuthClientUseCase)
+			if tt.expectCall {
+				mockOAuthClientUseCase.On("Create", mock.Anything, mock.AnythingOfType("*domain.CreateOAuthClientRequest")).
+					Return(&domain.CreateOAuthClientResponse{
+						OAuthClient:  &domain.OAuthClient{ID: 1, ClientID: "client-1", Name: "Billing", SecretHash: "hash"},
+						ClientSecret: "client-secret",
+					}, nil)
+			}
+
+			handler := NewOAuthHandler(e, new(mocks.AuthUseCase), mockOAuthClientUseCase)
+
+			err := handler.CreateClient(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.NotContains(t, rec.Body.String(), "hash")
+
+			mockOAuthClientUseCase.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/nicewook/gocore/internal/config"
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+const (
+	oidcStateCookieName    = "oidc_state"
+	oidcNonceCookieName    = "oidc_nonce"
+	oidcVerifierCookieName = "oidc_verifier"
+	oidcCookiePath         = "/auth/oidc"
+
+	// oidcCookieMaxAge 는 공급자 로그인 페이지에서 콜백까지 기다리는 최대 시간이다
+	oidcCookieMaxAge = 10 * time.Minute
+)
+
+// OIDCHandler handles login through an external OpenID Connect provider
+type OIDCHandler struct {
+	authUseCase domain.AuthUseCase
+	config      *config.Config
+}
+
+func NewOIDCHandler(e *echo.Echo, authUseCase domain.AuthUseCase, config *config.Config) *OIDCHandler {
+	handler := &OIDCHandler{
+		authUseCase: authUseCase,
+		config:      config,
+	}
+
+	group := e.Group(oidcCookiePath)
+	group.GET("/login", handler.Login)
+	group.GET("/callback", handler.Callback)
+
+	return handler
+}
+
+// Login redirects the browser to the provider's login page
+// state, nonce, PKCE verifier 는 콜백에서 확인할 수 있도록 쿠키에 저장한다
+func (h *OIDCHandler) Login(c echo.Context) error {
+	ctx := c.Request().Context()
+	authRequest, err := h.authUseCase.StartOIDCLogin(ctx)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	expiration := time.Now().Add(oidcCookieMaxAge)
+	c.SetCookie(h.newCookie(oidcStateCookieName, authRequest.State, expiration))
+	c.SetCookie(h.newCookie(oidcNonceCookieName, authRequest.Nonce, expiration))
+	c.SetCookie(h.newCookie(oidcVerifierCookieName, authRequest.CodeVerifier, expiration))
+
+	return c.Redirect(http.StatusFound, authRequest.URL)
+}
+
+// Callback completes the login with the authorization code returned by the provider
+func (h *OIDCHandler) Callback(c echo.Context) error {
+	req := new(domain.OIDCCallbackRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	state, stateErr := c.Cookie(oidcStateCookieName)
+	nonce, nonceErr := c.Cookie(oidcNonceCookieName)
+	verifier, verifierErr := c.Cookie(oidcVerifierCookieName)
+
+	// 성공 여부와 관계없이 로그인 시도에 사용한 쿠키는 한 번만 쓰고 지운다
+	expired := time.Now().Add(-1 * time.Hour)
+	for _, name := range []string{oidcStateCookieName, oidcNonceCookieName, oidcVerifierCookieName} {
+		c.SetCookie(h.newCookie(name, "", expired))
+	}
+
+	if stateErr != nil || nonceErr != nil || verifierErr != nil ||
+		subtle.ConstantTimeCompare([]byte(state.Value), []byte(req.State)) != 1 {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrOIDCLoginFailed))
+	}
+
+	// 사용자가 공급자에서 로그인을 취소하거나 거부된 경우
+	if req.Error != "" {
+		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrOIDCLoginFailed))
+	}
+	if req.Code == "" {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	loginResponse, err := h.authUseCase.LoginOIDC(ctx, req.Code, verifier.Value, nonce.Value)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	// MFA 가 필요하면 리프레시 토큰 없이 MFA 토큰만 반환한다
+	if !loginResponse.MFARequired {
+		c.SetCookie(newRefreshTokenCookie(
+			h.config.Secure.JWT.Cookie,
+			loginResponse.RefreshToken,
+			loginResponse.RefreshTokenExpiration))
+	}
+
+	return c.JSON(http.StatusOK, loginResponse)
+}
+
+// newCookie 는 로그인 시도 동안 사용하는 쿠키를 만든다.
+// 공급자에서 돌아오는 요청은 다른 사이트에서 시작된 이동이므로 SameSite 는 Lax 로 고정한다
+func (h *OIDCHandler) newCookie(name, value string, expiration time.Time) *http.Cookie {
+	cookieConfig := h.config.Secure.JWT.Cookie
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     oidcCookiePath,
+		Domain:   cookieConfig.Domain,
+		Expires:  expiration,
+		Secure:   cookieConfig.Secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+// errorResponse 는 OIDC 로그인 에러를 응답 코드로 변환한다
+func (h *OIDCHandler) errorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrOIDCDisabled):
+		return c.JSON(http.StatusNotFound, ErrResponse(domain.ErrOIDCDisabled))
+	case errors.Is(err, domain.ErrOIDCLoginFailed):
+		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrOIDCLoginFailed))
+	case errors.Is(err, domain.ErrEmailNotVerified), errors.Is(err, domain.ErrOIDCNotProvisioned), errors.Is(err, domain.ErrAccountDisabled):
+		return c.JSON(http.StatusForbidden, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
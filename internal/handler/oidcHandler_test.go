@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/internal/domain/mocks"
+)
+
+func TestOIDCHandler_Login(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockReturn     *domain.OIDCAuthRequest
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name: "Redirect To Provider",
+			mockReturn: &domain.OIDCAuthRequest{
+				URL:          "https://idp.example.com/authorize?state=state-1",
+				State:        "state-1",
+				Nonce:        "nonce-1",
+				CodeVerifier: "verifier-1",
+			},
+			expectedStatus: http.StatusFound,
+		},
+		{
+			name:           "OIDC Disabled",
+			mockError:      domain.ErrOIDCDisabled,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/auth/oidc/login", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuthUseCase := new(mocks.AuthUseCase)
+			mockAuthUseCase.On("StartOIDCLogin", mock.Anything).Return(tt.mockReturn, tt.mockError)
+
+			handler := NewOIDCHandler(e, mockAuthUseCase, authConfig)
+
+			err := handler.Login(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.mockError == nil {
+				assert.Equal(t, tt.mockReturn.URL, rec.Header().Get(echo.HeaderLocation))
+
+				cookies := map[string]*http.Cookie{}
+				for _, cookie := range rec.Result().Cookies() {
+					cookies[cookie.Name] = cookie
+				}
+				assert.Equal(t, "state-1", cookies[oidcStateCookieName].Value)
+				assert.Equal(t, "nonce-1", cookies[oidcNonceCookieName].Value)
+				assert.Equal(t, "verifier-1", cookies[oidcVerifierCookieName].Value)
+				for _, cookie := range cookies {
+					assert.True(t, cookie.HttpOnly)
+					assert.Equal(t, http.SameSiteLaxMode, cookie.SameSite)
+					assert.Equal(t, oidcCookiePath, cookie.Path)
+				}
+			}
+
+			mockAuthUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestOIDCHandler_Callback(t *testing.T) {
+	tests := []struct {
+		name               string
+		query              string
+		withCookies        bool
+		expectCall         bool
+		mockReturn         *domain.LoginResponse
+		mockError          error
+		expectedStatus     int
+		expectRefreshToken bool
+	}{
+		{
+			name:        "Success",
+			query:       "?code=auth-code&state=state-1",
+			withCookies: true,
+			expectCall:  true,
+			mockReturn: &domain.LoginResponse{
+				ID:           1,
+				Email:        "staff@example.com",
+				AccessToken:  "access-token",
+				RefreshToken: "refresh-token",
+			},
+			expectedStatus:     http.StatusOK,
+			expectRefreshToken: true,
+		},
+		{
+			name:        "MFA Required",
+			query:       "?code=auth-code&state=state-1",
+			withCookies: true,
+			expectCall:  true,
+			mockReturn: &domain.LoginResponse{
+				ID:          1,
+				Email:       "staff@example.com",
+				MFARequired: true,
+				MFAToken:    "mfa-token",
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "State Mismatch",
+			query:          "?code=auth-code&state=other-state",
+			withCookies:    true,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Missing Cookies",
+			query:          "?code=auth-code&state=state-1",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Provider Returned Error",
+			query:          "?error=access_denied&state=state-1",
+			withCookies:    true,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Invalid Code",
+			query:          "?code=bad-code&state=state-1",
+			withCookies:    true,
+			expectCall:     true,
+			mockError:      domain.ErrOIDCLoginFailed,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Not Provisioned",
+			query:          "?code=auth-code&state=state-1",
+			withCookies:    true,
+			expectCall:     true,
+			mockError:      domain.ErrOIDCNotProvisioned,
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/auth/oidc/callback"+tt.query, nil)
+			if tt.withCookies {
+				req.AddCookie(&http.Cookie{Name: oidcStateCookieName, Value: "state-1"})
+				req.AddCookie(&http.Cookie{Name: oidcNonceCookieName, Value: "nonce-1"})
+				req.AddCookie(&http.Cookie{Name: oidcVerifierCookieName, Value: "verifier-1"})
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuthUseCase := new(mocks.AuthUseCase)
+			if tt.expectCall {
+				code := c.QueryParam("code")
+				mockAuthUseCase.On("LoginOIDC", mock.Anything, code, "verifier-1", "nonce-1").
+					Return(tt.mockReturn, tt.mockError)
+			}
+
+			handler := NewOIDCHandler(e, mockAuthUseCase, authConfig)
+
+			err := handler.Callback(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			cookies := map[string]*http.Cookie{}
+			for _, cookie := range rec.Result().Cookies() {
+				cookies[cookie.Name] = cookie
+			}
+
+			// 로그인 시도 쿠키는 결과와 관계없이 만료시킨다
+			for _, name := range []string{oidcStateCookieName, oidcNonceCookieName, oidcVerifierCookieName} {
+				assert.Contains(t, cookies, name)
+				assert.Empty(t, cookies[name].Value)
+			}
+
+			refreshCookie, ok := cookies[refreshTokenCookieName]
+			assert.Equal(t, tt.expectRefreshToken, ok)
+			if tt.expectRefreshToken {
+				assert.Equal(t, "refresh-token", refreshCookie.Value)
+			}
+
+			mockAuthUseCase.AssertExpectations(t)
+		})
+	}
+}
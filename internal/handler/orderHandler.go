@@ -19,9 +19,9 @@ func NewOrderHandler(e *echo.Echo, orderUseCase domain.OrderUseCase) *OrderHandl
 	handler := &OrderHandler{orderUseCase: orderUseCase}
 
 	group := e.Group("/orders")
-	group.POST("", handler.CreateOrder, middlewares.AllowRoles(domain.RoleAdmin, domain.RoleManager, domain.RoleUser))
-	group.GET("", handler.GetAll, middlewares.AllowRoles(domain.RoleAdmin, domain.RoleManager))
-	group.GET("/:id", handler.GetByID, middlewares.AllowRoles(domain.RoleAdmin, domain.RoleManager, domain.RoleUser))
+	middlewares.Route(group, http.MethodPost, "", handler.CreateOrder, domain.PermOrdersCreate)
+	middlewares.Route(group, http.MethodGet, "", handler.GetAll, domain.PermOrdersReadAny)
+	middlewares.Route(group, http.MethodGet, "/:id", handler.GetByID, domain.PermOrdersReadOwn, domain.PermOrdersReadAny)
 
 	return handler
 }
@@ -52,6 +52,10 @@ func (h *OrderHandler) CreateOrder(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, ErrResponse(err))
 	case errors.Is(err, domain.ErrNotFound):
 		return c.JSON(http.StatusNotFound, ErrResponse(err))
+	case errors.Is(err, domain.ErrForbidden):
+		return c.JSON(http.StatusForbidden, ErrResponse(err))
+	case errors.Is(err, domain.ErrUnauthorized):
+		return c.JSON(http.StatusUnauthorized, ErrResponse(err))
 	default:
 		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
 	}
@@ -72,6 +76,8 @@ func (h *OrderHandler) GetByID(c echo.Context) error {
 	switch {
 	case errors.Is(err, domain.ErrNotFound):
 		return c.JSON(http.StatusNotFound, ErrResponse(domain.ErrNotFound))
+	case errors.Is(err, domain.ErrUnauthorized):
+		return c.JSON(http.StatusUnauthorized, ErrResponse(err))
 	default:
 		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
 	}
@@ -86,6 +92,10 @@ func (h *OrderHandler) GetAll(c echo.Context) error {
 	switch {
 	case errors.Is(err, domain.ErrNotFound):
 		return c.JSON(http.StatusNotFound, ErrResponse(domain.ErrNotFound))
+	case errors.Is(err, domain.ErrForbidden):
+		return c.JSON(http.StatusForbidden, ErrResponse(err))
+	case errors.Is(err, domain.ErrUnauthorized):
+		return c.JSON(http.StatusUnauthorized, ErrResponse(err))
 	default:
 		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
 	}
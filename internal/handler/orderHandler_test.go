@@ -35,6 +35,22 @@ func TestCreateOrder(t *testing.T) {
 			expectedStatus: http.StatusCreated,
 			expectedBody:   `{"id":1,"user_id":1,"product_id":1,"quantity":2,"total_price_in_krw":2000,"created_at":""}`,
 		},
+		{
+			name:           "Without UserID",
+			input:          `{"product_id":1,"quantity":2,"total_price_in_krw":2000}`,
+			mockInput:      &domain.Order{ProductID: 1, Quantity: 2, TotalPriceInKRW: 2000}, // usecase 가 토큰의 사용자로 채운다
+			mockReturn:     &domain.Order{ID: 1, UserID: 1, ProductID: 1, Quantity: 2, TotalPriceInKRW: 2000},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"id":1,"user_id":1,"product_id":1,"quantity":2,"total_price_in_krw":2000,"created_at":""}`,
+		},
+		{
+			name:           "Forbidden",
+			input:          `{"user_id":2,"product_id":1,"quantity":2,"total_price_in_krw":2000}`,
+			mockInput:      &domain.Order{UserID: 2, ProductID: 1, Quantity: 2, TotalPriceInKRW: 2000},
+			mockError:      domain.ErrForbidden,
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"error":"access to this resource is not allowed"}`,
+		},
 		{
 			name:           "InvalidInput",
 			input:          `{"user_id":0,"product_id":0,"quantity":0,"total_price_in_krw":0}`,
@@ -19,9 +19,9 @@ func NewProductHandler(e *echo.Echo, productUseCase domain.ProductUseCase) *Prod
 	handler := &ProductHandler{productUseCase: productUseCase}
 
 	group := e.Group("/products")
-	group.POST("", handler.CreateProduct, middlewares.AllowRoles(domain.RoleAdmin, domain.RoleManager))
-	group.GET("", handler.GetAll, middlewares.AllowRoles(domain.RolePublic))
-	group.GET("/:id", handler.GetByID, middlewares.AllowRoles(domain.RolePublic))
+	middlewares.Route(group, http.MethodPost, "", handler.CreateProduct, domain.PermProductsWrite)
+	group.GET("", handler.GetAll)
+	group.GET("/:id", handler.GetByID)
 
 	return handler
 }
@@ -19,8 +19,8 @@ func NewUserHandler(e *echo.Echo, userUseCase domain.UserUseCase) *UserHandler {
 	handler := &UserHandler{userUseCase: userUseCase}
 
 	group := e.Group("/users")
-	group.GET("", handler.GetAll, middlewares.AllowRoles(domain.RoleAdmin))
-	group.GET("/:id", handler.GetByID, middlewares.AllowRoles(domain.RoleAdmin, domain.RoleUser))
+	middlewares.Route(group, http.MethodGet, "", handler.GetAll, domain.PermUsersReadAny)
+	middlewares.Route(group, http.MethodGet, "/:id", handler.GetByID, domain.PermUsersReadOwn, domain.PermUsersReadAny)
 
 	return handler
 }
@@ -37,6 +37,23 @@ func ErrResponse(err error) map[string]string {
 	}
 }
 
+// PasswordPolicyResponse 는 비밀번호 정책 위반 사유를 password 필드의 사유 목록으로 반환한다
+// 예: {"error": "password does not meet policy", "fields": {"password": [{"code": "too_short", "message": "..."}]}}
+func PasswordPolicyResponse(err error) map[string]interface{} {
+	var reasons []domain.PasswordPolicyReason
+	var policyErr *domain.PasswordPolicyError
+	if errors.As(err, &policyErr) {
+		reasons = policyErr.Reasons
+	}
+
+	return map[string]interface{}{
+		"error": domain.ErrWeakPassword.Error(),
+		"fields": map[string]interface{}{
+			"password": reasons,
+		},
+	}
+}
+
 func (h *UserHandler) GetByID(c echo.Context) error {
 	req := new(domain.GetByIDRequest)
 	if err := c.Bind(req); err != nil {
@@ -55,6 +72,8 @@ func (h *UserHandler) GetByID(c echo.Context) error {
 	switch {
 	case errors.Is(err, domain.ErrNotFound):
 		return c.JSON(http.StatusNotFound, ErrResponse(err))
+	case errors.Is(err, domain.ErrUnauthorized):
+		return c.JSON(http.StatusUnauthorized, ErrResponse(err))
 	default:
 		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
 	}
@@ -103,6 +122,10 @@ func (h *UserHandler) GetAll(c echo.Context) error {
 			HasMore:    false,
 		}
 		return c.JSON(http.StatusOK, emptyResponse)
+	case errors.Is(err, domain.ErrForbidden):
+		return c.JSON(http.StatusForbidden, ErrResponse(err))
+	case errors.Is(err, domain.ErrUnauthorized):
+		return c.JSON(http.StatusUnauthorized, ErrResponse(err))
 	default:
 		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
 	}
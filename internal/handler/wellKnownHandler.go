@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+// WellKnownHandler serves public metadata under /.well-known
+type WellKnownHandler struct {
+	keyRing *security.KeyRing
+}
+
+func NewWellKnownHandler(e *echo.Echo, keyRing *security.KeyRing) *WellKnownHandler {
+	handler := &WellKnownHandler{keyRing: keyRing}
+
+	group := e.Group("/.well-known")
+	group.GET("/jwks.json", handler.JWKS)
+
+	return handler
+}
+
+// JWKS 는 다른 서비스가 gocore 토큰을 검증할 수 있도록 검증 키 목록을 공개한다
+func (h *WellKnownHandler) JWKS(c echo.Context) error {
+	// 키 교체 주기보다 충분히 짧게 캐싱하도록 한다
+	c.Response().Header().Set("Cache-Control", "public, max-age=300")
+	return c.JSON(http.StatusOK, h.keyRing.JWKS())
+}
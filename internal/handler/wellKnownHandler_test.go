@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+func TestWellKnownHandler_JWKS(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	privateKeyPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+	})
+
+	keyRing, err := security.NewKeyRing("key-1", security.KeyPEM{ID: "key-1", PrivateKey: string(privateKeyPEM)})
+	assert.NoError(t, err)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	handler := NewWellKnownHandler(e, keyRing)
+	err = handler.JWKS(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotEmpty(t, rec.Header().Get("Cache-Control"))
+
+	var jwks security.JWKSet
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &jwks))
+	assert.Len(t, jwks.Keys, 1)
+	assert.Equal(t, "key-1", jwks.Keys[0].Kid)
+	assert.Equal(t, "RSA", jwks.Keys[0].Kty)
+}
@@ -0,0 +1,45 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+// fileMailer 는 메일을 디렉터리에 .eml 파일로 저장한다. 개발 환경이나 테스트에서 메일 내용을 확인할 때 사용한다
+type fileMailer struct {
+	from string
+	dir  string
+}
+
+func NewFileMailer(from, dir string) domain.Mailer {
+	return &fileMailer{from: from, dir: dir}
+}
+
+func (m *fileMailer) Send(ctx context.Context, mail *domain.Mail) error {
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create mail directory: %w", err)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", m.from)
+	fmt.Fprintf(&b, "To: %s\r\n", mail.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mail.Subject)
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	b.WriteString(mail.Body)
+
+	// 파일 이름이 시간 순으로 정렬되도록 시각을 앞에 붙인다
+	name := fmt.Sprintf("%s-%s.eml", time.Now().Format("20060102T150405.000000000"), uuid.NewString())
+	if err := os.WriteFile(filepath.Join(m.dir, name), []byte(b.String()), 0o600); err != nil {
+		return fmt.Errorf("failed to write mail: %w", err)
+	}
+	return nil
+}
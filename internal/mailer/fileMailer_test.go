@@ -0,0 +1,35 @@
+package mailer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+func TestFileMailer_Send(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "mails")
+	m := NewFileMailer("no-reply@gocore.dev", dir)
+
+	err := m.Send(context.Background(), &domain.Mail{
+		To:      "john@example.com",
+		Subject: "Reset your password",
+		Body:    "https://example.com/reset?token=abc",
+	})
+	assert.NoError(t, err)
+
+	files, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	content, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "From: no-reply@gocore.dev\r\n")
+	assert.Contains(t, string(content), "To: john@example.com\r\n")
+	assert.Contains(t, string(content), "Subject: Reset your password\r\n")
+	assert.Contains(t, string(content), "https://example.com/reset?token=abc")
+}
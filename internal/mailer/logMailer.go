@@ -0,0 +1,28 @@
+package mailer
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/pkg/contextutil"
+)
+
+// logMailer 는 메일을 실제로 보내지 않고 로그로 남긴다. 개발 환경에서 사용한다
+type logMailer struct {
+	from string
+}
+
+func NewLogMailer(from string) domain.Mailer {
+	return &logMailer{from: from}
+}
+
+func (m *logMailer) Send(ctx context.Context, mail *domain.Mail) error {
+	contextutil.GetLogger(ctx).Info("MAIL_SENT",
+		slog.String("from", m.from),
+		slog.String("to", mail.To),
+		slog.String("subject", mail.Subject),
+		slog.String("body", mail.Body),
+	)
+	return nil
+}
@@ -0,0 +1,72 @@
+package middlewares
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/pkg/contextutil"
+)
+
+const (
+	// headerXAPIKey 는 API 키를 전달하는 요청 헤더이다
+	headerXAPIKey = "X-API-Key"
+	// apiKeyAuthScheme 은 Authorization 헤더로 API 키를 전달할 때 사용하는 스킴이다 (Authorization: ApiKey <key>)
+	apiKeyAuthScheme = "ApiKey "
+)
+
+// APIKeyAuth 는 X-API-Key 또는 Authorization: ApiKey 헤더의 API 키로 인증하는 미들웨어이다.
+// 인증에 성공하면 JWT 와 같은 방식으로 Principal 을 저장하므로 RequirePermission 을 그대로 사용할 수 있다.
+// API 키가 없으면 아무것도 하지 않고 JWT 인증으로 넘어간다.
+func APIKeyAuth(apiKeys domain.APIKeyUseCase, logger *slog.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			rawKey := extractAPIKey(c.Request())
+			if rawKey == "" {
+				return next(c)
+			}
+
+			user, key, err := apiKeys.Authenticate(c.Request().Context(), rawKey)
+			if err != nil {
+				if errors.Is(err, domain.ErrInvalidAPIKey) {
+					logger.Warn("Invalid API key",
+						"path", c.Path(),
+						"method", c.Request().Method)
+					return c.JSON(http.StatusUnauthorized, map[string]string{
+						"error": "Invalid or expired API key",
+					})
+				}
+				return c.JSON(http.StatusInternalServerError, map[string]string{
+					"error": domain.ErrInternal.Error(),
+				})
+			}
+
+			contextutil.SetPrincipal(c, contextutil.NewAPIKeyPrincipal(user.ID, user.Email, user.Roles, key.ID, key.ExpiresAt))
+			return next(c)
+		}
+	}
+}
+
+// extractAPIKey 는 요청 헤더에서 API 키를 꺼낸다. 없으면 빈 문자열을 반환한다
+func extractAPIKey(req *http.Request) string {
+	if key := req.Header.Get(headerXAPIKey); key != "" {
+		return strings.TrimSpace(key)
+	}
+
+	auth := req.Header.Get(echo.HeaderAuthorization)
+	if len(auth) > len(apiKeyAuthScheme) && strings.EqualFold(auth[:len(apiKeyAuthScheme)], apiKeyAuthScheme) {
+		return strings.TrimSpace(auth[len(apiKeyAuthScheme):])
+	}
+	return ""
+}
+
+// hasPrincipal 은 앞선 인증 미들웨어에서 이미 인증된 요청인지 확인한다.
+// API 키로 인증된 요청은 JWT 인증을 건너뛴다
+func hasPrincipal(c echo.Context) bool {
+	_, err := contextutil.GetPrincipal(c)
+	return err == nil
+}
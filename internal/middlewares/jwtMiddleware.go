@@ -1,43 +1,172 @@
 package middlewares
 
 import (
-	"fmt"
+	"errors"
+	"log/slog"
 	"net/http"
-	"slices"
 
+	"github.com/golang-jwt/jwt/v5"
+	echojwt "github.com/labstack/echo-jwt/v4"
 	"github.com/labstack/echo/v4"
 
+	"github.com/nicewook/gocore/internal/config"
 	"github.com/nicewook/gocore/internal/domain"
 	"github.com/nicewook/gocore/pkg/contextutil"
+	"github.com/nicewook/gocore/pkg/security"
 )
 
-// AllowRoles 는 허용된 역할만 접근할 수 있도록 하는 미들웨어이다.
-func AllowRoles(allowedRoles ...string) echo.MiddlewareFunc {
-	return func(next echo.HandlerFunc) echo.HandlerFunc {
-		return func(c echo.Context) error {
-			// 허용하는 역할중에 domain.RolePublic 역할이 있으면 토큰 검증을 하지 않는다.
-			for _, role := range allowedRoles {
-				if role == domain.RolePublic {
-					return next(c)
-				}
+// contextKeyClaims 는 echojwt 가 검증된 토큰(claims)을 저장하는 echo 컨텍스트 키이다
+const contextKeyClaims = "user"
+
+// JWTAuth 는 Authorization 헤더의 Bearer 액세스 토큰을 검증하는 미들웨어이다.
+// 토큰이 없으면 다음 미들웨어로 넘기고(공개 라우트), 토큰이 있는데 검증에 실패하면 401 로 요청을 끝낸다.
+// API 키로 이미 인증된 요청은 건너뛴다.
+func JWTAuth(cfg *config.Config, keyRing *security.KeyRing, revocations domain.TokenRevocationRepository, logger *slog.Logger) echo.MiddlewareFunc {
+	return echojwt.WithConfig(echojwt.Config{
+		Skipper: hasPrincipal,
+		// 서명, 만료, iss/aud, 토큰 타입 검증 후 폐기된 토큰(jti)인지 확인한다
+		ParseTokenFunc: parseAccessToken(keyRing, revocations),
+		ContextKey:     contextKeyClaims,
+		// 검증된 claims 를 Principal 로 변환하여 핸들러, usecase 에서 사용할 수 있게 한다
+		SuccessHandler: setPrincipal,
+		// ErrorHandler 가 nil 을 반환하면 ContinueOnIgnoredError 에 의해 다음 미들웨어가 실행된다.
+		// 토큰이 없는 경우만 nil 을 반환하고, 그 외의 오류는 에러를 반환하여 핸들러가 실행되지 않도록 한다
+		ErrorHandler:           jwtErrorHandler(cfg, logger),
+		ContinueOnIgnoredError: true,
+	})
+}
+
+// jwtErrorHandler 는 echojwt 의 ErrorHandler 로 사용되며, 검증 실패 원인에 맞는 401 에러를 반환한다
+func jwtErrorHandler(cfg *config.Config, logger *slog.Logger) func(c echo.Context, err error) error {
+	return func(c echo.Context, err error) error {
+		// 토큰이 없는 경우는 패스해준다.
+		// errors.Is로 ErrJWTMissing과 비교 (TokenExtractionError도 포함)
+		if errors.Is(err, echojwt.ErrJWTMissing) {
+			if cfg.App.Debug {
+				logger.Debug("JWT token is missing",
+					"path", c.Path(),
+					"method", c.Request().Method)
 			}
+			return nil // 토큰이 없는 경우만 무시
+		}
 
-			// token 속 사용자 roles 를 가져온다
-			_, _, rolesInToken, err := contextutil.TokenToUser(c)
-			if err != nil {
-				return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		// 토큰이 있지만 문제가 있는 경우를 체크한다 (만료, 서명 불일치 등)
+		var statusCode int
+		var errorMsg string
+
+		switch {
+		case errors.Is(err, jwt.ErrTokenExpired):
+			statusCode = http.StatusUnauthorized
+			errorMsg = "Token has expired"
+			// Debug 모드일 때만 로깅
+			if cfg.App.Debug {
+				logger.Info("JWT token expired",
+					"path", c.Path(),
+					"method", c.Request().Method)
 			}
 
-			// allowedRoles(허용하는 역할) 중에서 하나라도 일치하는 역할이 있으면 패스
-			for _, role := range allowedRoles {
-				if slices.Contains(rolesInToken, role) {
-					return next(c)
-				}
+		case errors.Is(err, security.ErrTokenType):
+			statusCode = http.StatusUnauthorized
+			errorMsg = "Invalid token type"
+			// 리프레시 토큰을 액세스 토큰으로 사용하려는 시도는 항상 로깅
+			logger.Warn("JWT token type is not access",
+				"path", c.Path(),
+				"method", c.Request().Method)
+
+		case errors.Is(err, jwt.ErrTokenInvalidIssuer), errors.Is(err, jwt.ErrTokenInvalidAudience):
+			statusCode = http.StatusUnauthorized
+			errorMsg = "Invalid token issuer or audience"
+			// 다른 서비스에서 발급한 토큰을 사용하려는 시도는 항상 로깅
+			logger.Warn("JWT token has invalid issuer or audience",
+				"error", err.Error(),
+				"path", c.Path(),
+				"method", c.Request().Method)
+
+		case errors.Is(err, jwt.ErrTokenSignatureInvalid):
+			statusCode = http.StatusUnauthorized
+			errorMsg = "Invalid token signature"
+			// 보안 관련 경고는 항상 로깅 (선택적)
+			logger.Warn("JWT token has invalid signature",
+				"path", c.Path(),
+				"method", c.Request().Method)
+
+		case errors.Is(err, security.ErrRevokedToken):
+			statusCode = http.StatusUnauthorized
+			errorMsg = "Token has been revoked"
+			if cfg.App.Debug {
+				logger.Info("JWT token revoked",
+					"path", c.Path(),
+					"method", c.Request().Method)
+			}
+
+		case errors.Is(err, jwt.ErrTokenNotValidYet):
+			statusCode = http.StatusUnauthorized
+			errorMsg = "Token not valid yet"
+			// Debug 모드일 때만 로깅
+			if cfg.App.Debug {
+				logger.Info("JWT token not valid yet",
+					"path", c.Path(),
+					"method", c.Request().Method)
 			}
 
-			// 허용하는 역할중에 일치하는 역할이 없으면 403 에러 반환
-			errMessage := fmt.Sprintf("insufficient permissions to access this resource. allowed: %v, user roles: %v", allowedRoles, rolesInToken)
-			return echo.NewHTTPError(http.StatusForbidden, errMessage)
+		default:
+			statusCode = http.StatusUnauthorized
+			errorMsg = "Invalid or malformed token"
+			if cfg.App.Debug {
+				logger.Warn("JWT validation failed",
+					"error", err.Error(),
+					"path", c.Path(),
+					"method", c.Request().Method)
+			}
+		}
+
+		// c.JSON 으로 응답하면 nil 이 반환되어 핸들러가 실행되므로 에러로 반환한다
+		return echo.NewHTTPError(statusCode, map[string]string{
+			"error": errorMsg,
+		})
+	}
+}
+
+// parseAccessToken 은 echojwt 의 ParseTokenFunc 로 사용되며,
+// security.ValidateAccessToken 으로 서명, 만료, iss/aud, 토큰 타입(access)을 검증한 뒤
+// 폐기 목록에 있는 토큰이면 security.ErrRevokedToken 을 반환한다.
+// 검증에 성공하면 *security.JWTClaims 를 반환하며, echojwt 가 이를 컨텍스트에 저장한다.
+func parseAccessToken(keyRing *security.KeyRing, revocations domain.TokenRevocationRepository) func(c echo.Context, auth string) (interface{}, error) {
+	return func(c echo.Context, auth string) (interface{}, error) {
+		claims, err := security.ValidateAccessToken(auth, keyRing)
+		if err != nil {
+			return nil, err
+		}
+		if claims.IssuedAt == nil {
+			return nil, security.ErrInvalidToken
+		}
+
+		revoked, err := revocations.IsRevoked(c.Request().Context(), claims.ID, claims.UserID, claims.IssuedAt.Time)
+		if err != nil {
+			return nil, err
+		}
+		if revoked {
+			return nil, security.ErrRevokedToken
 		}
+
+		return claims, nil
+	}
+}
+
+// setPrincipal 은 echojwt 의 SuccessHandler 로 사용되며,
+// 검증된 claims 로 Principal 을 만들어 echo 컨텍스트와 context.Context 에 저장한다.
+func setPrincipal(c echo.Context) {
+	claims, ok := c.Get(contextKeyClaims).(*security.JWTClaims)
+	if !ok {
+		return
+	}
+	principal := contextutil.NewPrincipal(claims)
+	contextutil.SetPrincipal(c, principal)
+
+	// 대리 로그인 요청은 handler, usecase 의 로그에서도 관리자를 알 수 있도록 로거에 두 주체를 추가한다
+	if principal.IsImpersonated() {
+		req := c.Request()
+		logger := contextutil.GetLogger(req.Context()).With(principalLogAttrs(principal)...)
+		c.SetRequest(req.WithContext(contextutil.WithLogger(req.Context(), logger)))
 	}
 }
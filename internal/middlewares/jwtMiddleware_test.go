@@ -0,0 +1,164 @@
+package middlewares
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nicewook/gocore/internal/config"
+	"github.com/nicewook/gocore/internal/repository/memory"
+	"github.com/nicewook/gocore/pkg/contextutil"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+// generateTestKeyPEM 은 테스트용 RSA 키를 생성하여 PEM 으로 반환한다
+func generateTestKeyPEM(t *testing.T, id string) security.KeyPEM {
+	t.Helper()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	privateKeyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("failed to marshal private key: %v", err)
+	}
+
+	return security.KeyPEM{
+		ID:         id,
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKeyBytes})),
+	}
+}
+
+func TestJWTAuth(t *testing.T) {
+	key := generateTestKeyPEM(t, "key-1")
+	keyRing, err := security.NewKeyRing("key-1", key)
+	assert.NoError(t, err)
+	keyRing.WithIssuer("https://auth.example.com", "gocore")
+	revocations := memory.NewTokenRevocationRepository()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	e := echo.New()
+	e.Use(JWTAuth(&config.Config{}, keyRing, revocations, logger))
+
+	var called bool
+	var authenticated bool
+	e.GET("/test", func(c echo.Context) error {
+		called = true
+		_, err := contextutil.GetPrincipal(c)
+		authenticated = err == nil
+		return c.NoContent(http.StatusOK)
+	})
+
+	newToken := func(expiration time.Duration) string {
+		token, err := security.GenerateAccessToken(1, "test@example.com", []string{"User"}, keyRing, expiration)
+		assert.NoError(t, err)
+		return token
+	}
+
+	// 같은 키로 서명했지만 iss, aud 가 다른 서비스의 토큰
+	newForeignToken := func(issuer, audience string) string {
+		otherKeyRing, err := security.NewKeyRing("key-1", key)
+		assert.NoError(t, err)
+		token, err := security.GenerateAccessToken(1, "test@example.com", []string{"User"}, otherKeyRing.WithIssuer(issuer, audience), time.Hour)
+		assert.NoError(t, err)
+		return token
+	}
+
+	refreshToken, err := security.GenerateRefreshToken(1, "test@example.com", []string{"User"}, keyRing, time.Hour)
+	assert.NoError(t, err)
+
+	revokedToken := newToken(time.Hour)
+	claims, err := security.ValidateAccessToken(revokedToken, keyRing)
+	assert.NoError(t, err)
+	assert.NoError(t, revocations.RevokeToken(context.Background(), claims.ID, time.Now().Add(time.Hour)))
+
+	tests := []struct {
+		name           string
+		authorization  string
+		expectedStatus int
+		expectCalled   bool
+		expectUser     bool
+		expectedError  string
+	}{
+		{
+			name:           "Valid Token",
+			authorization:  "Bearer " + newToken(time.Hour),
+			expectedStatus: http.StatusOK,
+			expectCalled:   true,
+			expectUser:     true,
+		},
+		{
+			name:           "Missing Token",
+			expectedStatus: http.StatusOK,
+			expectCalled:   true,
+		},
+		{
+			name:           "Revoked Token",
+			authorization:  "Bearer " + revokedToken,
+			expectedStatus: http.StatusUnauthorized,
+			expectedError:  "Token has been revoked",
+		},
+		{
+			name:           "Expired Token",
+			authorization:  "Bearer " + newToken(-time.Minute),
+			expectedStatus: http.StatusUnauthorized,
+			expectedError:  "Token has expired",
+		},
+		{
+			name:           "Refresh Token As Bearer",
+			authorization:  "Bearer " + refreshToken,
+			expectedStatus: http.StatusUnauthorized,
+			expectedError:  "Invalid token type",
+		},
+		{
+			name:           "Invalid Issuer",
+			authorization:  "Bearer " + newForeignToken("https://other.example.com", "gocore"),
+			expectedStatus: http.StatusUnauthorized,
+			expectedError:  "Invalid token issuer or audience",
+		},
+		{
+			name:           "Invalid Audience",
+			authorization:  "Bearer " + newForeignToken("https://auth.example.com", "other-service"),
+			expectedStatus: http.StatusUnauthorized,
+			expectedError:  "Invalid token issuer or audience",
+		},
+		{
+			name:           "Malformed Token",
+			authorization:  "Bearer not-a-jwt",
+			expectedStatus: http.StatusUnauthorized,
+			expectedError:  "Invalid or malformed token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called, authenticated = false, false
+
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			if tt.authorization != "" {
+				req.Header.Set(echo.HeaderAuthorization, tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectCalled, called)
+			assert.Equal(t, tt.expectUser, authenticated)
+			if tt.expectedError != "" {
+				assert.JSONEq(t, `{"error":"`+tt.expectedError+`"}`, rec.Body.String())
+			}
+		})
+	}
+}
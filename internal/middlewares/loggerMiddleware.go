@@ -47,6 +47,10 @@ func LoggerMiddleware(logger *slog.Logger) echo.MiddlewareFunc {
 				slog.String("referer", v.Referer),
 				slog.String("latency", v.Latency.String()),
 			)
+			// 인증된 요청은 주체를, 대리 로그인 요청은 실제로 요청한 관리자(actor_id)도 함께 기록한다
+			if principal, err := contextutil.GetPrincipal(c); err == nil {
+				baseLogger = baseLogger.With(principalLogAttrs(principal)...)
+			}
 			if v.Error != nil {
 				baseLogger.With(slog.String("err", v.Error.Error())).Error("REQUEST_ERROR")
 			} else {
@@ -56,3 +60,15 @@ func LoggerMiddleware(logger *slog.Logger) echo.MiddlewareFunc {
 		},
 	})
 }
+
+// principalLogAttrs 는 로그에 남길 요청 주체의 식별자이다
+func principalLogAttrs(principal *contextutil.Principal) []any {
+	if principal.IsService() {
+		return []any{slog.String("client_id", principal.ClientID)}
+	}
+	attrs := []any{slog.Int64("user_id", principal.UserID)}
+	if principal.IsImpersonated() {
+		attrs = append(attrs, slog.Int64("actor_id", principal.ActorID))
+	}
+	return attrs
+}
@@ -7,20 +7,27 @@ import (
 	"strings"
 	"time"
 
-	echojwt "github.com/labstack/echo-jwt/v4"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/labstack/gommon/log"
 	"golang.org/x/time/rate"
 
-	"github.com/golang-jwt/jwt/v5"
-
 	"github.com/nicewook/gocore/internal/config"
+	"github.com/nicewook/gocore/internal/domain"
 	"github.com/nicewook/gocore/pkg/contextutil"
+	"github.com/nicewook/gocore/pkg/security"
 	"github.com/nicewook/gocore/pkg/validatorutil"
 )
 
-func RegisterMiddlewares(cfg *config.Config, logger *slog.Logger, e *echo.Echo) {
+func RegisterMiddlewares(
+	cfg *config.Config,
+	logger *slog.Logger,
+	e *echo.Echo,
+	keyRing *security.KeyRing,
+	revocations domain.TokenRevocationRepository,
+	apiKeys domain.APIKeyUseCase,
+	permissions domain.PermissionUseCase,
+) {
 
 	// ✅ Validator: 요청 바인딩 및 유효성 검사
 	e.Validator = validatorutil.NewValidator()
@@ -51,6 +58,17 @@ func RegisterMiddlewares(cfg *config.Config, logger *slog.Logger, e *echo.Echo)
 		}
 	})
 
+	// ✅ ClientIP, UserAgent: 클라이언트 정보를 컨텍스트에 추가 (로그인 시도 제한, 세션 기록에서 사용)
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			ctx := contextutil.WithClientIP(req.Context(), c.RealIP())
+			ctx = contextutil.WithUserAgent(ctx, req.UserAgent())
+			c.SetRequest(req.WithContext(ctx))
+			return next(c)
+		}
+	})
+
 	// ✅ Logger: 커스텀 로거 사용
 	// echo 에서 제공하는 RequestID, Logger 미들웨어를 사용하지 않고 직접 구현한 LoggerMiddleware 사용
 	e.Use(LoggerMiddleware(logger))
@@ -192,6 +210,7 @@ func RegisterMiddlewares(cfg *config.Config, logger *slog.Logger, e *echo.Echo)
 			echo.HeaderAccept,
 			echo.HeaderAuthorization,
 			echo.HeaderXCSRFToken,
+			headerXAPIKey,
 		},
 		AllowCredentials: true,                            // 쿠키 및 인증정보 포함 허용 (JWT 쿠키 기반 인증 시 필수)
 		ExposeHeaders:    []string{echo.HeaderXRequestID}, // 클라이언트에 노출할 응답 헤더
@@ -209,6 +228,7 @@ func RegisterMiddlewares(cfg *config.Config, logger *slog.Logger, e *echo.Echo)
 		})
 
 		e.Use(middleware.CSRFWithConfig(middleware.CSRFConfig{
+			Skipper:        skipCSRF,
 			TokenLookup:    "header:" + echo.HeaderXCSRFToken,
 			CookieSecure:   false,                // HTTPS에서만 쿠키 전송
 			CookiePath:     "/",                  // 이 설정 추가
@@ -240,82 +260,21 @@ func RegisterMiddlewares(cfg *config.Config, logger *slog.Logger, e *echo.Echo)
 		ReferrerPolicy: "no-referrer",
 	}))
 
-	// ✅ JWT 인증
-	e.Use(echojwt.WithConfig(echojwt.Config{
-		// 공개키 파싱
-		SigningKey: func() interface{} {
-			publicKey, err := jwt.ParseRSAPublicKeyFromPEM([]byte(cfg.Secure.JWT.PublicKey))
-			if err != nil {
-				logger.Error("Failed to parse RSA public key", "error", err)
-				return nil
-			}
-			return publicKey
-		}(),
-		SigningMethod: "RS256",
-		// ErrorHandler 는 JWT 가  실패해도 무시하도록 하고, ContinueOnIgnoredError 를 true 로 설정
-		// 이렇게 하면 JWT 검증 실패 시 미들웨어가 무시되고 다음 미들웨어가 실행된다.
-		// ErrorHandler: 토큰이 없는 경우만 무시하고 다른 오류는 반환
-		ErrorHandler: func(c echo.Context, err error) error {
-			// 토큰이 없는 경우는 패스해준다.
-			// 1. errors.Is로 ErrJWTMissing과 비교 (TokenExtractionError도 포함)
-			// 2. 에러 메시지로 확인 (추가 안전장치)
-			if errors.Is(err, echojwt.ErrJWTMissing) {
-				if cfg.App.Debug {
-					logger.Debug("JWT token is missing",
-						"path", c.Path(),
-						"method", c.Request().Method)
-				}
-				return nil // 토큰이 없는 경우만 무시
-			}
-
-			// 토큰이 있지만 문제가 있는 경우를 체크한다 (만료, 서명 불일치 등)
-			var statusCode int
-			var errorMsg string
-
-			switch {
-			case errors.Is(err, jwt.ErrTokenExpired):
-				statusCode = http.StatusUnauthorized
-				errorMsg = "Token has expired"
-				// Debug 모드일 때만 로깅
-				if cfg.App.Debug {
-					logger.Info("JWT token expired",
-						"path", c.Path(),
-						"method", c.Request().Method)
-				}
+	// ✅ API 키 인증: 배치 작업 같은 기계 클라이언트용. 성공하면 JWT 인증은 건너뛴다
+	e.Use(APIKeyAuth(apiKeys, logger))
 
-			case errors.Is(err, jwt.ErrTokenSignatureInvalid):
-				statusCode = http.StatusUnauthorized
-				errorMsg = "Invalid token signature"
-				// 보안 관련 경고는 항상 로깅 (선택적)
-				logger.Warn("JWT token has invalid signature",
-					"path", c.Path(),
-					"method", c.Request().Method)
-
-			case errors.Is(err, jwt.ErrTokenNotValidYet):
-				statusCode = http.StatusUnauthorized
-				errorMsg = "Token not valid yet"
-				// Debug 모드일 때만 로깅
-				if cfg.App.Debug {
-					logger.Info("JWT token not valid yet",
-						"path", c.Path(),
-						"method", c.Request().Method)
-				}
+	// ✅ JWT 인증
+	e.Use(JWTAuth(cfg, keyRing, revocations, logger))
 
-			default:
-				statusCode = http.StatusUnauthorized
-				errorMsg = "Invalid or malformed token"
-				if cfg.App.Debug {
-					logger.Warn("JWT validation failed",
-						"error", err.Error(),
-						"path", c.Path(),
-						"method", c.Request().Method)
-				}
-			}
+	// ✅ 권한 계산: 인증된 주체의 역할(서비스 주체는 scope)로 권한을 계산한다. RequirePermission 이 사용한다
+	e.Use(ResolvePermissions(permissions, logger))
+}
 
-			return c.JSON(statusCode, map[string]string{
-				"error": errorMsg,
-			})
-		},
-		ContinueOnIgnoredError: true,
-	}))
+// skipCSRF 는 쿠키에 의존하지 않는 기계 클라이언트의 요청에 CSRF 검사를 하지 않도록 한다.
+// Authorization, X-API-Key 헤더는 브라우저가 다른 사이트의 요청에 자동으로 붙이지 않으므로 위조할 수 없다.
+func skipCSRF(c echo.Context) bool {
+	req := c.Request()
+	return c.Path() == "/oauth/token" || c.Path() == "/oauth/introspect" ||
+		req.Header.Get(echo.HeaderAuthorization) != "" ||
+		req.Header.Get(headerXAPIKey) != ""
 }
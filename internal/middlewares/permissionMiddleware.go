@@ -0,0 +1,171 @@
+package middlewares
+
+import (
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/pkg/contextutil"
+)
+
+// Router 는 경로를 등록할 수 있는 *echo.Echo 와 *echo.Group 이다
+type Router interface {
+	Add(method, path string, handler echo.HandlerFunc, middleware ...echo.MiddlewareFunc) *echo.Route
+}
+
+// routePermissions 는 Route 로 등록한 경로에 필요한 권한이다. 키는 "METHOD 경로" 이다.
+// 등록되지 않은 경로는 공개 경로로 보고, PermissionMatrix 에서 public 으로 표시한다.
+var routePermissions = struct {
+	sync.RWMutex
+	m map[string][]string
+}{m: make(map[string][]string)}
+
+// Route 는 permissions 중 하나가 있어야 접근할 수 있는 경로를 등록하고, 권한 매트릭스에 기록한다.
+// 인증이 필요한 경로는 모두 Route 로 등록해야 GET /admin/permissions 에 정확한 매트릭스가 나온다.
+// 권한을 지정하지 않거나 정의되지 않은 권한을 지정하면 서버 시작 시 panic 이 발생한다.
+func Route(r Router, method, path string, h echo.HandlerFunc, permissions ...string) *echo.Route {
+	if len(permissions) == 0 {
+		panic(fmt.Sprintf("route %s %s: at least one permission is required", method, path))
+	}
+	for _, permission := range permissions {
+		if !domain.IsValidPermission(permission) {
+			panic(fmt.Sprintf("route %s %s: unknown permission %q", method, path, permission))
+		}
+	}
+
+	route := r.Add(method, path, h, RequirePermission(permissions...))
+
+	routePermissions.Lock()
+	routePermissions.m[route.Method+" "+route.Path] = slices.Clone(permissions)
+	routePermissions.Unlock()
+
+	return route
+}
+
+// ResolvePermissions 는 인증된 주체의 권한을 현재 역할별 권한 매핑으로 계산해서 Principal 에 저장하는 미들웨어이다.
+// JWT, API 키 인증 뒤에 등록해야 하며, 서비스 주체는 scope 로 권한을 받는다.
+// 권한을 토큰에 담지 않으므로 매핑을 바꾸면 이미 발급된 토큰에도 바로 적용된다.
+func ResolvePermissions(permissionUseCase domain.PermissionUseCase, logger *slog.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			principal, err := contextutil.GetPrincipal(c)
+			if err != nil {
+				return next(c)
+			}
+
+			if principal.IsService() {
+				principal.Permissions = scopePermissions(principal.Scopes)
+				return next(c)
+			}
+
+			permissions, err := permissionUseCase.Resolve(c.Request().Context(), principal.Roles)
+			if err != nil {
+				logger.Error("Failed to resolve permissions",
+					"error", err.Error(),
+					"path", c.Path(),
+					"method", c.Request().Method)
+				return c.JSON(http.StatusInternalServerError, map[string]string{
+					"error": "Failed to resolve permissions",
+				})
+			}
+			principal.Permissions = permissions
+			return next(c)
+		}
+	}
+}
+
+// RequirePermission 은 permissions 중 하나라도 가진 주체만 접근할 수 있도록 하는 미들웨어이다.
+// 경로를 등록할 때는 권한 매트릭스에 기록되도록 Route 를 사용한다.
+func RequirePermission(permissions ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			principal, err := contextutil.GetPrincipal(c)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusForbidden, err.Error())
+			}
+
+			for _, permission := range permissions {
+				if principal.HasPermission(permission) {
+					return next(c)
+				}
+			}
+
+			errMessage := fmt.Sprintf("insufficient permissions to access this resource. required one of: %v", permissions)
+			return echo.NewHTTPError(http.StatusForbidden, errMessage)
+		}
+	}
+}
+
+// PermissionMatrix 는 등록된 경로마다 필요한 권한과, 그 권한을 가진 역할과 scope 를 정리한다.
+// rolePermissions 는 현재 적용 중인 역할별 권한 매핑이다.
+func PermissionMatrix(routes []*echo.Route, rolePermissions map[string][]string) *domain.PermissionMatrix {
+	routePermissions.RLock()
+	defer routePermissions.RUnlock()
+
+	matrix := &domain.PermissionMatrix{
+		Roles:  rolePermissions,
+		Scopes: domain.ScopePermissions,
+		Routes: make([]domain.RoutePermission, 0, len(routes)),
+	}
+
+	for _, route := range routes {
+		// 그룹 미들웨어를 위해 echo 가 등록하는 404 경로는 제외한다
+		if route.Method == echo.RouteNotFound {
+			continue
+		}
+
+		permissions, ok := routePermissions.m[route.Method+" "+route.Path]
+		if !ok {
+			matrix.Routes = append(matrix.Routes, domain.RoutePermission{
+				Method: route.Method,
+				Path:   route.Path,
+				Public: true,
+			})
+			continue
+		}
+
+		matrix.Routes = append(matrix.Routes, domain.RoutePermission{
+			Method:      route.Method,
+			Path:        route.Path,
+			Permissions: permissions,
+			Roles:       grantedBy(rolePermissions, permissions),
+			Scopes:      grantedBy(domain.ScopePermissions, permissions),
+		})
+	}
+
+	sort.Slice(matrix.Routes, func(i, j int) bool {
+		if matrix.Routes[i].Path != matrix.Routes[j].Path {
+			return matrix.Routes[i].Path < matrix.Routes[j].Path
+		}
+		return matrix.Routes[i].Method < matrix.Routes[j].Method
+	})
+	return matrix
+}
+
+// scopePermissions 는 서비스 주체의 scope 가 부여하는 권한을 반환한다
+func scopePermissions(scopes []string) []string {
+	var permissions []string
+	for _, scope := range scopes {
+		permissions = append(permissions, domain.ScopePermissions[scope]...)
+	}
+	slices.Sort(permissions)
+	return slices.Compact(permissions)
+}
+
+// grantedBy 는 permissions 중 하나라도 부여하는 역할(또는 scope)을 정렬해서 반환한다
+func grantedBy(grants map[string][]string, permissions []string) []string {
+	var granted []string
+	for _, name := range slices.Sorted(maps.Keys(grants)) {
+		if slices.ContainsFunc(permissions, func(p string) bool { return slices.Contains(grants[name], p) }) {
+			granted = append(granted, name)
+		}
+	}
+	return granted
+}
@@ -0,0 +1,321 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/nicewook/gocore/internal/config"
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+const (
+	discoveryPath = "/.well-known/openid-configuration"
+
+	// maxResponseSize 는 공급자 응답 본문의 최대 크기이다
+	maxResponseSize = 1 << 20
+
+	// jwksRefreshInterval 은 모르는 kid 의 토큰을 받았을 때 JWKS 를 다시 가져오는 최소 간격이다.
+	// 공급자가 키를 교체한 직후에도 로그인할 수 있게 하면서, 위조 토큰으로 공급자에 요청이 몰리지 않게 한다
+	jwksRefreshInterval = time.Minute
+)
+
+var defaultScopes = []string{"openid", "email", "profile"}
+
+// supportedAlgorithms 는 ID 토큰 서명 검증에 허용하는 알고리즘이다. none 과 HMAC 은 허용하지 않는다
+var supportedAlgorithms = []string{"RS256", "RS384", "RS512", "PS256", "ES256", "EdDSA"}
+
+// metadata 는 OpenID Provider Metadata 중 로그인에 필요한 항목이다
+type metadata struct {
+	Issuer                   string   `json:"issuer"`
+	AuthorizationEndpoint    string   `json:"authorization_endpoint"`
+	TokenEndpoint            string   `json:"token_endpoint"`
+	JWKSURI                  string   `json:"jwks_uri"`
+	TokenEndpointAuthMethods []string `json:"token_endpoint_auth_methods_supported"`
+	IDTokenSigningAlgs       []string `json:"id_token_signing_alg_values_supported"`
+}
+
+// idTokenClaims 는 ID 토큰에서 확인하는 claim 이다
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce           string `json:"nonce"`
+	AuthorizedParty string `json:"azp"`
+	Email           string `json:"email"`
+	EmailVerified   bool   `json:"email_verified"`
+	Name            string `json:"name"`
+}
+
+type tokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+type jwksKey struct {
+	alg       string
+	publicKey crypto.PublicKey
+}
+
+// provider 는 discovery 문서와 JWKS 를 처음 사용할 때 가져와 캐시한다.
+// 서버 시작 시 공급자에 접속할 수 없어도 다른 로그인 방식은 계속 사용할 수 있다.
+type provider struct {
+	config config.OIDCConfig
+	client *http.Client
+
+	mu            sync.Mutex
+	metadata      *metadata
+	keys          map[string]jwksKey
+	keysFetchedAt time.Time
+}
+
+func NewProvider(cfg config.OIDCConfig, client *http.Client) domain.OIDCProvider {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &provider{config: cfg, client: client}
+}
+
+func (p *provider) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := url.Parse(md.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid authorization endpoint: %w", err)
+	}
+
+	scopes := p.config.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultScopes
+	}
+
+	query := authURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.config.ClientID)
+	query.Set("redirect_uri", p.config.RedirectURL)
+	query.Set("scope", strings.Join(scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", "S256")
+	authURL.RawQuery = query.Encode()
+
+	return authURL.String(), nil
+}
+
+func (p *provider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*domain.OIDCIdentity, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.config.RedirectURL)
+	form.Set("code_verifier", codeVerifier)
+
+	// 공급자가 client_secret_post 만 지원하는 경우가 아니면 HTTP Basic 으로 인증한다 (RFC 6749 2.3.1)
+	useBasicAuth := len(md.TokenEndpointAuthMethods) == 0 || slices.Contains(md.TokenEndpointAuthMethods, "client_secret_basic")
+	if !useBasicAuth {
+		form.Set("client_id", p.config.ClientID)
+		form.Set("client_secret", p.config.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, md.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if useBasicAuth {
+		req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call token endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var token tokenResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(&token); err != nil {
+		return nil, fmt.Errorf("failed to decode token response (status %d): %w", resp.StatusCode, err)
+	}
+
+	// 코드가 만료되었거나 verifier 가 맞지 않으면 400 invalid_grant 를 받는다
+	switch {
+	case resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized:
+		return nil, fmt.Errorf("%w: %s %s", domain.ErrOIDCLoginFailed, token.Error, token.ErrorDescription)
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("token endpoint returned status %d", resp.StatusCode)
+	case token.IDToken == "":
+		return nil, fmt.Errorf("%w: id_token is missing", domain.ErrOIDCLoginFailed)
+	}
+
+	return p.verifyIDToken(ctx, md, token.IDToken, nonce)
+}
+
+// verifyIDToken 은 ID 토큰의 서명, iss, aud, exp, nonce 를 검증한다 (OpenID Connect Core 3.1.3.7)
+func (p *provider) verifyIDToken(ctx context.Context, md *metadata, rawIDToken, nonce string) (*domain.OIDCIdentity, error) {
+	algs := supportedAlgorithms
+	if len(md.IDTokenSigningAlgs) > 0 {
+		algs = slices.DeleteFunc(slices.Clone(md.IDTokenSigningAlgs), func(alg string) bool {
+			return !slices.Contains(supportedAlgorithms, alg)
+		})
+	}
+
+	claims := new(idTokenClaims)
+	_, err := jwt.ParseWithClaims(rawIDToken, claims,
+		func(token *jwt.Token) (interface{}, error) {
+			return p.verificationKey(ctx, md, token)
+		},
+		jwt.WithValidMethods(algs),
+		jwt.WithIssuer(p.config.Issuer),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrOIDCLoginFailed, err)
+	}
+
+	// 여러 대상에게 발급된 토큰은 azp 가 이 클라이언트여야 한다
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.config.ClientID {
+		return nil, fmt.Errorf("%w: unexpected azp", domain.ErrOIDCLoginFailed)
+	}
+	if claims.Nonce == "" || subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, fmt.Errorf("%w: nonce mismatch", domain.ErrOIDCLoginFailed)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: sub is missing", domain.ErrOIDCLoginFailed)
+	}
+
+	return &domain.OIDCIdentity{
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+	}, nil
+}
+
+// verificationKey 는 토큰 헤더의 kid 로 공급자 JWKS 에서 검증 키를 찾는다.
+// 캐시에 없는 kid 이면 공급자가 키를 교체했을 수 있으므로 JWKS 를 다시 가져온다.
+func (p *provider) verificationKey(ctx context.Context, md *metadata, token *jwt.Token) (crypto.PublicKey, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key, ok := p.lookupKey(kid)
+	if !ok && time.Since(p.keysFetchedAt) >= jwksRefreshInterval {
+		if err := p.fetchKeys(ctx, md); err != nil {
+			return nil, err
+		}
+		key, ok = p.lookupKey(kid)
+	}
+	if !ok {
+		return nil, security.ErrUnknownKeyID
+	}
+
+	if key.alg != "" && key.alg != token.Method.Alg() {
+		return nil, security.ErrInvalidToken
+	}
+	return key.publicKey, nil
+}
+
+// lookupKey 는 kid 에 해당하는 키를 찾는다. kid 가 없는 토큰은 공급자의 키가 하나일 때만 허용한다
+func (p *provider) lookupKey(kid string) (jwksKey, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+// fetchKeys 는 공급자의 JWKS 를 가져온다. 호출하는 쪽에서 p.mu 를 잠가야 한다
+func (p *provider) fetchKeys(ctx context.Context, md *metadata) error {
+	var set security.JWKSet
+	if err := p.getJSON(ctx, md.JWKSURI, &set); err != nil {
+		return fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+
+	keys := make(map[string]jwksKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		// 암호화용 키나 지원하지 않는 종류의 키는 건너뛴다
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		publicKey, err := jwk.PublicKey()
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = jwksKey{alg: jwk.Alg, publicKey: publicKey}
+	}
+
+	p.keys = keys
+	p.keysFetchedAt = time.Now()
+	return nil
+}
+
+// discover 는 discovery 문서를 가져온다. 성공한 결과는 계속 사용한다
+func (p *provider) discover(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+
+	md := new(metadata)
+	if err := p.getJSON(ctx, strings.TrimSuffix(p.config.Issuer, "/")+discoveryPath, md); err != nil {
+		return nil, fmt.Errorf("failed to fetch discovery document: %w", err)
+	}
+
+	// 문서의 issuer 는 설정한 issuer 와 정확히 같아야 한다 (OpenID Connect Discovery 4.3)
+	if md.Issuer != p.config.Issuer {
+		return nil, fmt.Errorf("discovery issuer %q does not match %q", md.Issuer, p.config.Issuer)
+	}
+	if md.AuthorizationEndpoint == "" || md.TokenEndpoint == "" || md.JWKSURI == "" {
+		return nil, errors.New("discovery document is missing required endpoints")
+	}
+
+	p.metadata = md
+	return md, nil
+}
+
+func (p *provider) getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(v)
+}
@@ -0,0 +1,335 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nicewook/gocore/internal/config"
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+const (
+	testClientID     = "gocore"
+	testClientSecret = "client-secret"
+	testRedirectURL  = "http://localhost:8080/auth/oidc/callback"
+	testCode         = "auth-code"
+	testNonce        = "test-nonce"
+)
+
+// mockOIDCServer 는 discovery, JWKS, 토큰 엔드포인트를 제공하는 테스트용 공급자이다.
+// 토큰 엔드포인트는 PKCE verifier 가 challenge 와 맞을 때만 idToken 을 반환한다
+type mockOIDCServer struct {
+	*httptest.Server
+	key           *rsa.PrivateKey
+	kid           string
+	codeChallenge string
+	idToken       func(issuer string) string
+	jwksRequests  atomic.Int32
+}
+
+func newMockOIDCServer(t *testing.T) *mockOIDCServer {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	m := &mockOIDCServer{key: key, kid: "idp-key-1"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"issuer":                                m.URL,
+			"authorization_endpoint":                m.URL + "/authorize",
+			"token_endpoint":                        m.URL + "/token",
+			"jwks_uri":                              m.URL + "/jwks",
+			"token_endpoint_auth_methods_supported": []string{"client_secret_basic"},
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		m.jwksRequests.Add(1)
+		_ = json.NewEncoder(w).Encode(security.JWKSet{Keys: []security.JWK{{
+			Kty: "RSA",
+			Use: "sig",
+			Alg: "RS256",
+			Kid: m.kid,
+			N:   base64.RawURLEncoding.EncodeToString(m.key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(m.key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		clientID, clientSecret, _ := r.BasicAuth()
+		if clientID != testClientID || clientSecret != testClientSecret {
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client"})
+			return
+		}
+		if r.FormValue("grant_type") != "authorization_code" ||
+			r.FormValue("code") != testCode ||
+			r.FormValue("redirect_uri") != testRedirectURL ||
+			security.PKCEChallenge(r.FormValue("code_verifier")) != m.codeChallenge {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"access_token": "idp-access-token",
+			"token_type":   "Bearer",
+			"id_token":     m.idToken(m.URL),
+		})
+	})
+
+	m.Server = httptest.NewServer(mux)
+	t.Cleanup(m.Close)
+	return m
+}
+
+// signIDToken 은 공급자 키로 ID 토큰을 서명한다
+func (m *mockOIDCServer) signIDToken(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = m.kid
+	signed, err := token.SignedString(m.key)
+	assert.NoError(t, err)
+	return signed
+}
+
+func validClaims(issuer string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":            issuer,
+		"sub":            "idp-user-1",
+		"aud":            testClientID,
+		"exp":            time.Now().Add(5 * time.Minute).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          testNonce,
+		"email":          "staff@example.com",
+		"email_verified": true,
+		"name":           "Staff Member",
+	}
+}
+
+func newTestProvider(server *mockOIDCServer) domain.OIDCProvider {
+	return NewProvider(config.OIDCConfig{
+		Issuer:       server.URL,
+		ClientID:     testClientID,
+		ClientSecret: testClientSecret,
+		RedirectURL:  testRedirectURL,
+	}, server.Client())
+}
+
+func TestProvider_AuthCodeURL(t *testing.T) {
+	server := newMockOIDCServer(t)
+	p := newTestProvider(server)
+
+	authURL, err := p.AuthCodeURL(context.Background(), "state-1", "nonce-1", "challenge-1")
+	assert.NoError(t, err)
+
+	parsed, err := url.Parse(authURL)
+	assert.NoError(t, err)
+	assert.Equal(t, server.URL+"/authorize", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+
+	query := parsed.Query()
+	assert.Equal(t, "code", query.Get("response_type"))
+	assert.Equal(t, testClientID, query.Get("client_id"))
+	assert.Equal(t, testRedirectURL, query.Get("redirect_uri"))
+	assert.Equal(t, "openid email profile", query.Get("scope"))
+	assert.Equal(t, "state-1", query.Get("state"))
+	assert.Equal(t, "nonce-1", query.Get("nonce"))
+	assert.Equal(t, "challenge-1", query.Get("code_challenge"))
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+}
+
+func TestProvider_AuthCodeURLRejectsIssuerMismatch(t *testing.T) {
+	server := newMockOIDCServer(t)
+	p := NewProvider(config.OIDCConfig{Issuer: server.URL + "/", ClientID: testClientID}, server.Client())
+
+	_, err := p.AuthCodeURL(context.Background(), "state", "nonce", "challenge")
+	assert.ErrorContains(t, err, "does not match")
+}
+
+func TestProvider_Exchange(t *testing.T) {
+	verifier, err := security.GeneratePKCEVerifier()
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		code         string
+		codeVerifier string
+		nonce        string
+		claims       func(issuer string) jwt.MapClaims
+		expectErr    error
+	}{
+		{
+			name:         "Success",
+			code:         testCode,
+			codeVerifier: verifier,
+			nonce:        testNonce,
+			claims:       validClaims,
+		},
+		{
+			name:         "Wrong Code Verifier",
+			code:         testCode,
+			codeVerifier: "wrong-verifier",
+			nonce:        testNonce,
+			claims:       validClaims,
+			expectErr:    domain.ErrOIDCLoginFailed,
+		},
+		{
+			name:         "Wrong Code",
+			code:         "wrong-code",
+			codeVerifier: verifier,
+			nonce:        testNonce,
+			claims:       validClaims,
+			expectErr:    domain.ErrOIDCLoginFailed,
+		},
+		{
+			name:         "Nonce Mismatch",
+			code:         testCode,
+			codeVerifier: verifier,
+			nonce:        "other-nonce",
+			claims:       validClaims,
+			expectErr:    domain.ErrOIDCLoginFailed,
+		},
+		{
+			name:         "Wrong Audience",
+			code:         testCode,
+			codeVerifier: verifier,
+			nonce:        testNonce,
+			claims: func(issuer string) jwt.MapClaims {
+				claims := validClaims(issuer)
+				claims["aud"] = "other-client"
+				return claims
+			},
+			expectErr: domain.ErrOIDCLoginFailed,
+		},
+		{
+			name:         "Wrong Issuer",
+			code:         testCode,
+			codeVerifier: verifier,
+			nonce:        testNonce,
+			claims: func(issuer string) jwt.MapClaims {
+				claims := validClaims(issuer)
+				claims["iss"] = "https://evil.example.com"
+				return claims
+			},
+			expectErr: domain.ErrOIDCLoginFailed,
+		},
+		{
+			name:         "Expired ID Token",
+			code:         testCode,
+			codeVerifier: verifier,
+			nonce:        testNonce,
+			claims: func(issuer string) jwt.MapClaims {
+				claims := validClaims(issuer)
+				claims["exp"] = time.Now().Add(-time.Minute).Unix()
+				return claims
+			},
+			expectErr: domain.ErrOIDCLoginFailed,
+		},
+		{
+			name:         "Multiple Audiences Without azp",
+			code:         testCode,
+			codeVerifier: verifier,
+			nonce:        testNonce,
+			claims: func(issuer string) jwt.MapClaims {
+				claims := validClaims(issuer)
+				claims["aud"] = []string{testClientID, "other-client"}
+				return claims
+			},
+			expectErr: domain.ErrOIDCLoginFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newMockOIDCServer(t)
+			server.codeChallenge = security.PKCEChallenge(verifier)
+			server.idToken = func(issuer string) string {
+				return server.signIDToken(t, tt.claims(issuer))
+			}
+			p := newTestProvider(server)
+
+			identity, err := p.Exchange(context.Background(), tt.code, tt.codeVerifier, tt.nonce)
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+				assert.Nil(t, identity)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, &domain.OIDCIdentity{
+				Subject:       "idp-user-1",
+				Email:         "staff@example.com",
+				EmailVerified: true,
+				Name:          "Staff Member",
+			}, identity)
+		})
+	}
+}
+
+func TestProvider_ExchangeRejectsForeignSignature(t *testing.T) {
+	verifier, err := security.GeneratePKCEVerifier()
+	assert.NoError(t, err)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	server := newMockOIDCServer(t)
+	server.codeChallenge = security.PKCEChallenge(verifier)
+	server.idToken = func(issuer string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, validClaims(issuer))
+		token.Header["kid"] = server.kid
+		signed, err := token.SignedString(otherKey)
+		assert.NoError(t, err)
+		return signed
+	}
+
+	_, err = newTestProvider(server).Exchange(context.Background(), testCode, verifier, testNonce)
+	assert.ErrorIs(t, err, domain.ErrOIDCLoginFailed)
+}
+
+// 공급자가 키를 교체하면 모르는 kid 를 받았을 때 JWKS 를 다시 가져온다
+func TestProvider_ExchangeRefreshesKeysOnRotation(t *testing.T) {
+	verifier, err := security.GeneratePKCEVerifier()
+	assert.NoError(t, err)
+
+	server := newMockOIDCServer(t)
+	server.codeChallenge = security.PKCEChallenge(verifier)
+	server.idToken = func(issuer string) string {
+		return server.signIDToken(t, validClaims(issuer))
+	}
+	p := newTestProvider(server).(*provider)
+
+	_, err = p.Exchange(context.Background(), testCode, verifier, testNonce)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), server.jwksRequests.Load())
+
+	// 캐시된 키로 검증하므로 다시 가져오지 않는다
+	_, err = p.Exchange(context.Background(), testCode, verifier, testNonce)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), server.jwksRequests.Load())
+
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	server.key = newKey
+	server.kid = "idp-key-2"
+	p.keysFetchedAt = time.Now().Add(-jwksRefreshInterval)
+
+	_, err = p.Exchange(context.Background(), testCode, verifier, testNonce)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), server.jwksRequests.Load())
+}
@@ -0,0 +1,101 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+// loginAttempt 는 key 하나의 실패 기록이다
+type loginAttempt struct {
+	failures      int
+	lastFailureAt time.Time
+	lockedUntil   time.Time
+	expiresAt     time.Time // 실패 횟수 누적 기간과 잠금이 모두 끝나는 시각. 이후에는 기록이 필요 없다
+}
+
+// loginAttemptRepository 는 프로세스 메모리에 로그인 실패 기록을 보관한다.
+// 서버 인스턴스 간에 공유되지 않으므로 여러 인스턴스로 운영할 때는 postgres 구현을 사용한다.
+type loginAttemptRepository struct {
+	mu       sync.Mutex
+	attempts map[string]*loginAttempt
+}
+
+func NewLoginAttemptRepository() domain.LoginAttemptRepository {
+	return &loginAttemptRepository{
+		attempts: make(map[string]*loginAttempt),
+	}
+}
+
+func (r *loginAttemptRepository) RecordFailure(ctx context.Context, key string, window time.Duration) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.deleteExpired(now)
+
+	attempt, ok := r.attempts[key]
+	if !ok {
+		attempt = &loginAttempt{}
+		r.attempts[key] = attempt
+	}
+	if now.Sub(attempt.lastFailureAt) > window {
+		attempt.failures = 0
+	}
+	attempt.failures++
+	attempt.lastFailureAt = now
+	attempt.expiresAt = latest(now.Add(window), attempt.lockedUntil)
+
+	return attempt.failures, nil
+}
+
+func (r *loginAttemptRepository) Lock(ctx context.Context, key string, until time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	attempt, ok := r.attempts[key]
+	if !ok {
+		attempt = &loginAttempt{}
+		r.attempts[key] = attempt
+	}
+	attempt.lockedUntil = until
+	attempt.expiresAt = latest(attempt.expiresAt, until)
+	return nil
+}
+
+func (r *loginAttemptRepository) LockedUntil(ctx context.Context, key string) (time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	attempt, ok := r.attempts[key]
+	if !ok || !time.Now().Before(attempt.lockedUntil) {
+		return time.Time{}, nil
+	}
+	return attempt.lockedUntil, nil
+}
+
+func (r *loginAttemptRepository) Reset(ctx context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.attempts, key)
+	return nil
+}
+
+// deleteExpired 는 더 이상 필요 없는 기록을 정리한다. 호출 시 잠금을 잡고 있어야 한다
+func (r *loginAttemptRepository) deleteExpired(now time.Time) {
+	for key, attempt := range r.attempts {
+		if !now.Before(attempt.expiresAt) {
+			delete(r.attempts, key)
+		}
+	}
+}
+
+func latest(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,35 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+// permissionRepository 는 설정 파일에서 읽은 역할별 권한 매핑을 보관한다.
+// 매핑을 바꾸려면 설정을 고치고 서버를 다시 시작해야 한다.
+type permissionRepository struct {
+	rolePermissions map[string][]string
+}
+
+// NewPermissionRepository 는 역할별 권한 매핑으로 저장소를 만든다. 매핑이 비어 있으면 기본 매핑을 사용한다
+func NewPermissionRepository(rolePermissions map[string][]string) domain.PermissionRepository {
+	if len(rolePermissions) == 0 {
+		rolePermissions = domain.DefaultRolePermissions
+	}
+	return &permissionRepository{rolePermissions: copyRolePermissions(rolePermissions)}
+}
+
+func (r *permissionRepository) GetRolePermissions(ctx context.Context) (map[string][]string, error) {
+	// 호출한 쪽에서 수정해도 저장된 매핑이 바뀌지 않도록 복사본을 반환한다
+	return copyRolePermissions(r.rolePermissions), nil
+}
+
+func copyRolePermissions(rolePermissions map[string][]string) map[string][]string {
+	copied := make(map[string][]string, len(rolePermissions))
+	for role, permissions := range rolePermissions {
+		copied[role] = slices.Clone(permissions)
+	}
+	return copied
+}
@@ -0,0 +1,47 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+func TestPermissionRepository(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("매핑이 비어 있으면 기본 매핑", func(t *testing.T) {
+		repo := NewPermissionRepository(nil)
+
+		rolePermissions, err := repo.GetRolePermissions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.DefaultRolePermissions, rolePermissions)
+	})
+
+	t.Run("설정한 매핑 사용", func(t *testing.T) {
+		repo := NewPermissionRepository(map[string][]string{
+			domain.RoleUser: {domain.PermOrdersCreate},
+		})
+
+		rolePermissions, err := repo.GetRolePermissions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string][]string{domain.RoleUser: {domain.PermOrdersCreate}}, rolePermissions)
+	})
+
+	t.Run("반환된 매핑을 수정해도 저장소는 바뀌지 않음", func(t *testing.T) {
+		repo := NewPermissionRepository(map[string][]string{
+			domain.RoleUser: {domain.PermOrdersCreate},
+		})
+
+		rolePermissions, err := repo.GetRolePermissions(ctx)
+		assert.NoError(t, err)
+		rolePermissions[domain.RoleUser][0] = domain.PermUsersManage
+		rolePermissions[domain.RoleManager] = []string{domain.PermUsersManage}
+
+		rolePermissions, err = repo.GetRolePermissions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string][]string{domain.RoleUser: {domain.PermOrdersCreate}}, rolePermissions)
+	})
+}
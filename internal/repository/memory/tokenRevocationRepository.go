@@ -0,0 +1,86 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+// userRevocation 은 사용자 단위 폐기 기록이다
+type userRevocation struct {
+	issuedBefore time.Time // 이 시각 이전에 발급된 토큰은 폐기된 것으로 본다
+	expiresAt    time.Time // 이 시각 이후에는 기록이 필요 없다
+}
+
+// tokenRevocationRepository 는 프로세스 메모리에 폐기 목록을 보관한다.
+// 각 기록은 토큰 만료 시각(TTL)이 지나면 정리된다.
+// 서버 인스턴스 간에 공유되지 않으므로 여러 인스턴스로 운영할 때는 postgres 구현을 사용한다.
+type tokenRevocationRepository struct {
+	mu     sync.RWMutex
+	tokens map[string]time.Time     // jti → 만료 시각
+	users  map[int64]userRevocation // userID → 사용자 단위 폐기 기록
+}
+
+func NewTokenRevocationRepository() domain.TokenRevocationRepository {
+	return &tokenRevocationRepository{
+		tokens: make(map[string]time.Time),
+		users:  make(map[int64]userRevocation),
+	}
+}
+
+func (r *tokenRevocationRepository) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tokens[jti] = expiresAt
+	r.deleteExpired(time.Now())
+	return nil
+}
+
+func (r *tokenRevocationRepository) RevokeUserTokens(ctx context.Context, userID int64, issuedBefore, expiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// 이미 더 늦은 기준 시각이 있으면 유지한다
+	if current, ok := r.users[userID]; ok {
+		if current.issuedBefore.After(issuedBefore) {
+			issuedBefore = current.issuedBefore
+		}
+		if current.expiresAt.After(expiresAt) {
+			expiresAt = current.expiresAt
+		}
+	}
+	r.users[userID] = userRevocation{issuedBefore: issuedBefore, expiresAt: expiresAt}
+	r.deleteExpired(time.Now())
+	return nil
+}
+
+func (r *tokenRevocationRepository) IsRevoked(ctx context.Context, jti string, userID int64, issuedAt time.Time) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	now := time.Now()
+	if expiresAt, ok := r.tokens[jti]; ok && now.Before(expiresAt) {
+		return true, nil
+	}
+	if revocation, ok := r.users[userID]; ok && now.Before(revocation.expiresAt) {
+		return issuedAt.Before(revocation.issuedBefore), nil
+	}
+	return false, nil
+}
+
+// deleteExpired 는 TTL 이 지난 기록을 정리한다. 호출 시 쓰기 잠금을 잡고 있어야 한다
+func (r *tokenRevocationRepository) deleteExpired(now time.Time) {
+	for jti, expiresAt := range r.tokens {
+		if !now.Before(expiresAt) {
+			delete(r.tokens, jti)
+		}
+	}
+	for userID, revocation := range r.users {
+		if !now.Before(revocation.expiresAt) {
+			delete(r.users, userID)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenRevocationRepository(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("jti 로 폐기한 토큰은 폐기 상태", func(t *testing.T) {
+		repo := NewTokenRevocationRepository()
+		now := time.Now()
+
+		assert.NoError(t, repo.RevokeToken(ctx, "jti-1", now.Add(time.Hour)))
+
+		revoked, err := repo.IsRevoked(ctx, "jti-1", 1, now)
+		assert.NoError(t, err)
+		assert.True(t, revoked)
+
+		revoked, err = repo.IsRevoked(ctx, "jti-2", 1, now)
+		assert.NoError(t, err)
+		assert.False(t, revoked)
+	})
+
+	t.Run("만료 시각이 지난 기록은 무시", func(t *testing.T) {
+		repo := NewTokenRevocationRepository()
+		now := time.Now()
+
+		assert.NoError(t, repo.RevokeToken(ctx, "jti-1", now.Add(-time.Second)))
+		assert.NoError(t, repo.RevokeUserTokens(ctx, 1, now, now.Add(-time.Second)))
+
+		revoked, err := repo.IsRevoked(ctx, "jti-1", 1, now.Add(-time.Minute))
+		assert.NoError(t, err)
+		assert.False(t, revoked)
+	})
+
+	t.Run("사용자 단위 폐기는 기준 시각 이전에 발급된 토큰에만 적용", func(t *testing.T) {
+		repo := NewTokenRevocationRepository()
+		now := time.Now()
+
+		assert.NoError(t, repo.RevokeUserTokens(ctx, 1, now, now.Add(time.Hour)))
+
+		revoked, err := repo.IsRevoked(ctx, "old-jti", 1, now.Add(-time.Minute))
+		assert.NoError(t, err)
+		assert.True(t, revoked)
+
+		revoked, err = repo.IsRevoked(ctx, "new-jti", 1, now.Add(time.Minute))
+		assert.NoError(t, err)
+		assert.False(t, revoked)
+
+		revoked, err = repo.IsRevoked(ctx, "other-user-jti", 2, now.Add(-time.Minute))
+		assert.NoError(t, err)
+		assert.False(t, revoked)
+	})
+
+	t.Run("더 이른 기준 시각으로 덮어쓰지 않음", func(t *testing.T) {
+		repo := NewTokenRevocationRepository()
+		now := time.Now()
+
+		assert.NoError(t, repo.RevokeUserTokens(ctx, 1, now, now.Add(time.Hour)))
+		assert.NoError(t, repo.RevokeUserTokens(ctx, 1, now.Add(-time.Hour), now.Add(time.Minute)))
+
+		revoked, err := repo.IsRevoked(ctx, "jti", 1, now.Add(-time.Minute))
+		assert.NoError(t, err)
+		assert.True(t, revoked)
+	})
+}
@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+type apiKeyRepository struct {
+	db *sql.DB
+}
+
+func NewAPIKeyRepository(db *sql.DB) domain.APIKeyRepository {
+	return &apiKeyRepository{db: db}
+}
+
+func (r *apiKeyRepository) Save(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error) {
+	const query = `
+		INSERT INTO api_keys (user_id, name, prefix, key_hash, scopes, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		key.UserID, key.Name, key.Prefix, key.KeyHash, strings.Join(key.Scopes, ","), key.ExpiresAt,
+	).Scan(&key.ID, &key.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save api key: %w", err)
+	}
+	return key, nil
+}
+
+func (r *apiKeyRepository) GetByHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	const query = `
+		SELECT id, user_id, name, prefix, key_hash, scopes, expires_at, last_used_at, created_at
+		FROM api_keys
+		WHERE key_hash = $1
+	`
+
+	key, err := scanAPIKey(r.db.QueryRowContext(ctx, query, keyHash))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+	return key, nil
+}
+
+func (r *apiKeyRepository) ListByUserID(ctx context.Context, userID int64) ([]*domain.APIKey, error) {
+	const query = `
+		SELECT id, user_id, name, prefix, key_hash, scopes, expires_at, last_used_at, created_at
+		FROM api_keys
+		WHERE user_id = $1
+		ORDER BY id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	defer rows.Close()
+
+	keys := []*domain.APIKey{}
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan api key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	return keys, nil
+}
+
+func (r *apiKeyRepository) Delete(ctx context.Context, userID int64, id int64) error {
+	const query = `DELETE FROM api_keys WHERE id = $1 AND user_id = $2`
+
+	result, err := r.db.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete api key: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete api key: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *apiKeyRepository) TouchLastUsed(ctx context.Context, id int64) error {
+	// 요청마다 쓰기가 발생하지 않도록 1분 이내에 기록된 경우는 갱신하지 않는다
+	const query = `
+		UPDATE api_keys
+		SET last_used_at = NOW()
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute')
+	`
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to touch api key: %w", err)
+	}
+	return nil
+}
+
+// rowScanner 는 *sql.Row 와 *sql.Rows 를 같은 방식으로 읽기 위한 인터페이스이다
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAPIKey(row rowScanner) (*domain.APIKey, error) {
+	key := &domain.APIKey{}
+	var scopes string
+	var expiresAt, lastUsedAt sql.NullTime
+	if err := row.Scan(
+		&key.ID, &key.UserID, &key.Name, &key.Prefix, &key.KeyHash,
+		&scopes, &expiresAt, &lastUsedAt, &key.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	key.Scopes = domain.StringToScopes(scopes)
+	key.ExpiresAt = nullTimeToPtr(expiresAt)
+	key.LastUsedAt = nullTimeToPtr(lastUsedAt)
+	return key, nil
+}
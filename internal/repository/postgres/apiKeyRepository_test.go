@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+func TestAPIKeyRepository(t *testing.T) {
+	cleanDB(t, "api_keys", "users")
+	ctx := context.Background()
+
+	userRepo := NewUserRepository(testDB)
+	savedUser, err := userRepo.Save(ctx, &domain.User{Name: "Key User", Email: "apikey@example.com", Password: "password"})
+	assert.NoError(t, err)
+	otherUser, err := userRepo.Save(ctx, &domain.User{Name: "Other User", Email: "other-apikey@example.com", Password: "password"})
+	assert.NoError(t, err)
+
+	repo := NewAPIKeyRepository(testDB)
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+
+	var savedKey *domain.APIKey
+	t.Run("API 키 저장 및 해시로 조회", func(t *testing.T) {
+		savedKey, err = repo.Save(ctx, &domain.APIKey{
+			UserID:    savedUser.ID,
+			Name:      "batch",
+			Prefix:    "gk_abcdefgh",
+			KeyHash:   "hash-1",
+			Scopes:    []string{domain.RoleUser},
+			ExpiresAt: &expiresAt,
+		})
+		assert.NoError(t, err)
+		assert.NotZero(t, savedKey.ID)
+
+		fetched, err := repo.GetByHash(ctx, "hash-1")
+		assert.NoError(t, err)
+		assert.Equal(t, savedUser.ID, fetched.UserID)
+		assert.Equal(t, []string{domain.RoleUser}, fetched.Scopes)
+		assert.True(t, expiresAt.Equal(*fetched.ExpiresAt))
+		assert.Nil(t, fetched.LastUsedAt)
+
+		_, err = repo.GetByHash(ctx, "unknown-hash")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("scope 가 없는 키는 빈 목록으로 조회", func(t *testing.T) {
+		_, err := repo.Save(ctx, &domain.APIKey{
+			UserID:  otherUser.ID,
+			Name:    "no-scope",
+			Prefix:  "gk_ijklmnop",
+			KeyHash: "hash-2",
+		})
+		assert.NoError(t, err)
+
+		fetched, err := repo.GetByHash(ctx, "hash-2")
+		assert.NoError(t, err)
+		assert.Empty(t, fetched.Scopes)
+		assert.NotContains(t, fetched.Scopes, "")
+	})
+
+	t.Run("마지막 사용 시각 기록", func(t *testing.T) {
+		assert.NoError(t, repo.TouchLastUsed(ctx, savedKey.ID))
+
+		keys, err := repo.ListByUserID(ctx, savedUser.ID)
+		assert.NoError(t, err)
+		assert.Len(t, keys, 1)
+		assert.NotNil(t, keys[0].LastUsedAt)
+	})
+
+	t.Run("다른 사용자의 키는 삭제 불가", func(t *testing.T) {
+		assert.ErrorIs(t, repo.Delete(ctx, otherUser.ID, savedKey.ID), domain.ErrNotFound)
+
+		assert.NoError(t, repo.Delete(ctx, savedUser.ID, savedKey.ID))
+		_, err := repo.GetByHash(ctx, "hash-1")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+type loginAttemptRepository struct {
+	db *sql.DB
+}
+
+// NewLoginAttemptRepository 는 여러 서버 인스턴스가 로그인 실패 기록을 공유해야 할 때 사용한다
+func NewLoginAttemptRepository(db *sql.DB) domain.LoginAttemptRepository {
+	return &loginAttemptRepository{db: db}
+}
+
+func (r *loginAttemptRepository) RecordFailure(ctx context.Context, key string, window time.Duration) (int, error) {
+	// 마지막 실패 후 window 가 지났으면 1 부터 다시 센다. 동시에 실패해도 한 번의 UPSERT 로 누락 없이 증가한다
+	const query = `
+		INSERT INTO login_attempts (key, failures, last_failure_at, expires_at)
+		VALUES ($1, 1, NOW(), NOW() + make_interval(secs => $2))
+		ON CONFLICT (key) DO UPDATE
+		SET failures = CASE
+		        WHEN login_attempts.last_failure_at < NOW() - make_interval(secs => $2) THEN 1
+		        ELSE login_attempts.failures + 1
+		    END,
+		    last_failure_at = NOW(),
+		    expires_at = GREATEST(EXCLUDED.expires_at, login_attempts.locked_until)
+		RETURNING failures
+	`
+
+	var failures int
+	if err := r.db.QueryRowContext(ctx, query, key, window.Seconds()).Scan(&failures); err != nil {
+		return 0, fmt.Errorf("failed to record login failure: %w", err)
+	}
+
+	// 누적 기간과 잠금이 모두 끝난 기록은 더 이상 필요 없으므로 함께 정리한다
+	const cleanup = `DELETE FROM login_attempts WHERE expires_at < NOW()`
+	if _, err := r.db.ExecContext(ctx, cleanup); err != nil {
+		return 0, fmt.Errorf("failed to clean up login attempts: %w", err)
+	}
+	return failures, nil
+}
+
+func (r *loginAttemptRepository) Lock(ctx context.Context, key string, until time.Time) error {
+	const query = `
+		INSERT INTO login_attempts (key, failures, last_failure_at, locked_until, expires_at)
+		VALUES ($1, 0, NOW(), $2, $2)
+		ON CONFLICT (key) DO UPDATE
+		SET locked_until = EXCLUDED.locked_until,
+		    expires_at = GREATEST(login_attempts.expires_at, EXCLUDED.expires_at)
+	`
+	if _, err := r.db.ExecContext(ctx, query, key, until); err != nil {
+		return fmt.Errorf("failed to lock login: %w", err)
+	}
+	return nil
+}
+
+func (r *loginAttemptRepository) LockedUntil(ctx context.Context, key string) (time.Time, error) {
+	const query = `
+		SELECT locked_until
+		FROM login_attempts
+		WHERE key = $1 AND locked_until > NOW()
+	`
+
+	var lockedUntil time.Time
+	if err := r.db.QueryRowContext(ctx, query, key).Scan(&lockedUntil); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, fmt.Errorf("failed to get login lock: %w", err)
+	}
+	return lockedUntil, nil
+}
+
+func (r *loginAttemptRepository) Reset(ctx context.Context, key string) error {
+	const query = `DELETE FROM login_attempts WHERE key = $1`
+	if _, err := r.db.ExecContext(ctx, query, key); err != nil {
+		return fmt.Errorf("failed to reset login attempts: %w", err)
+	}
+	return nil
+}
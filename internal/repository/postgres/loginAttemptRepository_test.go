@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoginAttemptRepository(t *testing.T) {
+	cleanDB(t, "login_attempts")
+	ctx := context.Background()
+
+	repo := NewLoginAttemptRepository(testDB)
+
+	t.Run("실패 횟수는 key 별로 누적", func(t *testing.T) {
+		for i := 1; i <= 3; i++ {
+			failures, err := repo.RecordFailure(ctx, "email:a@example.com", time.Minute)
+			assert.NoError(t, err)
+			assert.Equal(t, i, failures)
+		}
+
+		failures, err := repo.RecordFailure(ctx, "email:b@example.com", time.Minute)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, failures)
+	})
+
+	t.Run("누적 기간이 지나면 다시 1부터", func(t *testing.T) {
+		_, err := repo.RecordFailure(ctx, "email:c@example.com", time.Minute)
+		assert.NoError(t, err)
+		time.Sleep(10 * time.Millisecond)
+
+		failures, err := repo.RecordFailure(ctx, "email:c@example.com", time.Millisecond)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, failures)
+	})
+
+	t.Run("잠금과 해제", func(t *testing.T) {
+		until := time.Now().Add(time.Minute).Truncate(time.Microsecond)
+		assert.NoError(t, repo.Lock(ctx, "email:a@example.com", until))
+
+		lockedUntil, err := repo.LockedUntil(ctx, "email:a@example.com")
+		assert.NoError(t, err)
+		assert.True(t, until.Equal(lockedUntil))
+
+		assert.NoError(t, repo.Reset(ctx, "email:a@example.com"))
+
+		lockedUntil, err = repo.LockedUntil(ctx, "email:a@example.com")
+		assert.NoError(t, err)
+		assert.True(t, lockedUntil.IsZero())
+	})
+
+	t.Run("지난 잠금은 무시", func(t *testing.T) {
+		assert.NoError(t, repo.Lock(ctx, "ip:127.0.0.1", time.Now().Add(-time.Second)))
+
+		lockedUntil, err := repo.LockedUntil(ctx, "ip:127.0.0.1")
+		assert.NoError(t, err)
+		assert.True(t, lockedUntil.IsZero())
+	})
+}
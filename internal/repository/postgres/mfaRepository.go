@@ -0,0 +1,201 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+type mfaRepository struct {
+	db *sql.DB
+}
+
+func NewMFARepository(db *sql.DB) domain.MFARepository {
+	return &mfaRepository{db: db}
+}
+
+func (r *mfaRepository) SaveSecret(ctx context.Context, userID int64, secret string) error {
+	// 등록 확인 전이면 비밀키를 교체하고, 이미 확인된 설정은 덮어쓰지 않는다
+	const query = `
+		INSERT INTO user_mfa (user_id, secret)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET secret = EXCLUDED.secret, last_used_step = 0, created_at = NOW()
+		WHERE user_mfa.enabled_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, userID, secret)
+	if err != nil {
+		return fmt.Errorf("failed to save mfa secret: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to save mfa secret: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrAlreadyExists
+	}
+	return nil
+}
+
+func (r *mfaRepository) GetByUserID(ctx context.Context, userID int64) (*domain.MFA, error) {
+	const query = `
+		SELECT user_id, secret, enabled_at, last_used_step, created_at
+		FROM user_mfa
+		WHERE user_id = $1
+	`
+
+	mfa := &domain.MFA{}
+	var enabledAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+		&mfa.UserID, &mfa.Secret, &enabledAt, &mfa.LastUsedStep, &mfa.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get mfa: %w", err)
+	}
+
+	mfa.EnabledAt = nullTimeToPtr(enabledAt)
+	return mfa, nil
+}
+
+func (r *mfaRepository) Enable(ctx context.Context, userID int64, recoveryCodeHashes []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE user_mfa
+		SET enabled_at = NOW()
+		WHERE user_id = $1 AND enabled_at IS NULL
+	`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to enable mfa: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to enable mfa: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM mfa_recovery_codes WHERE user_id = $1", userID); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+	for _, hash := range recoveryCodeHashes {
+		if _, err := tx.ExecContext(ctx,
+			"INSERT INTO mfa_recovery_codes (user_id, code_hash) VALUES ($1, $2)",
+			userID, hash,
+		); err != nil {
+			return fmt.Errorf("failed to save recovery code: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *mfaRepository) Delete(ctx context.Context, userID int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM mfa_recovery_codes WHERE user_id = $1", userID); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_mfa WHERE user_id = $1", userID); err != nil {
+		return fmt.Errorf("failed to delete mfa: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *mfaRepository) UpdateLastUsedStep(ctx context.Context, userID int64, step int64) error {
+	// 조건부 UPDATE 로 같은 주기의 코드가 동시에 사용되어도 한 번만 성공하도록 한다
+	const query = `
+		UPDATE user_mfa
+		SET last_used_step = $2
+		WHERE user_id = $1 AND last_used_step < $2
+	`
+
+	result, err := r.db.ExecContext(ctx, query, userID, step)
+	if err != nil {
+		return fmt.Errorf("failed to update mfa last used step: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update mfa last used step: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *mfaRepository) GetUnusedRecoveryCodes(ctx context.Context, userID int64) ([]domain.RecoveryCode, error) {
+	const query = `
+		SELECT id, user_id, code_hash
+		FROM mfa_recovery_codes
+		WHERE user_id = $1 AND used_at IS NULL
+		ORDER BY id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recovery codes: %w", err)
+	}
+	defer rows.Close()
+
+	codes := []domain.RecoveryCode{}
+	for rows.Next() {
+		var code domain.RecoveryCode
+		if err := rows.Scan(&code.ID, &code.UserID, &code.CodeHash); err != nil {
+			return nil, fmt.Errorf("failed to scan recovery code: %w", err)
+		}
+		codes = append(codes, code)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate recovery codes: %w", err)
+	}
+
+	return codes, nil
+}
+
+func (r *mfaRepository) UseRecoveryCode(ctx context.Context, id int64) error {
+	const query = `
+		UPDATE mfa_recovery_codes
+		SET used_at = NOW()
+		WHERE id = $1 AND used_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to use recovery code: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to use recovery code: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
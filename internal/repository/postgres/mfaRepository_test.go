@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+func TestMFARepository(t *testing.T) {
+	cleanDB(t, "mfa_recovery_codes", "user_mfa", "users")
+	ctx := context.Background()
+
+	userRepo := NewUserRepository(testDB)
+	savedUser, err := userRepo.Save(ctx, &domain.User{Name: "MFA User", Email: "mfa@example.com", Password: "password"})
+	assert.NoError(t, err)
+
+	repo := NewMFARepository(testDB)
+
+	t.Run("등록 확인 전에는 비밀키 교체 가능", func(t *testing.T) {
+		assert.NoError(t, repo.SaveSecret(ctx, savedUser.ID, "SECRET1"))
+		assert.NoError(t, repo.SaveSecret(ctx, savedUser.ID, "SECRET2"))
+
+		mfa, err := repo.GetByUserID(ctx, savedUser.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "SECRET2", mfa.Secret)
+		assert.False(t, mfa.IsEnabled())
+	})
+
+	t.Run("등록 확인 후에는 비밀키 교체 불가", func(t *testing.T) {
+		err := repo.Enable(ctx, savedUser.ID, []string{"hash-1", "hash-2"})
+		assert.NoError(t, err)
+
+		mfa, err := repo.GetByUserID(ctx, savedUser.ID)
+		assert.NoError(t, err)
+		assert.True(t, mfa.IsEnabled())
+
+		err = repo.SaveSecret(ctx, savedUser.ID, "SECRET3")
+		assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+
+		err = repo.Enable(ctx, savedUser.ID, nil)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("같은 주기의 코드는 재사용 불가", func(t *testing.T) {
+		assert.NoError(t, repo.UpdateLastUsedStep(ctx, savedUser.ID, 100))
+		assert.ErrorIs(t, repo.UpdateLastUsedStep(ctx, savedUser.ID, 100), domain.ErrNotFound)
+		assert.ErrorIs(t, repo.UpdateLastUsedStep(ctx, savedUser.ID, 99), domain.ErrNotFound)
+		assert.NoError(t, repo.UpdateLastUsedStep(ctx, savedUser.ID, 101))
+	})
+
+	t.Run("복구 코드는 한 번만 사용 가능", func(t *testing.T) {
+		codes, err := repo.GetUnusedRecoveryCodes(ctx, savedUser.ID)
+		assert.NoError(t, err)
+		assert.Len(t, codes, 2)
+
+		assert.NoError(t, repo.UseRecoveryCode(ctx, codes[0].ID))
+		assert.ErrorIs(t, repo.UseRecoveryCode(ctx, codes[0].ID), domain.ErrNotFound)
+
+		codes, err = repo.GetUnusedRecoveryCodes(ctx, savedUser.ID)
+		assert.NoError(t, err)
+		assert.Len(t, codes, 1)
+		assert.Equal(t, "hash-2", codes[0].CodeHash)
+	})
+
+	t.Run("삭제하면 설정과 복구 코드가 모두 제거됨", func(t *testing.T) {
+		assert.NoError(t, repo.Delete(ctx, savedUser.ID))
+
+		_, err := repo.GetByUserID(ctx, savedUser.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		codes, err := repo.GetUnusedRecoveryCodes(ctx, savedUser.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, codes)
+	})
+}
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nicewook/gocore/internal/db"
+)
+
+// newMigrationTestDB 는 마이그레이션을 되돌려도 다른 테스트가 사용하는 testdb 의 스키마가 지워지지 않도록
+// 같은 컨테이너에 빈 데이터베이스를 만들어 연결한다. 테스트가 끝나면 데이터베이스를 지운다
+func newMigrationTestDB(t *testing.T, name string) *sql.DB {
+	t.Helper()
+
+	_, err := testDB.Exec("DROP DATABASE IF EXISTS " + name)
+	assert.NoError(t, err)
+	_, err = testDB.Exec("CREATE DATABASE " + name)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	conn, err := sql.Open("postgres", testDSN(name))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		_, err := testDB.Exec("DROP DATABASE IF EXISTS " + name)
+		assert.NoError(t, err)
+	})
+	return conn
+}
+
+// 마이그레이션을 되돌렸다가 다시 적용해도 스키마가 같아야 한다
+func TestMigrations(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("setupSchema 에서 모두 적용", func(t *testing.T) {
+		migrator, err := db.NewMigrator(testDB, db.Migrations)
+		assert.NoError(t, err)
+
+		pending, err := migrator.Pending(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, pending)
+
+		// 이미 적용한 마이그레이션은 다시 적용하지 않는다
+		applied, err := migrator.Up(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, applied)
+	})
+
+	t.Run("되돌린 뒤 다시 적용", func(t *testing.T) {
+		migrationDB := newMigrationTestDB(t, "migrations_test")
+		migrator, err := db.NewMigrator(migrationDB, db.Migrations)
+		assert.NoError(t, err)
+
+		all, err := migrator.Up(ctx)
+		assert.NoError(t, err)
+		if !assert.NotEmpty(t, all) {
+			return
+		}
+		last := all[len(all)-1]
+
+		reverted, err := migrator.Down(ctx, 1)
+		assert.NoError(t, err)
+		assert.Len(t, reverted, 1)
+		assert.Equal(t, last.Version, reverted[0].Version)
+
+		pending, err := migrator.Pending(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, pending, 1)
+
+		applied, err := migrator.Up(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, applied, 1)
+
+		// 다시 적용하면 기본 역할도 다시 등록된다
+		roles, err := NewUserRepository(migrationDB).GetAllRoles(ctx)
+		assert.NoError(t, err)
+		assert.Contains(t, roles, "Admin")
+	})
+}
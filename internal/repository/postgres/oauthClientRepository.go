@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+type oauthClientRepository struct {
+	db *sql.DB
+}
+
+func NewOAuthClientRepository(db *sql.DB) domain.OAuthClientRepository {
+	return &oauthClientRepository{db: db}
+}
+
+func (r *oauthClientRepository) Save(ctx context.Context, client *domain.OAuthClient) (*domain.OAuthClient, error) {
+	const query = `
+		INSERT INTO oauth_clients (client_id, name, secret_hash, scopes)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query, client.ClientID, client.Name, client.SecretHash, strings.Join(client.Scopes, ",")).
+		Scan(&client.ID, &client.CreatedAt)
+	if err != nil {
+		// PostgreSQL의 unique_violation 에러 코드 (23505)
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return nil, domain.ErrAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to save oauth client: %w", err)
+	}
+	return client, nil
+}
+
+func (r *oauthClientRepository) GetByClientID(ctx context.Context, clientID string) (*domain.OAuthClient, error) {
+	const query = `
+		SELECT id, client_id, name, secret_hash, scopes, created_at
+		FROM oauth_clients
+		WHERE client_id = $1
+	`
+
+	client, err := scanOAuthClient(r.db.QueryRowContext(ctx, query, clientID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get oauth client: %w", err)
+	}
+	return client, nil
+}
+
+func (r *oauthClientRepository) GetAll(ctx context.Context) ([]*domain.OAuthClient, error) {
+	const query = `
+		SELECT id, client_id, name, secret_hash, scopes, created_at
+		FROM oauth_clients
+		ORDER BY id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list oauth clients: %w", err)
+	}
+	defer rows.Close()
+
+	clients := []*domain.OAuthClient{}
+	for rows.Next() {
+		client, err := scanOAuthClient(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan oauth client: %w", err)
+		}
+		clients = append(clients, client)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list oauth clients: %w", err)
+	}
+	return clients, nil
+}
+
+func (r *oauthClientRepository) Delete(ctx context.Context, id int64) error {
+	const query = `DELETE FROM oauth_clients WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete oauth client: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete oauth client: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func scanOAuthClient(row rowScanner) (*domain.OAuthClient, error) {
+	client := &domain.OAuthClient{}
+	var scopes string
+	if err := row.Scan(&client.ID, &client.ClientID, &client.Name, &client.SecretHash, &scopes, &client.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	client.Scopes = domain.StringToScopes(scopes)
+	return client, nil
+}
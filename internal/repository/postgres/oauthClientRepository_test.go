@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+func TestOAuthClientRepository(t *testing.T) {
+	cleanDB(t, "oauth_clients")
+	ctx := context.Background()
+
+	repo := NewOAuthClientRepository(testDB)
+
+	var saved *domain.OAuthClient
+	t.Run("클라이언트 저장 및 조회", func(t *testing.T) {
+		var err error
+		saved, err = repo.Save(ctx, &domain.OAuthClient{
+			ClientID:   "billing-service",
+			Name:       "Billing",
+			SecretHash: "hash-1",
+			Scopes:     []string{"orders:read", "users:read"},
+		})
+		assert.NoError(t, err)
+		assert.NotZero(t, saved.ID)
+
+		fetched, err := repo.GetByClientID(ctx, "billing-service")
+		assert.NoError(t, err)
+		assert.Equal(t, "hash-1", fetched.SecretHash)
+		assert.Equal(t, []string{"orders:read", "users:read"}, fetched.Scopes)
+
+		_, err = repo.GetByClientID(ctx, "unknown")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("중복 client_id", func(t *testing.T) {
+		_, err := repo.Save(ctx, &domain.OAuthClient{ClientID: "billing-service", Name: "Dup", SecretHash: "hash-2", Scopes: []string{"orders:read"}})
+		assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+	})
+
+	t.Run("목록 및 삭제", func(t *testing.T) {
+		clients, err := repo.GetAll(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, clients, 1)
+
+		assert.NoError(t, repo.Delete(ctx, saved.ID))
+		assert.ErrorIs(t, repo.Delete(ctx, saved.ID), domain.ErrNotFound)
+	})
+
+	t.Run("scope 가 없는 클라이언트는 빈 목록으로 조회", func(t *testing.T) {
+		_, err := repo.Save(ctx, &domain.OAuthClient{ClientID: "no-scope-service", Name: "No Scope", SecretHash: "hash-3"})
+		assert.NoError(t, err)
+
+		fetched, err := repo.GetByClientID(ctx, "no-scope-service")
+		assert.NoError(t, err)
+		assert.Empty(t, fetched.Scopes)
+		assert.NotContains(t, fetched.Scopes, "")
+	})
+}
@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+type oneTimeTokenRepository struct {
+	db *sql.DB
+}
+
+func NewOneTimeTokenRepository(db *sql.DB) domain.OneTimeTokenRepository {
+	return &oneTimeTokenRepository{db: db}
+}
+
+func (r *oneTimeTokenRepository) Save(ctx context.Context, token *domain.OneTimeToken) (*domain.OneTimeToken, error) {
+	const query = `
+		INSERT INTO one_time_tokens (user_id, purpose, token_hash, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query, token.UserID, token.Purpose, token.TokenHash, token.ExpiresAt).
+		Scan(&token.ID, &token.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save one-time token: %w", err)
+	}
+
+	return token, nil
+}
+
+func (r *oneTimeTokenRepository) Find(ctx context.Context, purpose domain.OneTimeTokenPurpose, tokenHash string) (*domain.OneTimeToken, error) {
+	const query = `
+		SELECT id, user_id, purpose, token_hash, expires_at, created_at
+		FROM one_time_tokens
+		WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > NOW()
+	`
+
+	token := &domain.OneTimeToken{}
+	err := r.db.QueryRowContext(ctx, query, tokenHash, purpose).Scan(
+		&token.ID, &token.UserID, &token.Purpose, &token.TokenHash,
+		&token.ExpiresAt, &token.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find one-time token: %w", err)
+	}
+	return token, nil
+}
+
+func (r *oneTimeTokenRepository) Consume(ctx context.Context, purpose domain.OneTimeTokenPurpose, tokenHash string) (*domain.OneTimeToken, error) {
+	// 조건부 UPDATE 로 동시에 같은 토큰을 사용하는 경우에도 한 번만 성공하도록 한다
+	const query = `
+		UPDATE one_time_tokens
+		SET used_at = NOW()
+		WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > NOW()
+		RETURNING id, user_id, purpose, token_hash, expires_at, used_at, created_at
+	`
+
+	token := &domain.OneTimeToken{}
+	var usedAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, query, tokenHash, purpose).Scan(
+		&token.ID, &token.UserID, &token.Purpose, &token.TokenHash,
+		&token.ExpiresAt, &usedAt, &token.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to consume one-time token: %w", err)
+	}
+
+	if usedAt.Valid {
+		token.UsedAt = &usedAt.Time
+	}
+	return token, nil
+}
+
+func (r *oneTimeTokenRepository) DeleteByUserID(ctx context.Context, userID int64, purpose domain.OneTimeTokenPurpose) error {
+	const query = `
+		DELETE FROM one_time_tokens
+		WHERE user_id = $1 AND purpose = $2
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, purpose); err != nil {
+		return fmt.Errorf("failed to delete one-time tokens: %w", err)
+	}
+	return nil
+}
+
+func (r *oneTimeTokenRepository) LatestCreatedAt(ctx context.Context, userID int64, purpose domain.OneTimeTokenPurpose) (time.Time, error) {
+	const query = `
+		SELECT MAX(created_at)
+		FROM one_time_tokens
+		WHERE user_id = $1 AND purpose = $2
+	`
+
+	var createdAt sql.NullTime
+	if err := r.db.QueryRowContext(ctx, query, userID, purpose).Scan(&createdAt); err != nil {
+		return time.Time{}, fmt.Errorf("failed to get latest one-time token: %w", err)
+	}
+	if !createdAt.Valid {
+		return time.Time{}, domain.ErrNotFound
+	}
+	return createdAt.Time, nil
+}
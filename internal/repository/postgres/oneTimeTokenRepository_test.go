@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+func TestOneTimeTokenRepository(t *testing.T) {
+	cleanDB(t, "one_time_tokens", "users")
+	ctx := context.Background()
+
+	userRepo := NewUserRepository(testDB)
+	savedUser, err := userRepo.Save(ctx, &domain.User{Name: "Reset User", Email: "reset@example.com", Password: "password"})
+	assert.NoError(t, err)
+
+	repo := NewOneTimeTokenRepository(testDB)
+
+	t.Run("토큰은 한 번만 사용 가능", func(t *testing.T) {
+		_, err := repo.Save(ctx, &domain.OneTimeToken{
+			UserID:    savedUser.ID,
+			Purpose:   domain.PurposePasswordReset,
+			TokenHash: "reset-hash-1",
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		assert.NoError(t, err)
+
+		// Find 는 사용 처리하지 않는다
+		found, err := repo.Find(ctx, domain.PurposePasswordReset, "reset-hash-1")
+		assert.NoError(t, err)
+		assert.Equal(t, savedUser.ID, found.UserID)
+		assert.Nil(t, found.UsedAt)
+
+		consumed, err := repo.Consume(ctx, domain.PurposePasswordReset, "reset-hash-1")
+		assert.NoError(t, err)
+		assert.Equal(t, savedUser.ID, consumed.UserID)
+		assert.NotNil(t, consumed.UsedAt)
+
+		_, err = repo.Consume(ctx, domain.PurposePasswordReset, "reset-hash-1")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		_, err = repo.Find(ctx, domain.PurposePasswordReset, "reset-hash-1")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("만료된 토큰은 사용 불가", func(t *testing.T) {
+		_, err := repo.Save(ctx, &domain.OneTimeToken{
+			UserID:    savedUser.ID,
+			Purpose:   domain.PurposePasswordReset,
+			TokenHash: "reset-hash-2",
+			ExpiresAt: time.Now().Add(-time.Minute),
+		})
+		assert.NoError(t, err)
+
+		_, err = repo.Find(ctx, domain.PurposePasswordReset, "reset-hash-2")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		_, err = repo.Consume(ctx, domain.PurposePasswordReset, "reset-hash-2")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("삭제된 토큰은 사용 불가", func(t *testing.T) {
+		_, err := repo.Save(ctx, &domain.OneTimeToken{
+			UserID:    savedUser.ID,
+			Purpose:   domain.PurposePasswordReset,
+			TokenHash: "reset-hash-3",
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		assert.NoError(t, err)
+
+		assert.NoError(t, repo.DeleteByUserID(ctx, savedUser.ID, domain.PurposePasswordReset))
+
+		_, err = repo.Consume(ctx, domain.PurposePasswordReset, "reset-hash-3")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+type permissionRepository struct {
+	db *sql.DB
+}
+
+// NewPermissionRepository 는 역할별 권한 매핑을 role_permissions 테이블에서 읽는다
+// 서버를 다시 시작하지 않고 매핑을 바꾸거나, 여러 인스턴스가 같은 매핑을 사용해야 할 때 사용한다
+func NewPermissionRepository(db *sql.DB) domain.PermissionRepository {
+	return &permissionRepository{db: db}
+}
+
+func (r *permissionRepository) GetRolePermissions(ctx context.Context) (map[string][]string, error) {
+	const query = `
+		SELECT role, permission
+		FROM role_permissions
+		ORDER BY role, permission
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role permissions: %w", err)
+	}
+	defer rows.Close()
+
+	rolePermissions := make(map[string][]string)
+	for rows.Next() {
+		var role, permission string
+		if err := rows.Scan(&role, &permission); err != nil {
+			return nil, fmt.Errorf("failed to scan role permission: %w", err)
+		}
+		rolePermissions[role] = append(rolePermissions[role], permission)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate role permissions: %w", err)
+	}
+	return rolePermissions, nil
+}
+
+// SeedRolePermissions 는 role_permissions 테이블이 비어 있으면 rolePermissions 로 채우고, 채웠는지를 반환한다
+// 서버를 시작할 때 domain.DefaultRolePermissions 를 전달하여 코드의 기본 매핑과 테이블의 초기 매핑이 달라지지 않게 한다.
+// 여러 인스턴스가 동시에 시작해도 한 번만 채우도록 테이블을 잠그고 확인한다
+func SeedRolePermissions(ctx context.Context, db *sql.DB, rolePermissions map[string][]string) (bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "LOCK TABLE role_permissions IN SHARE ROW EXCLUSIVE MODE"); err != nil {
+		return false, fmt.Errorf("failed to lock role permissions: %w", err)
+	}
+	var exists bool
+	if err := tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM role_permissions)").Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check role permissions: %w", err)
+	}
+	if exists {
+		return false, nil
+	}
+
+	roles := make([]string, 0, len(rolePermissions))
+	for role := range rolePermissions {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	for _, role := range roles {
+		for _, permission := range rolePermissions[role] {
+			if _, err := tx.ExecContext(ctx,
+				"INSERT INTO role_permissions (role, permission) VALUES ($1, $2) ON CONFLICT DO NOTHING",
+				role, permission,
+			); err != nil {
+				return false, fmt.Errorf("failed to seed role permission: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return true, nil
+}
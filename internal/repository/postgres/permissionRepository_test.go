@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+func TestPermissionRepository(t *testing.T) {
+	cleanDB(t, "role_permissions")
+	ctx := context.Background()
+
+	repo := NewPermissionRepository(testDB)
+
+	t.Run("매핑이 없으면 빈 결과", func(t *testing.T) {
+		rolePermissions, err := repo.GetRolePermissions(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, rolePermissions)
+	})
+
+	t.Run("역할별 권한 조회", func(t *testing.T) {
+		_, err := testDB.Exec(`
+			INSERT INTO role_permissions (role, permission) VALUES
+			('User', 'orders:read:own'), ('User', 'orders:create'), ('Manager', 'orders:read:any')
+		`)
+		assert.NoError(t, err)
+
+		rolePermissions, err := repo.GetRolePermissions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string][]string{
+			domain.RoleManager: {domain.PermOrdersReadAny},
+			domain.RoleUser:    {domain.PermOrdersCreate, domain.PermOrdersReadOwn},
+		}, rolePermissions)
+	})
+
+	t.Run("비어 있을 때만 기본 매핑으로 채움", func(t *testing.T) {
+		// 이미 매핑이 있으면 채우지 않는다
+		seeded, err := SeedRolePermissions(ctx, testDB, domain.DefaultRolePermissions)
+		assert.NoError(t, err)
+		assert.False(t, seeded)
+
+		cleanDB(t, "role_permissions")
+		seeded, err = SeedRolePermissions(ctx, testDB, domain.DefaultRolePermissions)
+		assert.NoError(t, err)
+		assert.True(t, seeded)
+
+		rolePermissions, err := repo.GetRolePermissions(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, rolePermissions, len(domain.DefaultRolePermissions))
+		for role, permissions := range domain.DefaultRolePermissions {
+			assert.ElementsMatch(t, permissions, rolePermissions[role], role)
+		}
+	})
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+func TestRefreshTokenRepository(t *testing.T) {
+	cleanDB(t, "refresh_tokens", "users")
+	ctx := context.Background()
+
+	userRepo := NewUserRepository(testDB)
+	savedUser, err := userRepo.Save(ctx, &domain.User{Name: "Token User", Email: "token@example.com", Password: "password"})
+	assert.NoError(t, err)
+
+	repo := NewRefreshTokenRepository(testDB)
+	familyID := uuid.NewString()
+
+	t.Run("리프레시 토큰 저장 및 해시로 조회", func(t *testing.T) {
+		token := &domain.RefreshToken{
+			UserID:    savedUser.ID,
+			FamilyID:  familyID,
+			TokenHash: "hash-1",
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+		savedToken, err := repo.Save(ctx, token)
+		assert.NoError(t, err)
+		assert.NotZero(t, savedToken.ID)
+
+		fetched, err := repo.GetByHash(ctx, "hash-1")
+		assert.NoError(t, err)
+		assert.Equal(t, savedUser.ID, fetched.UserID)
+		assert.Equal(t, familyID, fetched.FamilyID)
+		assert.Nil(t, fetched.RotatedAt)
+		assert.Nil(t, fetched.RevokedAt)
+		assert.True(t, fetched.IsActive(time.Now()))
+	})
+
+	t.Run("존재하지 않는 해시로 조회 시 실패", func(t *testing.T) {
+		fetched, err := repo.GetByHash(ctx, "unknown-hash")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		assert.Nil(t, fetched)
+	})
+
+	t.Run("교체는 한 번만 성공", func(t *testing.T) {
+		fetched, err := repo.GetByHash(ctx, "hash-1")
+		assert.NoError(t, err)
+
+		assert.NoError(t, repo.MarkRotated(ctx, fetched.ID))
+		assert.ErrorIs(t, repo.MarkRotated(ctx, fetched.ID), domain.ErrNotFound)
+
+		rotated, err := repo.GetByHash(ctx, "hash-1")
+		assert.NoError(t, err)
+		assert.NotNil(t, rotated.RotatedAt)
+		assert.False(t, rotated.IsActive(time.Now()))
+	})
+
+	t.Run("family 전체 폐기", func(t *testing.T) {
+		_, err := repo.Save(ctx, &domain.RefreshToken{
+			UserID:    savedUser.ID,
+			FamilyID:  familyID,
+			TokenHash: "hash-2",
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		assert.NoError(t, err)
+
+		assert.NoError(t, repo.RevokeFamily(ctx, familyID))
+
+		for _, hash := range []string{"hash-1", "hash-2"} {
+			fetched, err := repo.GetByHash(ctx, hash)
+			assert.NoError(t, err)
+			assert.NotNil(t, fetched.RevokedAt)
+		}
+	})
+}
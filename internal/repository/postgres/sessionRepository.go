@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+type sessionRepository struct {
+	db *sql.DB
+}
+
+func NewSessionRepository(db *sql.DB) domain.SessionRepository {
+	return &sessionRepository{db: db}
+}
+
+func (r *sessionRepository) Create(ctx context.Context, session *domain.Session) error {
+	const query = `
+		INSERT INTO sessions (id, user_id, user_agent, ip, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at, last_used_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query, session.ID, session.UserID, session.UserAgent, session.IP, session.ExpiresAt).
+		Scan(&session.CreatedAt, &session.LastUsedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+	return nil
+}
+
+func (r *sessionRepository) Touch(ctx context.Context, id string, ip string, expiresAt time.Time) error {
+	const query = `
+		UPDATE sessions
+		SET last_used_at = NOW(), ip = $2, expires_at = $3
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, ip, expiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to touch session: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to touch session: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *sessionRepository) ListByUserID(ctx context.Context, userID int64) ([]*domain.Session, error) {
+	const query = `
+		SELECT id, user_id, user_agent, ip, created_at, last_used_at, expires_at
+		FROM sessions
+		WHERE user_id = $1 AND expires_at > NOW()
+		ORDER BY last_used_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	defer rows.Close()
+
+	sessions := []*domain.Session{}
+	for rows.Next() {
+		session := &domain.Session{}
+		if err := rows.Scan(
+			&session.ID, &session.UserID, &session.UserAgent, &session.IP,
+			&session.CreatedAt, &session.LastUsedAt, &session.ExpiresAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan session: %w", err)
+		}
+		sessions = append(sessions, session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+func (r *sessionRepository) Delete(ctx context.Context, userID int64, id string) error {
+	// 다른 사용자의 세션은 삭제할 수 없도록 user_id 를 함께 확인한다
+	const query = `DELETE FROM sessions WHERE id = $1 AND user_id = $2`
+
+	result, err := r.db.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *sessionRepository) DeleteAllByUserID(ctx context.Context, userID int64) error {
+	const query = `DELETE FROM sessions WHERE user_id = $1`
+	if _, err := r.db.ExecContext(ctx, query, userID); err != nil {
+		return fmt.Errorf("failed to delete sessions of user: %w", err)
+	}
+	return nil
+}
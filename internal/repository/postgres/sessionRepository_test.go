@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+func TestSessionRepository(t *testing.T) {
+	cleanDB(t, "sessions", "users")
+	ctx := context.Background()
+
+	userRepo := NewUserRepository(testDB)
+	savedUser, err := userRepo.Save(ctx, &domain.User{Name: "Session User", Email: "session@example.com", Password: "password"})
+	assert.NoError(t, err)
+	otherUser, err := userRepo.Save(ctx, &domain.User{Name: "Other User", Email: "other-session@example.com", Password: "password"})
+	assert.NoError(t, err)
+
+	repo := NewSessionRepository(testDB)
+	firstID, secondID := uuid.NewString(), uuid.NewString()
+
+	t.Run("세션 생성 및 조회", func(t *testing.T) {
+		for _, id := range []string{firstID, secondID} {
+			session := &domain.Session{
+				ID:        id,
+				UserID:    savedUser.ID,
+				UserAgent: "Mozilla/5.0",
+				IP:        "127.0.0.1",
+				ExpiresAt: time.Now().Add(time.Hour),
+			}
+			assert.NoError(t, repo.Create(ctx, session))
+			assert.False(t, session.CreatedAt.IsZero())
+		}
+
+		// 만료된 세션은 목록에 나오지 않는다
+		assert.NoError(t, repo.Create(ctx, &domain.Session{
+			ID:        uuid.NewString(),
+			UserID:    savedUser.ID,
+			ExpiresAt: time.Now().Add(-time.Second),
+		}))
+
+		sessions, err := repo.ListByUserID(ctx, savedUser.ID)
+		assert.NoError(t, err)
+		assert.Len(t, sessions, 2)
+	})
+
+	t.Run("사용 시 최근 사용 순으로 정렬", func(t *testing.T) {
+		assert.NoError(t, repo.Touch(ctx, firstID, "10.0.0.1", time.Now().Add(2*time.Hour)))
+
+		sessions, err := repo.ListByUserID(ctx, savedUser.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, firstID, sessions[0].ID)
+		assert.Equal(t, "10.0.0.1", sessions[0].IP)
+
+		assert.ErrorIs(t, repo.Touch(ctx, uuid.NewString(), "10.0.0.1", time.Now()), domain.ErrNotFound)
+	})
+
+	t.Run("다른 사용자의 세션은 삭제 불가", func(t *testing.T) {
+		assert.ErrorIs(t, repo.Delete(ctx, otherUser.ID, firstID), domain.ErrNotFound)
+
+		assert.NoError(t, repo.Delete(ctx, savedUser.ID, firstID))
+		assert.ErrorIs(t, repo.Touch(ctx, firstID, "10.0.0.1", time.Now()), domain.ErrNotFound)
+	})
+
+	t.Run("사용자의 모든 세션 삭제", func(t *testing.T) {
+		assert.NoError(t, repo.DeleteAllByUserID(ctx, savedUser.ID))
+
+		sessions, err := repo.ListByUserID(ctx, savedUser.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, sessions)
+	})
+}
@@ -12,10 +12,15 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/nicewook/gocore/internal/db"
 )
 
 var testDB *sql.DB
 
+// testDBHost, testDBPort 는 테스트 컨테이너의 주소이다. 별도의 데이터베이스가 필요한 테스트가 testDSN 으로 연결한다
+var testDBHost, testDBPort string
+
 func TestMain(m *testing.M) {
 
 	// PostgreSQL 컨테이너 시작
@@ -70,8 +75,8 @@ func setupPostgresContainer(ctx context.Context) (testcontainers.Container, *sql
 		return nil, nil, err
 	}
 
-	dsn := fmt.Sprintf("host=%s port=%s user=testuser password=testpass dbname=testdb sslmode=disable", host, port.Port())
-	db, err := sql.Open("postgres", dsn)
+	testDBHost, testDBPort = host, port.Port()
+	db, err := sql.Open("postgres", testDSN("testdb"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -87,34 +92,19 @@ func setupPostgresContainer(ctx context.Context) (testcontainers.Container, *sql
 	return container, db, nil
 }
 
+// testDSN 은 테스트 컨테이너의 dbname 데이터베이스에 연결하는 DSN 을 반환한다
+func testDSN(dbname string) string {
+	return fmt.Sprintf("host=%s port=%s user=testuser password=testpass dbname=%s sslmode=disable", testDBHost, testDBPort, dbname)
+}
+
+// setupSchema 는 서버와 같은 마이그레이션으로 스키마를 만든다
 func setupSchema() {
-	const schema = `
-        CREATE TABLE IF NOT EXISTS users (
-			id SERIAL PRIMARY KEY,
-			name VARCHAR(255) NOT NULL,
-			email VARCHAR(255) NOT NULL UNIQUE,
-			password VARCHAR(255) NOT NULL,
-			roles VARCHAR(255) DEFAULT 'User'
-		);
-        CREATE TABLE IF NOT EXISTS products (
-			id SERIAL PRIMARY KEY,
-			name VARCHAR(255) NOT NULL,
-            price_in_krw BIGINT NOT NULL,
-			UNIQUE (name)
-		);
-        CREATE TABLE IF NOT EXISTS orders (
-			id SERIAL PRIMARY KEY,
-			user_id INT NOT NULL,
-			product_id INT NOT NULL,
-			quantity INT NOT NULL,
-		    total_price_in_krw BIGINT NOT NULL,
-			created_at TIMESTAMP NOT NULL DEFAULT NOW(),
-			FOREIGN KEY (user_id) REFERENCES users(id),
-			FOREIGN KEY (product_id) REFERENCES products(id)
-		);
-    `
-	if _, err := testDB.Exec(schema); err != nil {
-		log.Fatalf("테이블 생성 실패: %v", err)
+	migrator, err := db.NewMigrator(testDB, db.Migrations)
+	if err != nil {
+		log.Fatalf("마이그레이션 로드 실패: %v", err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		log.Fatalf("마이그레이션 실패: %v", err)
 	}
 }
 
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+type tokenRevocationRepository struct {
+	db *sql.DB
+}
+
+// NewTokenRevocationRepository 는 여러 서버 인스턴스가 폐기 목록을 공유해야 할 때 사용한다
+func NewTokenRevocationRepository(db *sql.DB) domain.TokenRevocationRepository {
+	return &tokenRevocationRepository{db: db}
+}
+
+func (r *tokenRevocationRepository) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	const query = `
+		INSERT INTO revoked_tokens (jti, expires_at)
+		VALUES ($1, $2)
+		ON CONFLICT (jti) DO NOTHING
+	`
+	if _, err := r.db.ExecContext(ctx, query, jti, expiresAt); err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+
+	// 이미 만료된 토큰의 폐기 기록은 더 이상 필요 없으므로 함께 정리한다
+	const cleanup = `DELETE FROM revoked_tokens WHERE expires_at < NOW()`
+	if _, err := r.db.ExecContext(ctx, cleanup); err != nil {
+		return fmt.Errorf("failed to clean up revoked tokens: %w", err)
+	}
+	return nil
+}
+
+func (r *tokenRevocationRepository) RevokeUserTokens(ctx context.Context, userID int64, issuedBefore, expiresAt time.Time) error {
+	const query = `
+		INSERT INTO user_token_revocations (user_id, revoked_before, expires_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE
+		SET revoked_before = GREATEST(user_token_revocations.revoked_before, EXCLUDED.revoked_before),
+		    expires_at = GREATEST(user_token_revocations.expires_at, EXCLUDED.expires_at)
+	`
+	if _, err := r.db.ExecContext(ctx, query, userID, issuedBefore, expiresAt); err != nil {
+		return fmt.Errorf("failed to revoke user tokens: %w", err)
+	}
+	return nil
+}
+
+func (r *tokenRevocationRepository) IsRevoked(ctx context.Context, jti string, userID int64, issuedAt time.Time) (bool, error) {
+	const query = `
+		SELECT EXISTS (
+			SELECT 1 FROM revoked_tokens WHERE jti = $1 AND expires_at > NOW()
+		) OR EXISTS (
+			SELECT 1 FROM user_token_revocations
+			WHERE user_id = $2 AND revoked_before > $3 AND expires_at > NOW()
+		)
+	`
+
+	var revoked bool
+	if err := r.db.QueryRowContext(ctx, query, jti, userID, issuedAt).Scan(&revoked); err != nil {
+		return false, fmt.Errorf("failed to check token revocation: %w", err)
+	}
+	return revoked, nil
+}
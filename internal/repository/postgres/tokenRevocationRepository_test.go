@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+func TestTokenRevocationRepository(t *testing.T) {
+	cleanDB(t, "revoked_tokens", "user_token_revocations", "users")
+	ctx := context.Background()
+
+	userRepo := NewUserRepository(testDB)
+	savedUser, err := userRepo.Save(ctx, &domain.User{Name: "Revoked User", Email: "revoked@example.com", Password: "password"})
+	assert.NoError(t, err)
+
+	repo := NewTokenRevocationRepository(testDB)
+	now := time.Now()
+
+	t.Run("jti 로 토큰 폐기", func(t *testing.T) {
+		assert.NoError(t, repo.RevokeToken(ctx, "jti-1", now.Add(time.Hour)))
+		// 같은 jti 를 다시 폐기해도 에러가 나지 않는다
+		assert.NoError(t, repo.RevokeToken(ctx, "jti-1", now.Add(time.Hour)))
+
+		revoked, err := repo.IsRevoked(ctx, "jti-1", savedUser.ID, now)
+		assert.NoError(t, err)
+		assert.True(t, revoked)
+
+		revoked, err = repo.IsRevoked(ctx, "jti-2", savedUser.ID, now)
+		assert.NoError(t, err)
+		assert.False(t, revoked)
+	})
+
+	t.Run("사용자의 모든 토큰 폐기", func(t *testing.T) {
+		assert.NoError(t, repo.RevokeUserTokens(ctx, savedUser.ID, now, now.Add(time.Hour)))
+
+		revoked, err := repo.IsRevoked(ctx, "old-jti", savedUser.ID, now.Add(-time.Minute))
+		assert.NoError(t, err)
+		assert.True(t, revoked)
+
+		revoked, err = repo.IsRevoked(ctx, "new-jti", savedUser.ID, now.Add(time.Minute))
+		assert.NoError(t, err)
+		assert.False(t, revoked)
+	})
+}
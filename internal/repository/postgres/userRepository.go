@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/lib/pq"
@@ -12,6 +13,9 @@ import (
 	"github.com/nicewook/gocore/internal/domain"
 )
 
+// userRolesColumn 은 user_roles 의 역할을 배열로 가져오는 컬럼이다. users 테이블과 함께 조회할 때 사용한다
+const userRolesColumn = "ARRAY(SELECT ur.role FROM user_roles ur WHERE ur.user_id = users.id ORDER BY ur.role) AS roles"
+
 type userRepository struct {
 	db *sql.DB
 }
@@ -23,18 +27,28 @@ func NewUserRepository(db *sql.DB) domain.UserRepository {
 }
 
 func (r *userRepository) Save(ctx context.Context, user *domain.User) (*domain.User, error) {
-	// 기본 역할이 없으면 User 역할 추가
+	return insertUser(ctx, r.db, user)
+}
+
+// insertUser 는 사용자와 역할을 한 트랜잭션으로 저장한다. 역할이 없으면 User 역할을 부여한다
+func insertUser(ctx context.Context, db *sql.DB, user *domain.User) (*domain.User, error) {
 	if len(user.Roles) == 0 {
 		user.Roles = []string{domain.RoleUser}
 	}
 
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
 	const query = `
-		INSERT INTO users (name, email, password, roles)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO users (name, email, password, verified_at, password_change_required)
+		VALUES ($1, $2, $3, $4, $5)
 		RETURNING id
 	`
-
-	if err := r.db.QueryRowContext(ctx, query, user.Name, user.Email, user.Password, user.RolesToString()).Scan(&user.ID); err != nil {
+	err = tx.QueryRowContext(ctx, query, user.Name, user.Email, user.Password, user.VerifiedAt, user.PasswordChangeRequired).Scan(&user.ID)
+	if err != nil {
 		// PostgreSQL의 unique_violation 에러 코드 (23505)
 		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
 			return nil, fmt.Errorf("email %s: %w", user.Email, domain.ErrAlreadyExists)
@@ -42,19 +56,36 @@ func (r *userRepository) Save(ctx context.Context, user *domain.User) (*domain.U
 		return nil, fmt.Errorf("failed to save user: %w", err)
 	}
 
+	for _, role := range user.Roles {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO user_roles (user_id, role) VALUES ($1, $2) ON CONFLICT DO NOTHING", user.ID, role); err != nil {
+			return nil, roleError(err, role)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to save user: %w", err)
+	}
 	return user, nil
 }
 
+// roleError 는 역할 저장 에러를 변환한다. roles 에 없는 역할이면 foreign_key_violation (23503) 이 발생한다
+func roleError(err error, role string) error {
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+		return fmt.Errorf("role %s: %w", role, domain.ErrUnknownRole)
+	}
+	return fmt.Errorf("failed to save user role: %w", err)
+}
+
 func (r *userRepository) GetByID(ctx context.Context, id int64) (*domain.User, error) {
 	query := `
-		SELECT id, name, email, roles
+		SELECT id, name, email, ` + userRolesColumn + `, verified_at, disabled_at, password_change_required
 		FROM users
-		WHERE id = $1
+		WHERE id = $1 AND deleted_at IS NULL
 	`
 
 	var user domain.User
-	var rolesStr string
-	err := r.db.QueryRowContext(ctx, query, id).Scan(&user.ID, &user.Name, &user.Email, &rolesStr)
+	var verifiedAt, disabledAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, query, id).Scan(&user.ID, &user.Name, &user.Email, pq.Array(&user.Roles), &verifiedAt, &disabledAt, &user.PasswordChangeRequired)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, domain.ErrNotFound
@@ -62,7 +93,8 @@ func (r *userRepository) GetByID(ctx context.Context, id int64) (*domain.User, e
 		return nil, errors.Wrap(err, "failed to get user by id")
 	}
 
-	user.Roles = domain.StringToRoles(rolesStr)
+	user.VerifiedAt = nullTimeToPtr(verifiedAt)
+	user.DisabledAt = nullTimeToPtr(disabledAt)
 	return &user, nil
 }
 
@@ -90,9 +122,13 @@ func (r *userRepository) GetAll(ctx context.Context, req *domain.GetAllUsersRequ
 	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
 	// 사용자 데이터 쿼리, 카운트 쿼리 빌더 생성
-	dataBuilder := psql.Select("id", "name", "email", "password", "roles").From("users")
+	dataBuilder := psql.Select("id", "name", "email", "password", userRolesColumn, "verified_at", "disabled_at", "password_change_required").From("users")
 	countBuilder := psql.Select("COUNT(*)").From("users")
 
+	// 삭제된 사용자는 목록에서 제외한다
+	dataBuilder = dataBuilder.Where(sq.Eq{"deleted_at": nil})
+	countBuilder = countBuilder.Where(sq.Eq{"deleted_at": nil})
+
 	// 필터 조건 적용
 	if req.Name != "" {
 		dataBuilder = dataBuilder.Where(sq.Like{"name": fmt.Sprintf("%%%s%%", req.Name)})
@@ -103,18 +139,15 @@ func (r *userRepository) GetAll(ctx context.Context, req *domain.GetAllUsersRequ
 		countBuilder = countBuilder.Where(sq.Like{"email": fmt.Sprintf("%%%s%%", req.Email)})
 	}
 
+	// 역할은 정확히 일치하는 경우만 찾는다. 여러 역할을 지정하면 하나라도 가진 사용자를 찾는다
 	rolesArray := req.GetRolesArray()
 	if len(rolesArray) > 0 {
-		roleConditions := make([]sq.Sqlizer, 0, len(rolesArray))
-		for _, role := range rolesArray {
-			if role != "" {
-				roleConditions = append(roleConditions, sq.Like{"roles": fmt.Sprintf("%%%s%%", role)})
-			}
-		}
-		if len(roleConditions) > 0 {
-			dataBuilder = dataBuilder.Where(sq.Or(roleConditions))
-			countBuilder = countBuilder.Where(sq.Or(roleConditions))
-		}
+		roleCondition := sq.Expr(
+			"EXISTS (SELECT 1 FROM user_roles ur WHERE ur.user_id = users.id AND ur.role = ANY(?))",
+			pq.Array(rolesArray),
+		)
+		dataBuilder = dataBuilder.Where(roleCondition)
+		countBuilder = countBuilder.Where(roleCondition)
 	}
 
 	// 카운트 쿼리 실행
@@ -181,15 +214,16 @@ func scanUsers(rows *sql.Rows) ([]domain.User, error) {
 
 	for rows.Next() {
 		var user domain.User
-		var rolesStr string
 		var password string
+		var verifiedAt, disabledAt sql.NullTime
 
-		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &password, &rolesStr); err != nil {
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &password, pq.Array(&user.Roles), &verifiedAt, &disabledAt, &user.PasswordChangeRequired); err != nil {
 			return nil, errors.Wrap(err, "사용자 스캔 실패")
 		}
 
 		user.Password = password
-		user.Roles = domain.StringToRoles(rolesStr)
+		user.VerifiedAt = nullTimeToPtr(verifiedAt)
+		user.DisabledAt = nullTimeToPtr(disabledAt)
 		users = append(users, user)
 	}
 
@@ -202,14 +236,14 @@ func scanUsers(rows *sql.Rows) ([]domain.User, error) {
 
 func (r *userRepository) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
 	const query = `
-		SELECT id, name, email, password, roles
+		SELECT id, name, email, password, ` + userRolesColumn + `, verified_at, disabled_at, password_change_required
 		FROM users
-		WHERE email = $1
+		WHERE email = $1 AND deleted_at IS NULL
 	`
 
 	user := &domain.User{}
-	var rolesStr string
-	err := r.db.QueryRowContext(ctx, query, email).Scan(&user.ID, &user.Name, &user.Email, &user.Password, &rolesStr)
+	var verifiedAt, disabledAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, query, email).Scan(&user.ID, &user.Name, &user.Email, &user.Password, pq.Array(&user.Roles), &verifiedAt, &disabledAt, &user.PasswordChangeRequired)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, domain.ErrNotFound
@@ -217,6 +251,264 @@ func (r *userRepository) GetUserByEmail(ctx context.Context, email string) (*dom
 		return nil, fmt.Errorf("failed to get user by email: %w", err)
 	}
 
-	user.Roles = domain.StringToRoles(rolesStr)
+	user.VerifiedAt = nullTimeToPtr(verifiedAt)
+	user.DisabledAt = nullTimeToPtr(disabledAt)
 	return user, nil
 }
+
+func (r *userRepository) Update(ctx context.Context, user *domain.User) error {
+	const query = `
+		UPDATE users
+		SET name = $1, email = $2, verified_at = $3
+		WHERE id = $4 AND deleted_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, user.Name, user.Email, user.VerifiedAt, user.ID)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return fmt.Errorf("email %s: %w", user.Email, domain.ErrAlreadyExists)
+		}
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *userRepository) UpdatePassword(ctx context.Context, id int64, hashedPassword string) error {
+	const query = `
+		UPDATE users
+		SET password = $1, password_change_required = FALSE
+		WHERE id = $2 AND deleted_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, hashedPassword, id)
+	if err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *userRepository) MarkVerified(ctx context.Context, id int64) error {
+	const query = `
+		UPDATE users
+		SET verified_at = COALESCE(verified_at, NOW())
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to mark user verified: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to mark user verified: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *userRepository) SetDisabled(ctx context.Context, id int64, disabled bool) error {
+	// 이미 비활성화된 사용자는 처음 비활성화한 시각을 유지한다
+	const query = `
+		UPDATE users
+		SET disabled_at = CASE WHEN $1 THEN COALESCE(disabled_at, NOW()) ELSE NULL END
+		WHERE id = $2 AND deleted_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, disabled, id)
+	if err != nil {
+		return fmt.Errorf("failed to set user disabled: %w", err)
+	}
+	return requireAffected(result, "failed to set user disabled")
+}
+
+func (r *userRepository) SoftDelete(ctx context.Context, id int64) (time.Time, error) {
+	const query = `
+		UPDATE users
+		SET deleted_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING deleted_at
+	`
+
+	var deletedAt time.Time
+	if err := r.db.QueryRowContext(ctx, query, id).Scan(&deletedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return time.Time{}, domain.ErrNotFound
+		}
+		return time.Time{}, fmt.Errorf("failed to delete user: %w", err)
+	}
+	return deletedAt, nil
+}
+
+func (r *userRepository) Restore(ctx context.Context, id int64) error {
+	const query = `
+		UPDATE users
+		SET deleted_at = NULL
+		WHERE id = $1 AND deleted_at IS NOT NULL AND erased_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to restore user: %w", err)
+	}
+	return requireAffected(result, "failed to restore user")
+}
+
+// eraseTables 는 개인정보를 지울 때 함께 삭제하는 사용자의 인증 관련 데이터이다. 주문(orders)은 남긴다
+var eraseTables = []string{
+	"user_roles",
+	"refresh_tokens",
+	"sessions",
+	"one_time_tokens",
+	"mfa_recovery_codes",
+	"user_mfa",
+	"api_keys",
+	"user_token_revocations",
+}
+
+func (r *userRepository) EraseDeleted(ctx context.Context, deletedBefore time.Time) ([]int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// 이메일은 UNIQUE 이므로 사용자 ID 로 만든 주소를 사용하고, 비밀번호는 어떤 해시와도 일치하지 않는 빈 값으로 둔다
+	const query = `
+		UPDATE users
+		SET name = 'Deleted User',
+			email = 'erased-' || id || '@invalid',
+			password = '',
+			verified_at = NULL,
+			disabled_at = NULL,
+			erased_at = NOW()
+		WHERE deleted_at < $1 AND erased_at IS NULL
+		RETURNING id
+	`
+	rows, err := tx.QueryContext(ctx, query, deletedBefore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to erase users: %w", err)
+	}
+	ids := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan erased user: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate erased users: %w", err)
+	}
+	if len(ids) == 0 {
+		return ids, nil
+	}
+
+	for _, table := range eraseTables {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM "+table+" WHERE user_id = ANY($1)", pq.Array(ids)); err != nil {
+			return nil, fmt.Errorf("failed to erase %s: %w", table, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to erase users: %w", err)
+	}
+	return ids, nil
+}
+
+func (r *userRepository) GetAllRoles(ctx context.Context) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT name FROM roles ORDER BY name")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roles: %w", err)
+	}
+	defer rows.Close()
+
+	roles := []string{}
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, fmt.Errorf("failed to scan role: %w", err)
+		}
+		roles = append(roles, role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate roles: %w", err)
+	}
+	return roles, nil
+}
+
+func (r *userRepository) GrantRole(ctx context.Context, userID int64, role string) error {
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM users WHERE id = $1 AND deleted_at IS NULL)", userID).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check user: %w", err)
+	}
+	if !exists {
+		return domain.ErrNotFound
+	}
+
+	const query = `
+		INSERT INTO user_roles (user_id, role)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := r.db.ExecContext(ctx, query, userID, role); err != nil {
+		return roleError(err, role)
+	}
+	return nil
+}
+
+func (r *userRepository) RevokeRole(ctx context.Context, userID int64, role string) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM user_roles WHERE user_id = $1 AND role = $2", userID, role)
+	if err != nil {
+		return fmt.Errorf("failed to revoke role: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to revoke role: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// requireAffected 는 변경된 행이 없으면 ErrNotFound 를 반환한다
+func requireAffected(result sql.Result, message string) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", message, err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// nullTimeToPtr 은 NULL 허용 시각 컬럼을 *time.Time 으로 변환한다
+func nullTimeToPtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
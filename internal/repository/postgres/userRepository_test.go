@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -147,6 +148,30 @@ func TestGetAllUsers(t *testing.T) {
 		assert.False(t, page2Response.HasMore)
 	})
 
+	t.Run("역할은 정확히 일치하는 경우만 필터링", func(t *testing.T) {
+		cleanDB(t, "users")
+		_, err := testDB.Exec("INSERT INTO roles (name) VALUES ('SuperUser') ON CONFLICT DO NOTHING")
+		assert.NoError(t, err)
+
+		_, err = repo.Save(ctx, &domain.User{Name: "Plain User", Email: "user@example.com", Roles: []string{domain.RoleUser}})
+		assert.NoError(t, err)
+		_, err = repo.Save(ctx, &domain.User{Name: "Super User", Email: "super@example.com", Roles: []string{"SuperUser"}})
+		assert.NoError(t, err)
+		_, err = repo.Save(ctx, &domain.User{Name: "Manager", Email: "manager@example.com", Roles: []string{domain.RoleManager, domain.RoleUser}})
+		assert.NoError(t, err)
+
+		response, err := repo.GetAll(ctx, &domain.GetAllUsersRequest{Roles: domain.RoleUser, Limit: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), response.TotalCount)
+		for _, user := range response.Users {
+			assert.Contains(t, user.Roles, domain.RoleUser)
+		}
+
+		response, err = repo.GetAll(ctx, &domain.GetAllUsersRequest{Roles: "SuperUser,Manager", Limit: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), response.TotalCount)
+	})
+
 	t.Run("사용자가 없을 때 빈 결과 반환", func(t *testing.T) {
 		cleanDB(t, "users") // 데이터 초기화
 
@@ -197,3 +222,256 @@ func TestGetUserByEmail(t *testing.T) {
 		assert.Nil(t, fetchedUser)
 	})
 }
+
+func TestUpdateUser(t *testing.T) {
+	repo := NewUserRepository(testDB)
+	cleanDB(t, "users")
+	ctx := context.Background()
+
+	t.Run("이름과 이메일 변경 성공", func(t *testing.T) {
+		savedUser, err := repo.Save(ctx, &domain.User{Name: "Profile User", Email: "profile@example.com", Password: "hash"})
+		assert.NoError(t, err)
+		assert.NoError(t, repo.MarkVerified(ctx, savedUser.ID))
+
+		// 이메일을 바꾸면 인증 시각도 초기화한다
+		savedUser.Name = "Renamed User"
+		savedUser.Email = "renamed@example.com"
+		savedUser.VerifiedAt = nil
+		err = repo.Update(ctx, savedUser)
+		assert.NoError(t, err)
+
+		fetchedUser, err := repo.GetByID(ctx, savedUser.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "Renamed User", fetchedUser.Name)
+		assert.Equal(t, "renamed@example.com", fetchedUser.Email)
+		assert.False(t, fetchedUser.IsVerified())
+	})
+
+	t.Run("이미 사용 중인 이메일로 변경 시 실패", func(t *testing.T) {
+		_, err := repo.Save(ctx, &domain.User{Name: "Taken User", Email: "taken@example.com", Password: "hash"})
+		assert.NoError(t, err)
+		savedUser, err := repo.Save(ctx, &domain.User{Name: "Other User", Email: "other@example.com", Password: "hash"})
+		assert.NoError(t, err)
+
+		savedUser.Email = "taken@example.com"
+		err = repo.Update(ctx, savedUser)
+		assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+	})
+
+	t.Run("존재하지 않는 사용자 변경 시 실패", func(t *testing.T) {
+		err := repo.Update(ctx, &domain.User{ID: 9999, Name: "Nobody", Email: "nobody@example.com"})
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestUpdatePassword(t *testing.T) {
+	repo := NewUserRepository(testDB)
+	cleanDB(t, "users")
+	ctx := context.Background()
+
+	t.Run("비밀번호 변경 성공", func(t *testing.T) {
+		savedUser, err := repo.Save(ctx, &domain.User{Name: "Password User", Email: "password@example.com", Password: "old-hash"})
+		assert.NoError(t, err)
+
+		err = repo.UpdatePassword(ctx, savedUser.ID, "new-hash")
+		assert.NoError(t, err)
+
+		fetchedUser, err := repo.GetUserByEmail(ctx, savedUser.Email)
+		assert.NoError(t, err)
+		assert.Equal(t, "new-hash", fetchedUser.Password)
+	})
+
+	t.Run("비밀번호를 바꾸면 변경 요구가 해제된다", func(t *testing.T) {
+		verifiedAt := time.Now()
+		savedUser, err := repo.Save(ctx, &domain.User{
+			Name:                   "Seeded Admin",
+			Email:                  "seeded@example.com",
+			Password:               "old-hash",
+			Roles:                  []string{domain.RoleAdmin},
+			VerifiedAt:             &verifiedAt,
+			PasswordChangeRequired: true,
+		})
+		assert.NoError(t, err)
+
+		fetchedUser, err := repo.GetUserByEmail(ctx, savedUser.Email)
+		assert.NoError(t, err)
+		assert.True(t, fetchedUser.PasswordChangeRequired)
+		assert.True(t, fetchedUser.IsVerified())
+
+		assert.NoError(t, repo.UpdatePassword(ctx, savedUser.ID, "new-hash"))
+		fetchedUser, err = repo.GetUserByEmail(ctx, savedUser.Email)
+		assert.NoError(t, err)
+		assert.False(t, fetchedUser.PasswordChangeRequired)
+	})
+
+	t.Run("존재하지 않는 사용자의 비밀번호 변경 시 실패", func(t *testing.T) {
+		err := repo.UpdatePassword(ctx, 9999, "new-hash")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestMarkVerified(t *testing.T) {
+	repo := NewUserRepository(testDB)
+	cleanDB(t, "users")
+	ctx := context.Background()
+
+	t.Run("이메일 인증 처리 성공", func(t *testing.T) {
+		savedUser, err := repo.Save(ctx, &domain.User{Name: "Verify User", Email: "verify@example.com", Password: "hash"})
+		assert.NoError(t, err)
+
+		fetchedUser, err := repo.GetByID(ctx, savedUser.ID)
+		assert.NoError(t, err)
+		assert.False(t, fetchedUser.IsVerified())
+
+		err = repo.MarkVerified(ctx, savedUser.ID)
+		assert.NoError(t, err)
+
+		fetchedUser, err = repo.GetByID(ctx, savedUser.ID)
+		assert.NoError(t, err)
+		assert.True(t, fetchedUser.IsVerified())
+
+		// 이미 인증된 사용자는 최초 인증 시각을 유지한다
+		firstVerifiedAt := *fetchedUser.VerifiedAt
+		err = repo.MarkVerified(ctx, savedUser.ID)
+		assert.NoError(t, err)
+
+		fetchedUser, err = repo.GetByID(ctx, savedUser.ID)
+		assert.NoError(t, err)
+		assert.True(t, firstVerifiedAt.Equal(*fetchedUser.VerifiedAt))
+	})
+
+	t.Run("존재하지 않는 사용자의 인증 처리 시 실패", func(t *testing.T) {
+		err := repo.MarkVerified(ctx, 9999)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestUserRoles(t *testing.T) {
+	repo := NewUserRepository(testDB)
+	cleanDB(t, "users")
+	ctx := context.Background()
+
+	user, err := repo.Save(ctx, &domain.User{Name: "Role User", Email: "role@example.com", Password: "hashed"})
+	assert.NoError(t, err)
+
+	t.Run("등록된 역할 목록", func(t *testing.T) {
+		roles, err := repo.GetAllRoles(ctx)
+		assert.NoError(t, err)
+		assert.Subset(t, roles, []string{domain.RoleAdmin, domain.RoleManager, domain.RoleUser})
+	})
+
+	t.Run("역할 부여 및 회수", func(t *testing.T) {
+		assert.NoError(t, repo.GrantRole(ctx, user.ID, domain.RoleManager))
+		// 이미 가진 역할은 다시 부여해도 에러가 없다
+		assert.NoError(t, repo.GrantRole(ctx, user.ID, domain.RoleManager))
+
+		fetched, err := repo.GetByID(ctx, user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{domain.RoleManager, domain.RoleUser}, fetched.Roles)
+
+		assert.NoError(t, repo.RevokeRole(ctx, user.ID, domain.RoleManager))
+		assert.ErrorIs(t, repo.RevokeRole(ctx, user.ID, domain.RoleManager), domain.ErrNotFound)
+
+		fetched, err = repo.GetByID(ctx, user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{domain.RoleUser}, fetched.Roles)
+	})
+
+	t.Run("등록되지 않은 역할", func(t *testing.T) {
+		assert.ErrorIs(t, repo.GrantRole(ctx, user.ID, "Unknown"), domain.ErrUnknownRole)
+
+		_, err := repo.Save(ctx, &domain.User{Name: "Bad Role", Email: "bad@example.com", Password: "hashed", Roles: []string{"Unknown"}})
+		assert.ErrorIs(t, err, domain.ErrUnknownRole)
+	})
+
+	t.Run("없는 사용자", func(t *testing.T) {
+		assert.ErrorIs(t, repo.GrantRole(ctx, 9999, domain.RoleUser), domain.ErrNotFound)
+	})
+}
+
+func TestUserAccountLifecycle(t *testing.T) {
+	repo := NewUserRepository(testDB)
+	cleanDB(t, "users", "products", "orders")
+	ctx := context.Background()
+
+	user, err := repo.Save(ctx, &domain.User{Name: "John Doe", Email: "john@example.com", Password: "hashed"})
+	assert.NoError(t, err)
+
+	t.Run("비활성화 및 활성화", func(t *testing.T) {
+		assert.NoError(t, repo.SetDisabled(ctx, user.ID, true))
+		fetched, err := repo.GetByID(ctx, user.ID)
+		assert.NoError(t, err)
+		assert.True(t, fetched.IsDisabled())
+
+		assert.NoError(t, repo.SetDisabled(ctx, user.ID, false))
+		fetched, err = repo.GetByID(ctx, user.ID)
+		assert.NoError(t, err)
+		assert.False(t, fetched.IsDisabled())
+
+		assert.ErrorIs(t, repo.SetDisabled(ctx, 9999, true), domain.ErrNotFound)
+	})
+
+	t.Run("삭제된 사용자는 조회되지 않는다", func(t *testing.T) {
+		_, err := repo.SoftDelete(ctx, user.ID)
+		assert.NoError(t, err)
+
+		_, err = repo.GetByID(ctx, user.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		_, err = repo.GetUserByEmail(ctx, user.Email)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		response, err := repo.GetAll(ctx, &domain.GetAllUsersRequest{Limit: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), response.TotalCount)
+
+		// 이미 삭제된 사용자는 다시 삭제할 수 없다
+		_, err = repo.SoftDelete(ctx, user.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("복구", func(t *testing.T) {
+		assert.NoError(t, repo.Restore(ctx, user.ID))
+		_, err := repo.GetByID(ctx, user.ID)
+		assert.NoError(t, err)
+
+		// 삭제되지 않은 사용자는 복구할 수 없다
+		assert.ErrorIs(t, repo.Restore(ctx, user.ID), domain.ErrNotFound)
+	})
+
+	t.Run("개인정보를 지워도 주문은 남는다", func(t *testing.T) {
+		var productID, orderID int64
+		err := testDB.QueryRow("INSERT INTO products (name, price_in_krw) VALUES ('Book', 10000) RETURNING id").Scan(&productID)
+		assert.NoError(t, err)
+		err = testDB.QueryRow("INSERT INTO orders (user_id, product_id, quantity, total_price_in_krw) VALUES ($1, $2, 1, 10000) RETURNING id", user.ID, productID).Scan(&orderID)
+		assert.NoError(t, err)
+
+		_, err = repo.SoftDelete(ctx, user.ID)
+		assert.NoError(t, err)
+
+		// 유예 기간이 지나지 않은 사용자는 지우지 않는다
+		erased, err := repo.EraseDeleted(ctx, time.Now().Add(-time.Hour))
+		assert.NoError(t, err)
+		assert.Empty(t, erased)
+
+		erased, err = repo.EraseDeleted(ctx, time.Now().Add(time.Second))
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{user.ID}, erased)
+
+		var name, email, password string
+		err = testDB.QueryRow("SELECT name, email, password FROM users WHERE id = $1", user.ID).Scan(&name, &email, &password)
+		assert.NoError(t, err)
+		assert.Equal(t, "Deleted User", name)
+		assert.NotEqual(t, user.Email, email)
+		assert.Empty(t, password)
+
+		var roleCount, orderCount int
+		assert.NoError(t, testDB.QueryRow("SELECT COUNT(*) FROM user_roles WHERE user_id = $1", user.ID).Scan(&roleCount))
+		assert.NoError(t, testDB.QueryRow("SELECT COUNT(*) FROM orders WHERE id = $1", orderID).Scan(&orderCount))
+		assert.Equal(t, 0, roleCount)
+		assert.Equal(t, 1, orderCount)
+
+		// 개인정보를 지운 사용자는 복구할 수 없고, 이메일은 다시 가입에 사용할 수 있다
+		assert.ErrorIs(t, repo.Restore(ctx, user.ID), domain.ErrNotFound)
+		_, err = repo.Save(ctx, &domain.User{Name: "John Again", Email: user.Email, Password: "hashed"})
+		assert.NoError(t, err)
+	})
+}
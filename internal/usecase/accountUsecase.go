@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/pkg/contextutil"
+)
+
+// 계정의 비활성화, 삭제, 개인정보 삭제(erase)
+// 비활성화는 관리자가 로그인을 막는 것이고 언제든 되돌릴 수 있다.
+// 삭제는 사용자가 요청하며, 유예 기간 동안은 관리자가 복구할 수 있고 이후 EraseDeletedUsers 가 개인정보를 지운다.
+
+// defaultDeletionGracePeriod 는 account_deletion.grace_period_day 를 설정하지 않았을 때의 유예 기간이다
+const defaultDeletionGracePeriod = 30 * 24 * time.Hour
+
+// DisableUser 사용자를 비활성화하고 모든 토큰을 폐기한다. 자기 자신은 비활성화할 수 없다
+func (uc *authUseCase) DisableUser(ctx context.Context, userID int64) error {
+	if principal, ok := contextutil.PrincipalFrom(ctx); ok && principal.UserID == userID {
+		return domain.ErrForbidden
+	}
+
+	if err := uc.userRepo.SetDisabled(ctx, userID, true); err != nil {
+		return err
+	}
+	if err := uc.RevokeUserTokens(ctx, userID); err != nil {
+		return err
+	}
+
+	uc.logAccountChange(ctx, "User disabled", userID)
+	return nil
+}
+
+// EnableUser 비활성화된 사용자를 다시 활성화한다
+func (uc *authUseCase) EnableUser(ctx context.Context, userID int64) error {
+	if err := uc.userRepo.SetDisabled(ctx, userID, false); err != nil {
+		return err
+	}
+
+	uc.logAccountChange(ctx, "User enabled", userID)
+	return nil
+}
+
+// RestoreUser 삭제를 요청한 사용자를 복구한다. 개인정보가 이미 지워졌으면 ErrNotFound 를 반환한다
+// 삭제할 때 모든 토큰을 폐기했으므로 복구된 사용자는 다시 로그인해야 한다
+func (uc *authUseCase) RestoreUser(ctx context.Context, userID int64) error {
+	if err := uc.userRepo.Restore(ctx, userID); err != nil {
+		return err
+	}
+
+	uc.logAccountChange(ctx, "User restored", userID)
+	return nil
+}
+
+// DeleteAccount 비밀번호를 확인하고 계정을 삭제 상태로 바꾼다
+// 삭제된 계정은 바로 조회되지 않으므로 로그인, 토큰 갱신, API 키를 사용할 수 없다.
+// 비밀번호 확인 실패는 로그인 실패와 같이 횟수를 제한하고, 대리 로그인 중에는 삭제할 수 없다
+func (uc *authUseCase) DeleteAccount(ctx context.Context, userID int64, req *domain.DeleteAccountRequest) (*domain.DeleteAccountResponse, error) {
+	if err := forbidImpersonation(ctx); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.confirmPassword(ctx, user, req.Password); err != nil {
+		return nil, err
+	}
+
+	// RevokeUserTokens 는 사용자를 조회하므로 삭제 표시 전에 호출한다
+	if err := uc.RevokeUserTokens(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	deletedAt, err := uc.userRepo.SoftDelete(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	eraseAfter := deletedAt.Add(uc.deletionGracePeriod())
+	contextutil.GetLogger(ctx).Info("Account deletion requested",
+		slog.Int64("user_id", user.ID),
+		slog.Time("erase_after", eraseAfter),
+	)
+	return &domain.DeleteAccountResponse{DeletedAt: deletedAt, EraseAfter: eraseAfter}, nil
+}
+
+// EraseDeletedUsers 유예 기간이 지난 삭제된 사용자의 개인정보를 익명화한다. 주문 기록은 남는다
+func (uc *authUseCase) EraseDeletedUsers(ctx context.Context) (int, error) {
+	ids, err := uc.userRepo.EraseDeleted(ctx, time.Now().Add(-uc.deletionGracePeriod()))
+	if err != nil {
+		return 0, err
+	}
+
+	logger := contextutil.GetLogger(ctx)
+	for _, id := range ids {
+		logger.Info("User erased", slog.Int64("user_id", id))
+	}
+	return len(ids), nil
+}
+
+// checkAccountActive 는 비활성화된 사용자면 ErrAccountDisabled 를 반환한다. 토큰을 발급하기 전에 확인한다
+func checkAccountActive(user *domain.User) error {
+	if user.IsDisabled() {
+		return domain.ErrAccountDisabled
+	}
+	return nil
+}
+
+// logAccountChange 는 관리자가 바꾼 계정 상태를 요청한 관리자와 함께 기록한다
+func (uc *authUseCase) logAccountChange(ctx context.Context, message string, userID int64) {
+	attrs := []any{slog.Int64("user_id", userID)}
+	if principal, ok := contextutil.PrincipalFrom(ctx); ok {
+		attrs = append(attrs, slog.Int64("actor_id", principal.UserID))
+	}
+	contextutil.GetLogger(ctx).Info(message, attrs...)
+}
+
+func (uc *authUseCase) deletionGracePeriod() time.Duration {
+	if days := uc.config.Secure.AccountDeletion.GracePeriodDay; days > 0 {
+		return time.Duration(days) * 24 * time.Hour
+	}
+	return defaultDeletionGracePeriod
+}
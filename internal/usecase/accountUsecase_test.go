@@ -0,0 +1,194 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicewook/gocore/internal/config"
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/internal/domain/mocks"
+	"github.com/nicewook/gocore/internal/usecase"
+	"github.com/nicewook/gocore/pkg/contextutil"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+func TestDisableUser(t *testing.T) {
+	cfg := &config.Config{
+		Secure: config.SecureConfig{
+			JWT: config.JWTConfig{AccessExpirationMin: 15, RefreshExpirationDay: 7},
+		},
+	}
+	admin := &contextutil.Principal{UserID: 9, Roles: []string{domain.RoleAdmin}}
+
+	tests := []struct {
+		name         string
+		userID       int64
+		mockErr      error
+		expectRevoke bool
+		expectErr    error
+	}{
+		{
+			name:         "Success Revokes Tokens",
+			userID:       1,
+			expectRevoke: true,
+		},
+		{
+			name:      "User Not Found",
+			userID:    2,
+			mockErr:   domain.ErrNotFound,
+			expectErr: domain.ErrNotFound,
+		},
+		{
+			name:      "Self",
+			userID:    9,
+			expectErr: domain.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := new(mocks.UserRepository)
+			mockRevocationRepo := new(mocks.TokenRevocationRepository)
+			mockRefreshTokenRepo := new(mocks.RefreshTokenRepository)
+
+			if tt.expectRevoke || tt.mockErr != nil {
+				mockUserRepo.On("SetDisabled", mock.Anything, tt.userID, true).Return(tt.mockErr)
+			}
+			if tt.expectRevoke {
+				mockUserRepo.On("GetByID", mock.Anything, tt.userID).Return(&domain.User{ID: tt.userID}, nil)
+				mockRevocationRepo.On("RevokeUserTokens", mock.Anything, tt.userID, mock.Anything, mock.Anything).Return(nil)
+				mockRefreshTokenRepo.On("RevokeAllByUserID", mock.Anything, tt.userID).Return(nil)
+			}
+
+			uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, mockRefreshTokenRepo, mockRevocationRepo, new(mocks.OneTimeTokenRepository), new(mocks.MFARepository), new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), nil, nil, nil, cfg)
+
+			ctx := contextutil.WithPrincipal(context.Background(), admin)
+			err := uc.DisableUser(ctx, tt.userID)
+
+			assert.ErrorIs(t, err, tt.expectErr)
+			mockUserRepo.AssertExpectations(t)
+			mockRevocationRepo.AssertExpectations(t)
+			mockRefreshTokenRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteAccount(t *testing.T) {
+	cfg := &config.Config{
+		Secure: config.SecureConfig{
+			JWT: config.JWTConfig{AccessExpirationMin: 15, RefreshExpirationDay: 7},
+			LoginThrottle: config.LoginThrottleConfig{
+				MaxFailures:    5,
+				WindowMin:      15,
+				BaseLockoutSec: 30,
+				MaxLockoutMin:  60,
+			},
+			AccountDeletion: config.AccountDeletionConfig{GracePeriodDay: 14},
+		},
+	}
+
+	hashedPassword, err := security.GeneratePasswordHash("password", nil)
+	assert.NoError(t, err)
+	user := &domain.User{ID: 1, Email: "john@example.com", Roles: []string{domain.RoleUser}}
+	deletedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		password     string
+		impersonated bool
+		expectDelete bool
+		expectErr    error
+	}{
+		{
+			name:         "Success",
+			password:     "password",
+			expectDelete: true,
+		},
+		{
+			name:      "Incorrect Password",
+			password:  "wrongpassword",
+			expectErr: domain.ErrIncorrectPassword,
+		},
+		{
+			name:         "Impersonated",
+			password:     "password",
+			impersonated: true,
+			expectErr:    domain.ErrImpersonated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := new(mocks.UserRepository)
+			mockRevocationRepo := new(mocks.TokenRevocationRepository)
+			mockRefreshTokenRepo := new(mocks.RefreshTokenRepository)
+			mockAttemptRepo := new(mocks.LoginAttemptRepository)
+
+			if !tt.impersonated {
+				mockUserRepo.On("GetByID", mock.Anything, user.ID).Return(user, nil)
+				mockAttemptRepo.On("LockedUntil", mock.Anything, "email:john@example.com").Return(time.Time{}, nil)
+				mockUserRepo.On("GetUserByEmail", mock.Anything, user.Email).
+					Return(&domain.User{ID: user.ID, Email: user.Email, Password: hashedPassword}, nil)
+			}
+			if tt.expectErr == domain.ErrIncorrectPassword {
+				mockAttemptRepo.On("RecordFailure", mock.Anything, "email:john@example.com", 15*time.Minute).Return(1, nil)
+			}
+			if tt.expectDelete {
+				// 삭제 전에 모든 토큰을 폐기한다
+				mockRevocationRepo.On("RevokeUserTokens", mock.Anything, user.ID, mock.Anything, mock.Anything).Return(nil)
+				mockRefreshTokenRepo.On("RevokeAllByUserID", mock.Anything, user.ID).Return(nil)
+				mockUserRepo.On("SoftDelete", mock.Anything, user.ID).Return(deletedAt, nil)
+			}
+
+			uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, mockRefreshTokenRepo, mockRevocationRepo, new(mocks.OneTimeTokenRepository), new(mocks.MFARepository), mockAttemptRepo, newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), nil, nil, nil, cfg)
+
+			principal := &contextutil.Principal{UserID: user.ID}
+			if tt.impersonated {
+				principal.ActorID = 9
+			}
+			ctx := contextutil.WithPrincipal(context.Background(), principal)
+			response, err := uc.DeleteAccount(ctx, user.ID, &domain.DeleteAccountRequest{Password: tt.password})
+
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+				assert.Nil(t, response)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, deletedAt, response.DeletedAt)
+				assert.Equal(t, deletedAt.Add(14*24*time.Hour), response.EraseAfter)
+			}
+
+			mockUserRepo.AssertExpectations(t)
+			mockRevocationRepo.AssertExpectations(t)
+			mockRefreshTokenRepo.AssertExpectations(t)
+			mockAttemptRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEraseDeletedUsers(t *testing.T) {
+	cfg := &config.Config{
+		Secure: config.SecureConfig{
+			AccountDeletion: config.AccountDeletionConfig{GracePeriodDay: 30},
+		},
+	}
+
+	mockUserRepo := new(mocks.UserRepository)
+	// 유예 기간(30일)보다 먼저 삭제된 사용자만 지운다
+	mockUserRepo.On("EraseDeleted", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+		expected := time.Now().Add(-30 * 24 * time.Hour)
+		return before.Sub(expected).Abs() < time.Minute
+	})).Return([]int64{3, 7}, nil)
+
+	uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, new(mocks.RefreshTokenRepository), new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), new(mocks.MFARepository), new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), nil, nil, nil, cfg)
+
+	erased, err := uc.EraseDeletedUsers(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, erased)
+	mockUserRepo.AssertExpectations(t)
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/pkg/contextutil"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+const (
+	// apiKeyPrefix 는 API 키 원문의 접두사로, 로그나 코드에 노출된 키를 찾기 쉽게 한다
+	apiKeyPrefix = "gk_"
+	// apiKeyDisplayLength 는 목록에서 키를 구분하기 위해 저장하는 원문 앞부분의 길이이다
+	apiKeyDisplayLength = len(apiKeyPrefix) + 8
+)
+
+type apiKeyUseCase struct {
+	apiKeyRepo domain.APIKeyRepository
+	userRepo   domain.UserRepository
+}
+
+func NewAPIKeyUseCase(apiKeyRepo domain.APIKeyRepository, userRepo domain.UserRepository) domain.APIKeyUseCase {
+	return &apiKeyUseCase{
+		apiKeyRepo: apiKeyRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// Create 새 API 키를 발급한다. 키 원문은 응답에서만 확인할 수 있다
+// scope 를 지정하지 않으면 사용자의 현재 역할을 모두 사용한다
+func (uc *apiKeyUseCase) Create(ctx context.Context, userID int64, req *domain.CreateAPIKeyRequest) (*domain.CreateAPIKeyResponse, error) {
+	// 대리 로그인한 관리자가 대리 로그인이 끝난 뒤에도 사용자로 인증할 수 있는 키를 만들지 못하게 한다
+	if err := forbidImpersonation(ctx); err != nil {
+		return nil, err
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := req.Scopes
+	if len(scopes) == 0 {
+		scopes = user.Roles
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(user.Roles, scope) {
+			return nil, domain.ErrInvalidScope
+		}
+	}
+
+	token, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+	rawKey := apiKeyPrefix + token
+
+	key, err := uc.apiKeyRepo.Save(ctx, &domain.APIKey{
+		UserID:    userID,
+		Name:      req.Name,
+		Prefix:    rawKey[:apiKeyDisplayLength],
+		KeyHash:   security.HashToken(rawKey),
+		Scopes:    slices.Compact(slices.Sorted(slices.Values(scopes))),
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	contextutil.GetLogger(ctx).Info("API key created",
+		slog.Int64("user_id", userID),
+		slog.Int64("api_key_id", key.ID),
+	)
+	return &domain.CreateAPIKeyResponse{APIKey: key, Key: rawKey}, nil
+}
+
+// List 사용자의 API 키 목록을 반환한다
+func (uc *apiKeyUseCase) List(ctx context.Context, userID int64) ([]*domain.APIKey, error) {
+	return uc.apiKeyRepo.ListByUserID(ctx, userID)
+}
+
+// Revoke 사용자의 API 키를 삭제한다. 다른 사용자의 키이거나 없는 키이면 ErrNotFound 를 반환한다
+func (uc *apiKeyUseCase) Revoke(ctx context.Context, userID int64, id int64) error {
+	if err := uc.apiKeyRepo.Delete(ctx, userID, id); err != nil {
+		return err
+	}
+
+	contextutil.GetLogger(ctx).Info("API key revoked",
+		slog.Int64("user_id", userID),
+		slog.Int64("api_key_id", id),
+	)
+	return nil
+}
+
+// Authenticate 키 원문으로 사용자를 인증한다
+// 키를 발급한 뒤 사용자의 역할이 줄었을 수 있으므로, 키의 scope 중 사용자가 현재도 가진 역할만 허용한다
+func (uc *apiKeyUseCase) Authenticate(ctx context.Context, rawKey string) (*domain.User, *domain.APIKey, error) {
+	if !strings.HasPrefix(rawKey, apiKeyPrefix) {
+		return nil, nil, domain.ErrInvalidAPIKey
+	}
+
+	key, err := uc.apiKeyRepo.GetByHash(ctx, security.HashToken(rawKey))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, nil, domain.ErrInvalidAPIKey
+		}
+		return nil, nil, err
+	}
+	if key.IsExpired(time.Now()) {
+		return nil, nil, domain.ErrInvalidAPIKey
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, key.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, nil, domain.ErrInvalidAPIKey
+		}
+		return nil, nil, err
+	}
+	// 삭제된 사용자는 조회되지 않고, 비활성화된 사용자의 키는 사용할 수 없다
+	if user.IsDisabled() {
+		return nil, nil, domain.ErrInvalidAPIKey
+	}
+
+	roles := make([]string, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		if slices.Contains(user.Roles, scope) {
+			roles = append(roles, scope)
+		}
+	}
+	user.Roles = roles
+
+	// 마지막 사용 시각 기록에 실패해도 요청은 계속 처리한다
+	if err := uc.apiKeyRepo.TouchLastUsed(ctx, key.ID); err != nil {
+		contextutil.GetLogger(ctx).Error("Failed to record api key usage",
+			slog.Int64("api_key_id", key.ID),
+			slog.String("err", err.Error()),
+		)
+	}
+	return user, key, nil
+}
@@ -0,0 +1,175 @@
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/internal/domain/mocks"
+	"github.com/nicewook/gocore/internal/usecase"
+	"github.com/nicewook/gocore/pkg/contextutil"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	user := &domain.User{ID: 1, Email: "john@example.com", Roles: []string{domain.RoleUser, domain.RoleManager}}
+
+	tests := []struct {
+		name           string
+		req            *domain.CreateAPIKeyRequest
+		impersonated   bool
+		expectedScopes []string
+		expectErr      error
+	}{
+		{
+			name:           "Defaults To All Roles",
+			req:            &domain.CreateAPIKeyRequest{Name: "batch"},
+			expectedScopes: []string{domain.RoleManager, domain.RoleUser},
+		},
+		{
+			name:           "Scope Subset",
+			req:            &domain.CreateAPIKeyRequest{Name: "batch", Scopes: []string{domain.RoleUser}},
+			expectedScopes: []string{domain.RoleUser},
+		},
+		{
+			name:      "Scope Not Held",
+			req:       &domain.CreateAPIKeyRequest{Name: "batch", Scopes: []string{domain.RoleAdmin}},
+			expectErr: domain.ErrInvalidScope,
+		},
+		{
+			name:      "Expiry In The Past",
+			req:       &domain.CreateAPIKeyRequest{Name: "batch", ExpiresAt: &past},
+			expectErr: domain.ErrInvalidInput,
+		},
+		{
+			name:         "Impersonated",
+			req:          &domain.CreateAPIKeyRequest{Name: "batch"},
+			impersonated: true,
+			expectErr:    domain.ErrImpersonated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAPIKeyRepo := new(mocks.APIKeyRepository)
+			mockUserRepo := new(mocks.UserRepository)
+
+			if tt.expectErr != domain.ErrInvalidInput && tt.expectErr != domain.ErrImpersonated {
+				mockUserRepo.On("GetByID", mock.Anything, int64(1)).Return(user, nil)
+			}
+			if tt.expectErr == nil {
+				mockAPIKeyRepo.On("Save", mock.Anything, mock.MatchedBy(func(key *domain.APIKey) bool {
+					return key.UserID == 1 && key.Name == "batch" && key.KeyHash != "" &&
+						strings.HasPrefix(key.Prefix, "gk_") && assert.ObjectsAreEqual(tt.expectedScopes, key.Scopes)
+				})).Return(func(_ context.Context, key *domain.APIKey) *domain.APIKey {
+					key.ID = 1
+					return key
+				}, nil)
+			}
+
+			uc := usecase.NewAPIKeyUseCase(mockAPIKeyRepo, mockUserRepo)
+
+			ctx := context.Background()
+			if tt.impersonated {
+				ctx = contextutil.WithPrincipal(ctx, &contextutil.Principal{UserID: 1, ActorID: 9})
+			}
+			result, err := uc.Create(ctx, 1, tt.req)
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				// 원문은 응답에서만 확인할 수 있고, 저장되는 값은 해시이다
+				assert.True(t, strings.HasPrefix(result.Key, result.Prefix))
+				assert.Equal(t, security.HashToken(result.Key), result.KeyHash)
+			}
+
+			mockAPIKeyRepo.AssertExpectations(t)
+			mockUserRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	const rawKey = "gk_test-key"
+	past := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name          string
+		rawKey        string
+		mockKey       *domain.APIKey
+		mockKeyErr    error
+		mockUser      *domain.User
+		expectedRoles []string
+		expectErr     error
+	}{
+		{
+			name:          "Success",
+			rawKey:        rawKey,
+			mockKey:       &domain.APIKey{ID: 7, UserID: 1, Scopes: []string{domain.RoleUser}},
+			mockUser:      &domain.User{ID: 1, Roles: []string{domain.RoleUser, domain.RoleManager}},
+			expectedRoles: []string{domain.RoleUser},
+		},
+		{
+			name:          "Scope Removed From User",
+			rawKey:        rawKey,
+			mockKey:       &domain.APIKey{ID: 7, UserID: 1, Scopes: []string{domain.RoleUser, domain.RoleManager}},
+			mockUser:      &domain.User{ID: 1, Roles: []string{domain.RoleUser}},
+			expectedRoles: []string{domain.RoleUser},
+		},
+		{
+			name:      "Missing Prefix",
+			rawKey:    "not-an-api-key",
+			expectErr: domain.ErrInvalidAPIKey,
+		},
+		{
+			name:       "Unknown Key",
+			rawKey:     rawKey,
+			mockKeyErr: domain.ErrNotFound,
+			expectErr:  domain.ErrInvalidAPIKey,
+		},
+		{
+			name:      "Expired Key",
+			rawKey:    rawKey,
+			mockKey:   &domain.APIKey{ID: 7, UserID: 1, Scopes: []string{domain.RoleUser}, ExpiresAt: &past},
+			expectErr: domain.ErrInvalidAPIKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAPIKeyRepo := new(mocks.APIKeyRepository)
+			mockUserRepo := new(mocks.UserRepository)
+
+			if tt.mockKey != nil || tt.mockKeyErr != nil {
+				mockAPIKeyRepo.On("GetByHash", mock.Anything, security.HashToken(rawKey)).Return(tt.mockKey, tt.mockKeyErr)
+			}
+			if tt.mockUser != nil {
+				mockUserRepo.On("GetByID", mock.Anything, int64(1)).Return(tt.mockUser, nil)
+				mockAPIKeyRepo.On("TouchLastUsed", mock.Anything, int64(7)).Return(nil)
+			}
+
+			uc := usecase.NewAPIKeyUseCase(mockAPIKeyRepo, mockUserRepo)
+
+			user, key, err := uc.Authenticate(context.Background(), tt.rawKey)
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+				assert.Nil(t, user)
+				assert.Nil(t, key)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedRoles, user.Roles)
+				assert.Equal(t, int64(7), key.ID)
+			}
+
+			mockAPIKeyRepo.AssertExpectations(t)
+			mockUserRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -2,96 +2,500 @@ package usecase
 
 import (
 	"context"
-	"crypto/rsa"
 	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/nicewook/gocore/internal/config"
 	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/pkg/contextutil"
 	"github.com/nicewook/gocore/pkg/security"
 )
 
 type authUseCase struct {
-	authRepo   domain.AuthRepository
-	userRepo   domain.UserRepository
-	config     *config.Config
-	privateKey *rsa.PrivateKey
-	publicKey  *rsa.PublicKey
+	authRepo         domain.AuthRepository
+	userRepo         domain.UserRepository
+	refreshTokenRepo domain.RefreshTokenRepository
+	revocationRepo   domain.TokenRevocationRepository
+	oneTimeTokenRepo domain.OneTimeTokenRepository
+	mfaRepo          domain.MFARepository
+	loginAttemptRepo domain.LoginAttemptRepository
+	sessionRepo      domain.SessionRepository
+	oauthClientRepo  domain.OAuthClientRepository
+	oidcProvider     domain.OIDCProvider // OIDC 로그인을 사용하지 않으면 nil
+	mailer           domain.Mailer
+	keyRing          *security.KeyRing
+	hashParams       *security.HashParams
+	passwordPolicy   *security.PasswordPolicy
+	config           *config.Config
+
+	// dummyPasswordHash 는 가입되지 않은 이메일로 로그인할 때 비교에 사용하는 해시이다
+	dummyPasswordHash func() string
 }
 
-func NewAuthUseCase(authRepo domain.AuthRepository, userRepo domain.UserRepository, config *config.Config) (domain.AuthUseCase, error) {
-	// RSA 키 파싱
-	privateKey, err := security.ParseRSAPrivateKeyFromPEM(config.Secure.JWT.PrivateKey)
-	if err != nil {
-		return nil, err
+func NewAuthUseCase(
+	authRepo domain.AuthRepository,
+	userRepo domain.UserRepository,
+	refreshTokenRepo domain.RefreshTokenRepository,
+	revocationRepo domain.TokenRevocationRepository,
+	oneTimeTokenRepo domain.OneTimeTokenRepository,
+	mfaRepo domain.MFARepository,
+	loginAttemptRepo domain.LoginAttemptRepository,
+	sessionRepo domain.SessionRepository,
+	oauthClientRepo domain.OAuthClientRepository,
+	oidcProvider domain.OIDCProvider,
+	mailer domain.Mailer,
+	keyRing *security.KeyRing,
+	hashParams *security.HashParams,
+	passwordPolicy *security.PasswordPolicy,
+	config *config.Config,
+) domain.AuthUseCase {
+	return &authUseCase{
+		authRepo:         authRepo,
+		userRepo:         userRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		revocationRepo:   revocationRepo,
+		oneTimeTokenRepo: oneTimeTokenRepo,
+		mfaRepo:          mfaRepo,
+		loginAttemptRepo: loginAttemptRepo,
+		sessionRepo:      sessionRepo,
+		oauthClientRepo:  oauthClientRepo,
+		oidcProvider:     oidcProvider,
+		mailer:           mailer,
+		keyRing:          keyRing,
+		hashParams:       hashParams,
+		passwordPolicy:   passwordPolicy,
+		config:           config,
+		dummyPasswordHash: sync.OnceValue(func() string {
+			hash, _ := security.GeneratePasswordHash("dummy-password-for-timing", hashParams)
+			return hash
+		}),
 	}
+}
 
-	publicKey, err := security.ParseRSAPublicKeyFromPEM(config.Secure.JWT.PublicKey)
-	if err != nil {
+func (uc *authUseCase) SignUpUser(ctx context.Context, user *domain.User) (*domain.User, error) {
+	if err := uc.checkPasswordPolicy(user.Password, user.Email); err != nil {
 		return nil, err
 	}
 
-	return &authUseCase{
-		authRepo:   authRepo,
-		userRepo:   userRepo,
-		config:     config,
-		privateKey: privateKey,
-		publicKey:  publicKey,
-	}, nil
-}
-
-func (uc *authUseCase) SignUpUser(ctx context.Context, user *domain.User) (*domain.User, error) {
 	// 비밀번호 해싱
-	hashedPassword, err := security.GeneratePasswordHash(user.Password, nil)
+	hashedPassword, err := security.GeneratePasswordHash(user.Password, uc.hashParams)
 	if err != nil {
 		return nil, err
 	}
 	user.Password = hashedPassword
 
-	return uc.authRepo.CreateUser(ctx, user)
+	createdUser, err := uc.authRepo.CreateUser(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	// 인증 메일 발송에 실패해도 가입은 완료된다. 사용자는 재발송을 요청할 수 있다
+	if err := uc.sendVerificationMail(ctx, createdUser); err != nil {
+		contextutil.GetLogger(ctx).Error("Failed to send verification mail",
+			slog.Int64("user_id", createdUser.ID),
+			slog.String("err", err.Error()),
+		)
+	}
+	return createdUser, nil
 }
 
+// Login 이메일과 비밀번호를 확인하고 토큰을 발급한다
+// 가입되지 않은 이메일과 틀린 비밀번호는 같은 ErrInvalidCredentials 를 반환하고, 둘 다 실패 횟수에 포함한다
 func (uc *authUseCase) Login(ctx context.Context, email, password string) (*domain.LoginResponse, error) {
+	throttleKeys := uc.loginThrottleKeys(ctx, email)
+	if err := uc.checkLoginLocked(ctx, throttleKeys); err != nil {
+		return nil, err
+	}
+
 	// 이메일로 사용자 조회
 	user, err := uc.userRepo.GetUserByEmail(ctx, email)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return nil, err
+	}
+
+	// 비밀번호 검증. 가입되지 않은 이메일도 같은 비용의 비교를 수행한다
+	passwordHash := uc.dummyPasswordHash()
+	if user != nil {
+		passwordHash = user.Password
+	}
+	match, err := security.ComparePasswordHash(password, passwordHash)
 	if err != nil {
 		return nil, err
 	}
+	if user == nil || !match {
+		if err := uc.recordLoginFailure(ctx, throttleKeys); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	if err := uc.resetLoginFailures(ctx, email); err != nil {
+		return nil, err
+	}
 
-	// 비밀번호 검증
-	match, err := security.ComparePasswordHash(password, user.Password)
+	// 현재 정책보다 약한 파라미터로 저장된 해시는 평문을 알고 있는 지금 다시 해싱한다
+	uc.rehashPasswordIfNeeded(ctx, user, password)
+
+	// 비밀번호가 맞은 경우에만 알려주어 가입 여부가 드러나지 않게 한다
+	if err := checkAccountActive(user); err != nil {
+		return nil, err
+	}
+	if uc.config.Secure.EmailVerification.RequiredForLogin && !user.IsVerified() {
+		return nil, domain.ErrEmailNotVerified
+	}
+
+	// 관리자가 정한 비밀번호는 바꾸기 전까지 토큰을 발급하지 않는다. 바꾼 뒤에 다시 로그인하면 MFA 를 진행한다
+	if user.PasswordChangeRequired {
+		return uc.passwordChangeChallenge(ctx, user)
+	}
+
+	// MFA 를 사용하는 사용자는 토큰 대신 MFA 토큰을 받고, LoginMFA 에서 두 번째 인증을 마친다
+	mfaRequired, enrollmentRequired, err := uc.mfaStatus(ctx, user)
 	if err != nil {
 		return nil, err
 	}
-	if !match {
-		return nil, errors.New("invalid credentials")
+	if mfaRequired {
+		return uc.mfaChallenge(user, enrollmentRequired)
 	}
 
-	// 토큰 생성
-	return uc.generateTokens(user)
+	// 토큰 생성. 로그인마다 새로운 세션(리프레시 토큰 family)을 시작한다
+	return uc.startSession(ctx, user)
+}
+
+// checkPasswordPolicy 는 비밀번호가 정책을 충족하지 못하면 사유를 담은 PasswordPolicyError 를 반환한다
+func (uc *authUseCase) checkPasswordPolicy(password, email string) error {
+	violations := uc.passwordPolicy.Check(password, email)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	reasons := make([]domain.PasswordPolicyReason, 0, len(violations))
+	for _, v := range violations {
+		reasons = append(reasons, domain.PasswordPolicyReason{Code: v.Code, Message: v.Message})
+	}
+	return &domain.PasswordPolicyError{Reasons: reasons}
+}
+
+// rehashPasswordIfNeeded 는 저장된 해시가 현재 파라미터보다 약하면 다시 해싱하여 저장한다
+// 실패해도 로그인은 계속 진행하고, 다음 로그인에서 다시 시도한다.
+// 변경이 필요한 비밀번호는 곧 교체되고, UpdatePassword 가 변경 요구를 해제하므로 다시 해싱하지 않는다
+func (uc *authUseCase) rehashPasswordIfNeeded(ctx context.Context, user *domain.User, password string) {
+	if user.PasswordChangeRequired || !security.NeedsRehash(user.Password, uc.hashParams) {
+		return
+	}
+
+	logger := contextutil.GetLogger(ctx)
+	hashedPassword, err := security.GeneratePasswordHash(password, uc.hashParams)
+	if err == nil {
+		err = uc.userRepo.UpdatePassword(ctx, user.ID, hashedPassword)
+	}
+	if err != nil {
+		logger.Error("Failed to rehash password",
+			slog.Int64("user_id", user.ID),
+			slog.String("err", err.Error()),
+		)
+		return
+	}
+
+	user.Password = hashedPassword
+	logger.Info("Password rehashed with current parameters", slog.Int64("user_id", user.ID))
 }
 
 // Logout 사용자 로그아웃 처리
-func (uc *authUseCase) Logout(ctx context.Context, userID int64) error {
-	// 현재 구현에서는 클라이언트 측에서 토큰을 삭제하는 방식으로 처리
-	// 서버 측에서는 특별한 작업이 필요 없음
+// 현재 액세스 토큰을 폐기 목록에 추가하고, 전달된 리프레시 토큰이 속한 family 를 폐기하여
+// 쿠키가 탈취되었더라도 즉시 사용할 수 없게 한다
+func (uc *authUseCase) Logout(ctx context.Context, req *domain.LogoutRequest) error {
+	if req.AccessTokenID != "" {
+		if err := uc.revocationRepo.RevokeToken(ctx, req.AccessTokenID, req.AccessTokenExpiresAt); err != nil {
+			return err
+		}
+	}
+
+	// 대리 로그인 토큰은 리프레시 토큰이 없으므로 액세스 토큰 폐기로 대리 로그인이 끝난다
+	if req.ActorID != 0 {
+		contextutil.GetLogger(ctx).Warn("Impersonation ended",
+			slog.Int64("user_id", req.UserID),
+			slog.Int64("actor_id", req.ActorID),
+		)
+		return nil
+	}
+
+	if req.RefreshToken == "" {
+		return nil
+	}
+
+	stored, err := uc.refreshTokenRepo.GetByHash(ctx, security.HashToken(req.RefreshToken))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	// 다른 사용자의 리프레시 토큰으로 로그아웃 요청을 한 경우는 무시한다
+	if stored.UserID != req.UserID {
+		return nil
+	}
+
+	if err := uc.refreshTokenRepo.RevokeFamily(ctx, stored.FamilyID); err != nil {
+		return err
+	}
+	return uc.deleteSession(ctx, stored.UserID, stored.FamilyID)
+}
+
+// RevokeUserTokens 사용자의 모든 액세스 토큰과 리프레시 토큰을 폐기한다
+func (uc *authUseCase) RevokeUserTokens(ctx context.Context, userID int64) error {
+	if _, err := uc.userRepo.GetByID(ctx, userID); err != nil {
+		return err
+	}
+
+	// 지금까지 발급된 액세스 토큰과 대리 로그인 토큰 중 가장 늦게 만료되는 시점까지만 기록을 유지한다
+	// 토큰의 iat 는 초 단위로 내림되므로, 폐기 시각과 같은 초에 발급한 토큰까지 폐기되도록 다음 초를 기준 시각으로 한다.
+	// 폐기 직후 같은 초에 새로 발급한 토큰도 함께 폐기되지만, 폐기되어야 할 토큰이 살아남는 것보다 안전하다
+	issuedBefore := time.Now().Truncate(time.Second).Add(time.Second)
+	retention := max(time.Duration(uc.config.Secure.JWT.AccessExpirationMin)*time.Minute, uc.impersonationExpiration())
+	if err := uc.revocationRepo.RevokeUserTokens(ctx, userID, issuedBefore, issuedBefore.Add(retention)); err != nil {
+		return err
+	}
+
+	if err := uc.refreshTokenRepo.RevokeAllByUserID(ctx, userID); err != nil {
+		return err
+	}
+	return uc.sessionRepo.DeleteAllByUserID(ctx, userID)
+}
+
+// UnlockUser 로그인 실패로 잠긴 사용자의 이메일 기준 실패 기록과 잠금을 해제한다
+func (uc *authUseCase) UnlockUser(ctx context.Context, userID int64) error {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return uc.loginAttemptRepo.Reset(ctx, emailThrottleKey(user.Email))
+}
+
+// ForgotPassword 비밀번호 재설정 링크를 메일로 보낸다
+// 가입 여부를 알아낼 수 없도록, 가입되지 않은 이메일이거나 메일 발송에 실패해도 에러를 반환하지 않는다
+func (uc *authUseCase) ForgotPassword(ctx context.Context, email string) error {
+	logger := contextutil.GetLogger(ctx)
+
+	user, err := uc.userRepo.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			logger.Info("Password reset requested for unknown email")
+			return nil
+		}
+		return err
+	}
+
+	resetCfg := uc.config.Secure.PasswordReset
+	token, err := uc.issueOneTimeToken(ctx, user.ID, domain.PurposePasswordReset, time.Duration(resetCfg.TokenExpirationMin)*time.Minute)
+	if err != nil {
+		return err
+	}
+
+	err = uc.mailer.Send(ctx, &domain.Mail{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Use the link below to reset your password. The link expires in %d minutes.\n\n%s?token=%s\n\n"+
+				"If you did not request a password reset, you can ignore this email.",
+			resetCfg.TokenExpirationMin, resetCfg.URL, url.QueryEscape(token),
+		),
+	})
+	if err != nil {
+		logger.Error("Failed to send password reset mail",
+			slog.Int64("user_id", user.ID),
+			slog.String("err", err.Error()),
+		)
+	}
+	return nil
+}
+
+// passwordChangeChallenge 는 토큰 대신 비밀번호 재설정 토큰을 발급한다
+// 재설정 링크와 같은 토큰이므로 ResetPassword 로 비밀번호를 바꾸면 변경 요구가 해제된다
+func (uc *authUseCase) passwordChangeChallenge(ctx context.Context, user *domain.User) (*domain.LoginResponse, error) {
+	expiration := time.Duration(uc.config.Secure.PasswordReset.TokenExpirationMin) * time.Minute
+	token, err := uc.issueOneTimeToken(ctx, user.ID, domain.PurposePasswordReset, expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.LoginResponse{
+		ID:                     user.ID,
+		Email:                  user.Email,
+		PasswordChangeRequired: true,
+		PasswordChangeToken:    token,
+	}, nil
+}
+
+// ResetPassword 재설정 토큰을 사용 처리하고 비밀번호를 변경한다
+// 비밀번호가 변경되면 기존에 발급된 모든 토큰을 폐기하여 다른 기기의 세션을 종료시킨다
+func (uc *authUseCase) ResetPassword(ctx context.Context, req *domain.ResetPasswordRequest) error {
+	tokenHash := security.HashToken(req.Token)
+
+	// 정책을 충족하지 못하면 토큰을 사용 처리하지 않아 같은 링크로 다시 시도할 수 있게 한다
+	token, err := uc.oneTimeTokenRepo.Find(ctx, domain.PurposePasswordReset, tokenHash)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrInvalidToken
+		}
+		return err
+	}
+	user, err := uc.userRepo.GetByID(ctx, token.UserID)
+	if err != nil {
+		return err
+	}
+	if err := uc.checkPasswordPolicy(req.Password, user.Email); err != nil {
+		return err
+	}
+
+	token, err = uc.oneTimeTokenRepo.Consume(ctx, domain.PurposePasswordReset, tokenHash)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrInvalidToken
+		}
+		return err
+	}
+
+	hashedPassword, err := security.GeneratePasswordHash(req.Password, uc.hashParams)
+	if err != nil {
+		return err
+	}
+	if err := uc.userRepo.UpdatePassword(ctx, token.UserID, hashedPassword); err != nil {
+		return err
+	}
 
-	// TODO: 향후 토큰 블랙리스트 구현 시 여기에 추가
-	// - 사용자의 현재 토큰을 블랙리스트에 추가
-	// - Redis 또는 다른 인메모리 저장소 사용 권장
-	// - 토큰의 만료 시간까지만 블랙리스트에 유지
+	return uc.RevokeUserTokens(ctx, token.UserID)
+}
+
+// VerifyEmail 인증 토큰을 사용 처리하고 사용자의 이메일을 인증된 상태로 변경한다
+func (uc *authUseCase) VerifyEmail(ctx context.Context, token string) error {
+	consumed, err := uc.oneTimeTokenRepo.Consume(ctx, domain.PurposeEmailVerification, security.HashToken(token))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrInvalidToken
+		}
+		return err
+	}
 
+	return uc.userRepo.MarkVerified(ctx, consumed.UserID)
+}
+
+// ResendVerification 인증 메일을 다시 보낸다
+// 가입 여부를 알아낼 수 없도록 가입되지 않았거나, 이미 인증되었거나, 재발송 간격이 지나지 않은 경우에도 에러를 반환하지 않는다
+func (uc *authUseCase) ResendVerification(ctx context.Context, email string) error {
+	logger := contextutil.GetLogger(ctx)
+
+	user, err := uc.userRepo.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			logger.Info("Verification resend requested for unknown email")
+			return nil
+		}
+		return err
+	}
+	if user.IsVerified() {
+		return nil
+	}
+
+	// 재발송 간격 제한. 메일 폭탄이나 토큰 남발을 막는다
+	lastSentAt, err := uc.oneTimeTokenRepo.LatestCreatedAt(ctx, user.ID, domain.PurposeEmailVerification)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return err
+	}
+	interval := time.Duration(uc.config.Secure.EmailVerification.ResendIntervalSec) * time.Second
+	if err == nil && time.Since(lastSentAt) < interval {
+		logger.Info("Verification resend throttled", slog.Int64("user_id", user.ID))
+		return nil
+	}
+
+	if err := uc.sendVerificationMail(ctx, user); err != nil {
+		logger.Error("Failed to send verification mail",
+			slog.Int64("user_id", user.ID),
+			slog.String("err", err.Error()),
+		)
+	}
 	return nil
 }
 
+// sendVerificationMail 은 새 인증 토큰을 발급하여 인증 링크를 메일로 보낸다
+func (uc *authUseCase) sendVerificationMail(ctx context.Context, user *domain.User) error {
+	verificationCfg := uc.config.Secure.EmailVerification
+	token, err := uc.issueOneTimeToken(ctx, user.ID, domain.PurposeEmailVerification, time.Duration(verificationCfg.TokenExpirationMin)*time.Minute)
+	if err != nil {
+		return err
+	}
+
+	return uc.mailer.Send(ctx, &domain.Mail{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf(
+			"Use the link below to verify your email address. The link expires in %d minutes.\n\n%s?token=%s",
+			verificationCfg.TokenExpirationMin, verificationCfg.URL, url.QueryEscape(token),
+		),
+	})
+}
+
+// issueOneTimeToken 은 일회용 토큰을 새로 발급하여 해시를 저장하고 원문을 반환한다
+// 이전에 발급된 같은 용도의 토큰은 더 이상 사용할 수 없게 한다
+func (uc *authUseCase) issueOneTimeToken(ctx context.Context, userID int64, purpose domain.OneTimeTokenPurpose, expiration time.Duration) (string, error) {
+	if err := uc.oneTimeTokenRepo.DeleteByUserID(ctx, userID, purpose); err != nil {
+		return "", err
+	}
+
+	token, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+
+	_, err = uc.oneTimeTokenRepo.Save(ctx, &domain.OneTimeToken{
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: security.HashToken(token),
+		ExpiresAt: time.Now().Add(expiration),
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
 // RefreshToken validates a refresh token and issues new access and refresh tokens
+// 리프레시 토큰은 사용할 때마다 교체(rotation)되며, 이미 교체된 토큰이 다시 사용되면
+// 탈취된 것으로 보고 해당 family 전체를 폐기한다.
 func (uc *authUseCase) RefreshToken(ctx context.Context, refreshToken string) (*domain.LoginResponse, error) {
 	// Validate the refresh token
-	claims, err := security.ValidateRefreshToken(refreshToken, uc.publicKey)
+	claims, err := security.ValidateRefreshToken(refreshToken, uc.keyRing)
 	if err != nil {
 		return nil, domain.ErrUnauthorized
 	}
 
+	// 서버에 저장된 토큰인지 확인
+	stored, err := uc.refreshTokenRepo.GetByHash(ctx, security.HashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrUnauthorized
+		}
+		return nil, err
+	}
+	if stored.UserID != claims.UserID {
+		return nil, domain.ErrUnauthorized
+	}
+	if !stored.IsActive(time.Now()) {
+		// 폐기되지 않았는데 이미 교체된 토큰이면 재사용으로 간주한다
+		if stored.RotatedAt != nil && stored.RevokedAt == nil {
+			return nil, uc.revokeReusedFamily(ctx, stored)
+		}
+		return nil, domain.ErrUnauthorized
+	}
+
 	// Get user by ID
 	user, err := uc.userRepo.GetByID(ctx, claims.UserID)
 	if err != nil {
@@ -100,8 +504,31 @@ func (uc *authUseCase) RefreshToken(ctx context.Context, refreshToken string) (*
 		}
 		return nil, err
 	}
+	if err := checkAccountActive(user); err != nil {
+		return nil, err
+	}
+
+	// 현재 토큰을 교체 처리. 동시에 같은 토큰으로 교체 요청이 들어와 먼저 교체된 경우도 재사용으로 간주한다
+	if err := uc.refreshTokenRepo.MarkRotated(ctx, stored.ID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, uc.revokeReusedFamily(ctx, stored)
+		}
+		return nil, err
+	}
+
+	// 세션의 마지막 사용 시각과 만료 시각을 갱신한다. 원격 로그아웃으로 삭제된 세션은 더 이상 갱신할 수 없다
+	expiresAt := time.Now().Add(uc.refreshTokenExpiration())
+	if err := uc.sessionRepo.Touch(ctx, stored.FamilyID, contextutil.GetClientIP(ctx), expiresAt); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			if err := uc.refreshTokenRepo.RevokeFamily(ctx, stored.FamilyID); err != nil {
+				return nil, err
+			}
+			return nil, domain.ErrUnauthorized
+		}
+		return nil, err
+	}
 
-	response, err := uc.generateTokens(user)
+	response, err := uc.generateTokens(ctx, user, stored.FamilyID)
 	if err != nil {
 		return nil, err
 	}
@@ -109,14 +536,59 @@ func (uc *authUseCase) RefreshToken(ctx context.Context, refreshToken string) (*
 	return response, nil
 }
 
-func (uc *authUseCase) generateTokens(user *domain.User) (*domain.LoginResponse, error) {
+// revokeReusedFamily 는 재사용이 감지된 리프레시 토큰의 family 전체를 폐기한다
+func (uc *authUseCase) revokeReusedFamily(ctx context.Context, stored *domain.RefreshToken) error {
+	contextutil.GetLogger(ctx).Warn("Refresh token reuse detected",
+		slog.Int64("user_id", stored.UserID),
+		slog.String("family_id", stored.FamilyID),
+	)
+
+	if err := uc.refreshTokenRepo.RevokeFamily(ctx, stored.FamilyID); err != nil {
+		return err
+	}
+	if err := uc.deleteSession(ctx, stored.UserID, stored.FamilyID); err != nil {
+		return err
+	}
+	return domain.ErrUnauthorized
+}
+
+// startSession 은 요청한 기기의 정보로 세션을 기록하고, 세션 ID 를 family 로 하는 토큰을 발급한다
+func (uc *authUseCase) startSession(ctx context.Context, user *domain.User) (*domain.LoginResponse, error) {
+	session := &domain.Session{
+		ID:        uuid.NewString(),
+		UserID:    user.ID,
+		UserAgent: contextutil.GetUserAgent(ctx),
+		IP:        contextutil.GetClientIP(ctx),
+		ExpiresAt: time.Now().Add(uc.refreshTokenExpiration()),
+	}
+	if err := uc.sessionRepo.Create(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return uc.generateTokens(ctx, user, session.ID)
+}
+
+// deleteSession 은 세션을 삭제한다. 이미 삭제된 세션은 무시한다
+func (uc *authUseCase) deleteSession(ctx context.Context, userID int64, sessionID string) error {
+	if err := uc.sessionRepo.Delete(ctx, userID, sessionID); err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+func (uc *authUseCase) refreshTokenExpiration() time.Duration {
+	return time.Duration(uc.config.Secure.JWT.RefreshExpirationDay) * 24 * time.Hour
+}
+
+func (uc *authUseCase) generateTokens(ctx context.Context, user *domain.User, familyID string) (*domain.LoginResponse, error) {
 	// Generate access token
 	accessTokenExpiration := time.Duration(uc.config.Secure.JWT.AccessExpirationMin) * time.Minute
-	accessToken, err := security.GenerateAccessToken(
+	accessToken, err := security.GenerateSessionAccessToken(
 		user.ID,
 		user.Email,
 		user.Roles,
-		uc.privateKey,
+		familyID,
+		uc.keyRing,
 		accessTokenExpiration,
 	)
 	if err != nil {
@@ -124,25 +596,37 @@ func (uc *authUseCase) generateTokens(user *domain.User) (*domain.LoginResponse,
 	}
 
 	// Generate refresh token
-	refreshTokenExpiration := time.Duration(uc.config.Secure.JWT.RefreshExpirationDay) * 24 * time.Hour
+	refreshTokenExpiration := uc.refreshTokenExpiration()
 	refreshToken, err := security.GenerateRefreshToken(
 		user.ID,
 		user.Email,
 		user.Roles,
-		uc.privateKey,
+		uc.keyRing,
 		refreshTokenExpiration,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	// 리프레시 토큰은 해시로 저장하여 교체 및 폐기 여부를 추적한다
+	refreshTokenExpiresAt := time.Now().Add(refreshTokenExpiration)
+	_, err = uc.refreshTokenRepo.Save(ctx, &domain.RefreshToken{
+		UserID:    user.ID,
+		FamilyID:  familyID,
+		TokenHash: security.HashToken(refreshToken),
+		ExpiresAt: refreshTokenExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	// Create response
 	response := &domain.LoginResponse{
 		ID:                     user.ID,
 		Email:                  user.Email,
 		AccessToken:            accessToken,
 		RefreshToken:           refreshToken,
-		RefreshTokenExpiration: time.Now().Add(refreshTokenExpiration),
+		RefreshTokenExpiration: refreshTokenExpiresAt,
 	}
 	return response, nil
 }
@@ -7,6 +7,7 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,7 +17,9 @@ import (
 	"github.com/nicewook/gocore/internal/config"
 	"github.com/nicewook/gocore/internal/domain"
 	"github.com/nicewook/gocore/internal/domain/mocks"
+	"github.com/nicewook/gocore/internal/repository/memory"
 	"github.com/nicewook/gocore/internal/usecase"
+	"github.com/nicewook/gocore/pkg/contextutil"
 	"github.com/nicewook/gocore/pkg/security"
 )
 
@@ -48,19 +51,41 @@ func generateTestRSAKeys() (string, string, error) {
 	return string(privateKeyPEM), string(publicKeyPEM), nil
 }
 
-func TestSignUpUser(t *testing.T) {
-	// 테스트용 RSA 키 생성
+// 테스트용 키 링 생성
+func generateTestKeyRing() (*security.KeyRing, error) {
 	privateKeyPEM, publicKeyPEM, err := generateTestRSAKeys()
 	if err != nil {
-		t.Fatalf("Failed to generate RSA keys: %v", err)
+		return nil, err
+	}
+
+	return security.NewKeyRing("test-key", security.KeyPEM{
+		ID:         "test-key",
+		PrivateKey: privateKeyPEM,
+		PublicKey:  publicKeyPEM,
+	})
+}
+
+// newTestSessionRepo 는 세션 기록을 검증하지 않는 테스트에서 사용하는 세션 저장소이다
+func newTestSessionRepo() *mocks.SessionRepository {
+	sessionRepo := new(mocks.SessionRepository)
+	sessionRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Session")).Return(nil).Maybe()
+	sessionRepo.On("Touch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	sessionRepo.On("Delete", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	sessionRepo.On("DeleteAllByUserID", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return sessionRepo
+}
+
+func TestSignUpUser(t *testing.T) {
+	// 테스트용 키 링 생성
+	keyRing, err := generateTestKeyRing()
+	if err != nil {
+		t.Fatalf("Failed to generate key ring: %v", err)
 	}
 
 	// Create a test config
 	cfg := &config.Config{
 		Secure: config.SecureConfig{
 			JWT: config.JWTConfig{
-				PrivateKey:           privateKeyPEM,
-				PublicKey:            publicKeyPEM,
 				AccessExpirationMin:  60, // 60분
 				RefreshExpirationDay: 30, // 30일
 				Cookie: config.CookieConfig{
@@ -115,24 +140,48 @@ func TestSignUpUser(t *testing.T) {
 			expected:   nil,
 			expectErr:  domain.ErrAlreadyExists,
 		},
+		{
+			// 정책을 충족하지 못하면 사용자를 만들지 않는다
+			name: "Weak Password",
+			mockInput: &domain.User{
+				Email:    "test@example.com",
+				Password: "short",
+				Roles:    []string{domain.RoleUser},
+			},
+			expected:  nil,
+			expectErr: domain.ErrWeakPassword,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockAuthRepo := new(mocks.AuthRepository)
 			mockUserRepo := new(mocks.UserRepository)
+			mockRefreshTokenRepo := new(mocks.RefreshTokenRepository)
+			mockRevocationRepo := new(mocks.TokenRevocationRepository)
+			mockOneTimeTokenRepo := new(mocks.OneTimeTokenRepository)
+			mockMailer := new(mocks.Mailer)
 
 			// We need to use a matcher for password since it will be hashed
-			mockAuthRepo.On("CreateUser", mock.Anything, mock.AnythingOfType("*domain.User")).Return(tt.mockReturn, tt.mockError)
+			if !errors.Is(tt.expectErr, domain.ErrWeakPassword) {
+				mockAuthRepo.On("CreateUser", mock.Anything, mock.AnythingOfType("*domain.User")).Return(tt.mockReturn, tt.mockError)
+			}
+			if tt.expectErr == nil {
+				// 가입 후 인증 메일이 발송된다
+				mockOneTimeTokenRepo.On("DeleteByUserID", mock.Anything, tt.mockReturn.ID, domain.PurposeEmailVerification).Return(nil)
+				mockOneTimeTokenRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.OneTimeToken")).Return(&domain.OneTimeToken{}, nil)
+				mockMailer.On("Send", mock.Anything, mock.MatchedBy(func(mail *domain.Mail) bool {
+					return mail.To == tt.mockReturn.Email && strings.Contains(mail.Body, "?token=")
+				})).Return(nil)
+			}
 
-			uc, err := usecase.NewAuthUseCase(mockAuthRepo, mockUserRepo, cfg)
-			assert.NoError(t, err)
+			uc := usecase.NewAuthUseCase(mockAuthRepo, mockUserRepo, mockRefreshTokenRepo, mockRevocationRepo, mockOneTimeTokenRepo, new(mocks.MFARepository), new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, mockMailer, keyRing, nil, nil, cfg)
 
 			ctx := context.Background()
 			result, err := uc.SignUpUser(ctx, tt.mockInput)
 
 			if tt.expectErr != nil {
-				assert.Equal(t, tt.expectErr, err)
+				assert.ErrorIs(t, err, tt.expectErr)
 				assert.Equal(t, tt.expected, result)
 			} else {
 				assert.NoError(t, err)
@@ -142,19 +191,19 @@ func TestSignUpUser(t *testing.T) {
 			}
 
 			mockAuthRepo.AssertExpectations(t)
+			mockOneTimeTokenRepo.AssertExpectations(t)
+			mockMailer.AssertExpectations(t)
 		})
 	}
 }
 
 func TestLogin(t *testing.T) {
-	privateKeyPEM, publicKeyPEM, err := generateTestRSAKeys()
+	keyRing, err := generateTestKeyRing()
 	assert.NoError(t, err)
 
 	cfg := &config.Config{
 		Secure: config.SecureConfig{
 			JWT: config.JWTConfig{
-				PrivateKey:           privateKeyPEM,
-				PublicKey:            publicKeyPEM,
 				AccessExpirationMin:  15,
 				RefreshExpirationDay: 7,
 				Cookie: config.CookieConfig{
@@ -177,15 +226,21 @@ func TestLogin(t *testing.T) {
 		Password: hashedPassword,
 		Roles:    []string{domain.RoleUser},
 	}
+	verifiedAt := time.Now()
+	verifiedUser := *user
+	verifiedUser.VerifiedAt = &verifiedAt
+	disabledUser := *user
+	disabledUser.DisabledAt = &verifiedAt
 
 	tests := []struct {
-		name      string
-		email     string
-		password  string
-		mockUser  *domain.User
-		mockError error
-		expected  *domain.LoginResponse
-		expectErr error
+		name            string
+		email           string
+		password        string
+		requireVerified bool // 이메일 인증을 마친 사용자만 로그인 허용
+		mockUser        *domain.User
+		mockError       error
+		expected        *domain.LoginResponse
+		expectErr       error
 	}{
 		{
 			name:      "Success",
@@ -206,7 +261,7 @@ func TestLogin(t *testing.T) {
 			mockUser:  nil,
 			mockError: domain.ErrNotFound,
 			expected:  nil,
-			expectErr: domain.ErrNotFound,
+			expectErr: domain.ErrInvalidCredentials, // 가입 여부가 드러나지 않도록 틀린 비밀번호와 같은 에러
 		},
 		{
 			name:      "Invalid Password",
@@ -215,7 +270,36 @@ func TestLogin(t *testing.T) {
 			mockUser:  user,
 			mockError: nil,
 			expected:  nil,
-			expectErr: errors.New("invalid credentials"),
+			expectErr: domain.ErrInvalidCredentials,
+		},
+		{
+			name:      "Disabled Account Blocked",
+			email:     "test@example.com",
+			password:  "password",
+			mockUser:  &disabledUser,
+			expected:  nil,
+			expectErr: domain.ErrAccountDisabled,
+		},
+		{
+			name:            "Unverified Email Blocked",
+			email:           "test@example.com",
+			password:        "password",
+			requireVerified: true,
+			mockUser:        user,
+			expected:        nil,
+			expectErr:       domain.ErrEmailNotVerified,
+		},
+		{
+			name:            "Verified Email Allowed",
+			email:           "test@example.com",
+			password:        "password",
+			requireVerified: true,
+			mockUser:        &verifiedUser,
+			expected: &domain.LoginResponse{
+				ID:    1,
+				Email: "test@example.com",
+			},
+			expectErr: nil,
 		},
 	}
 
@@ -223,15 +307,35 @@ func TestLogin(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockAuthRepo := new(mocks.AuthRepository)
 			mockUserRepo := new(mocks.UserRepository)
+			mockRefreshTokenRepo := new(mocks.RefreshTokenRepository)
+			mockRevocationRepo := new(mocks.TokenRevocationRepository)
+			mockMFARepo := new(mocks.MFARepository)
+			mockSessionRepo := new(mocks.SessionRepository)
 
 			if tt.email != "" {
 				mockUserRepo.On("GetUserByEmail", mock.Anything, tt.email).Return(tt.mockUser, tt.mockError)
 			}
+			var sessionID string
+			if tt.expectErr == nil {
+				// MFA 를 등록하지 않은 사용자
+				mockMFARepo.On("GetByUserID", mock.Anything, tt.mockUser.ID).Return(nil, domain.ErrNotFound)
+				// 로그인한 기기의 정보로 세션을 기록한다
+				mockSessionRepo.On("Create", mock.Anything, mock.MatchedBy(func(session *domain.Session) bool {
+					sessionID = session.ID
+					return session.UserID == tt.mockUser.ID && session.ID != "" &&
+						session.UserAgent == "test-agent" && session.IP == "203.0.113.1"
+				})).Return(nil)
+				// 로그인 성공 시 세션 ID 를 family 로 하는 리프레시 토큰이 저장되어야 한다
+				mockRefreshTokenRepo.On("Save", mock.Anything, mock.MatchedBy(func(token *domain.RefreshToken) bool {
+					return token.UserID == tt.mockUser.ID && token.FamilyID == sessionID && token.TokenHash != ""
+				})).Return(&domain.RefreshToken{ID: 1}, nil)
+			}
 
-			uc, err := usecase.NewAuthUseCase(mockAuthRepo, mockUserRepo, cfg)
-			assert.NoError(t, err)
+			testCfg := *cfg
+			testCfg.Secure.EmailVerification.RequiredForLogin = tt.requireVerified
+			uc := usecase.NewAuthUseCase(mockAuthRepo, mockUserRepo, mockRefreshTokenRepo, mockRevocationRepo, new(mocks.OneTimeTokenRepository), mockMFARepo, new(mocks.LoginAttemptRepository), mockSessionRepo, new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, &testCfg)
 
-			ctx := context.Background()
+			ctx := contextutil.WithUserAgent(contextutil.WithClientIP(context.Background(), "203.0.113.1"), "test-agent")
 			result, err := uc.Login(ctx, tt.email, tt.password)
 
 			if tt.expectErr != nil {
@@ -241,24 +345,140 @@ func TestLogin(t *testing.T) {
 				assert.NoError(t, err)
 				assert.NotEmpty(t, result.AccessToken)
 				assert.NotEmpty(t, result.RefreshToken)
+
+				// 액세스 토큰에는 세션 ID 가 담긴다
+				claims, err := security.ValidateAccessToken(result.AccessToken, keyRing)
+				assert.NoError(t, err)
+				assert.Equal(t, sessionID, claims.SessionID)
 			}
 
+			mockUserRepo.AssertExpectations(t)
+			mockRefreshTokenRepo.AssertExpectations(t)
+			mockMFARepo.AssertExpectations(t)
+			mockSessionRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLoginPasswordChangeRequired(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	cfg := &config.Config{
+		Secure: config.SecureConfig{
+			PasswordReset: config.PasswordResetConfig{TokenExpirationMin: 30},
+		},
+	}
+
+	// 기본값보다 약한 해시여도 곧 바뀔 비밀번호이므로 다시 해싱하지 않는다
+	weakHash, err := security.GeneratePasswordHash("password", &security.HashParams{Time: 2, Memory: 32 * 1024, Threads: 1, KeyLen: 16})
+	assert.NoError(t, err)
+	user := &domain.User{ID: 1, Email: "admin@example.com", Password: weakHash, Roles: []string{domain.RoleAdmin}, PasswordChangeRequired: true}
+
+	mockUserRepo := new(mocks.UserRepository)
+	mockOneTimeTokenRepo := new(mocks.OneTimeTokenRepository)
+	mockUserRepo.On("GetUserByEmail", mock.Anything, user.Email).Return(user, nil)
+	// 재설정 링크와 같은 용도의 토큰을 발급하여 /auth/password/reset 에서 사용한다
+	var savedToken *domain.OneTimeToken
+	mockOneTimeTokenRepo.On("DeleteByUserID", mock.Anything, user.ID, domain.PurposePasswordReset).Return(nil)
+	mockOneTimeTokenRepo.On("Save", mock.Anything, mock.MatchedBy(func(token *domain.OneTimeToken) bool {
+		savedToken = token
+		return token.UserID == user.ID && token.Purpose == domain.PurposePasswordReset
+	})).Return(&domain.OneTimeToken{ID: 1}, nil)
+
+	// 세션, 리프레시 토큰, MFA 저장소는 호출되지 않아야 한다
+	uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, new(mocks.RefreshTokenRepository), new(mocks.TokenRevocationRepository), mockOneTimeTokenRepo, new(mocks.MFARepository), new(mocks.LoginAttemptRepository), new(mocks.SessionRepository), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
+
+	result, err := uc.Login(context.Background(), user.Email, "password")
+
+	assert.NoError(t, err)
+	assert.True(t, result.PasswordChangeRequired)
+	assert.Empty(t, result.AccessToken)
+	assert.Empty(t, result.RefreshToken)
+	assert.Equal(t, security.HashToken(result.PasswordChangeToken), savedToken.TokenHash)
+	mockUserRepo.AssertExpectations(t)
+	mockOneTimeTokenRepo.AssertExpectations(t)
+}
+
+func TestLoginRehashPassword(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	cfg := &config.Config{
+		Secure: config.SecureConfig{
+			JWT: config.JWTConfig{
+				AccessExpirationMin:  15,
+				RefreshExpirationDay: 7,
+			},
+		},
+	}
+
+	weakParams := &security.HashParams{Time: 2, Memory: 32 * 1024, Threads: 1, KeyLen: 16}
+	weakHash, err := security.GeneratePasswordHash("password", weakParams)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		hashParams  *security.HashParams // 현재 정책. nil 이면 기본값
+		expectSaved bool
+		updateErr   error
+	}{
+		{
+			name:        "Weaker Hash Upgraded",
+			hashParams:  nil,
+			expectSaved: true,
+		},
+		{
+			name:        "Hash Meets Policy",
+			hashParams:  weakParams,
+			expectSaved: false,
+		},
+		{
+			name:        "Upgrade Failure Does Not Block Login",
+			hashParams:  nil,
+			expectSaved: true,
+			updateErr:   errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := new(mocks.UserRepository)
+			mockRefreshTokenRepo := new(mocks.RefreshTokenRepository)
+			mockMFARepo := new(mocks.MFARepository)
+
+			user := &domain.User{ID: 1, Email: "test@example.com", Password: weakHash, Roles: []string{domain.RoleUser}}
+			mockUserRepo.On("GetUserByEmail", mock.Anything, user.Email).Return(user, nil)
+			if tt.expectSaved {
+				// 새 해시는 현재 정책의 파라미터를 사용하고 같은 비밀번호와 일치해야 한다
+				mockUserRepo.On("UpdatePassword", mock.Anything, user.ID, mock.MatchedBy(func(hash string) bool {
+					match, err := security.ComparePasswordHash("password", hash)
+					return err == nil && match && !security.NeedsRehash(hash, tt.hashParams)
+				})).Return(tt.updateErr)
+			}
+			mockMFARepo.On("GetByUserID", mock.Anything, user.ID).Return(nil, domain.ErrNotFound)
+			mockRefreshTokenRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).Return(&domain.RefreshToken{ID: 1}, nil)
+
+			uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, mockRefreshTokenRepo, new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), mockMFARepo, new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, tt.hashParams, nil, cfg)
+
+			result, err := uc.Login(context.Background(), user.Email, "password")
+			assert.NoError(t, err)
+			assert.NotEmpty(t, result.AccessToken)
+
 			mockUserRepo.AssertExpectations(t)
 		})
 	}
 }
 
 func TestLogout(t *testing.T) {
-	// 테스트용 RSA 키 생성
-	privateKeyPEM, publicKeyPEM, err := generateTestRSAKeys()
+	// 테스트용 키 링 생성
+	keyRing, err := generateTestKeyRing()
 	assert.NoError(t, err)
 
 	// Create a test config
 	cfg := &config.Config{
 		Secure: config.SecureConfig{
 			JWT: config.JWTConfig{
-				PrivateKey:           privateKeyPEM,
-				PublicKey:            publicKeyPEM,
 				AccessExpirationMin:  60,
 				RefreshExpirationDay: 30,
 				Cookie: config.CookieConfig{
@@ -271,14 +491,64 @@ func TestLogout(t *testing.T) {
 		},
 	}
 
+	const refreshToken = "refresh-token"
+	storedToken := &domain.RefreshToken{ID: 1, UserID: 1, FamilyID: "family-1"}
+	accessTokenExpiresAt := time.Now().Add(time.Hour)
+
 	tests := []struct {
-		name      string
-		userID    int64
-		expectErr error
+		name         string
+		req          *domain.LogoutRequest
+		mockStored   *domain.RefreshToken
+		mockError    error
+		expectRevoke bool
+		expectErr    error
 	}{
 		{
-			name:      "Success",
-			userID:    1,
+			name:         "Success",
+			req:          &domain.LogoutRequest{UserID: 1, RefreshToken: refreshToken},
+			mockStored:   storedToken,
+			expectRevoke: true,
+			expectErr:    nil,
+		},
+		{
+			name: "Revokes Access Token",
+			req: &domain.LogoutRequest{
+				UserID:               1,
+				AccessTokenID:        "access-jti",
+				AccessTokenExpiresAt: accessTokenExpiresAt,
+				RefreshToken:         refreshToken,
+			},
+			mockStored:   storedToken,
+			expectRevoke: true,
+			expectErr:    nil,
+		},
+		{
+			name:      "Without Refresh Token",
+			req:       &domain.LogoutRequest{UserID: 1},
+			expectErr: nil,
+		},
+		{
+			name:      "Unknown Refresh Token",
+			req:       &domain.LogoutRequest{UserID: 1, RefreshToken: refreshToken},
+			mockError: domain.ErrNotFound,
+			expectErr: nil,
+		},
+		{
+			name:       "Refresh Token Of Another User",
+			req:        &domain.LogoutRequest{UserID: 2, RefreshToken: refreshToken},
+			mockStored: storedToken,
+			expectErr:  nil,
+		},
+		{
+			// 대리 로그인 토큰으로 로그아웃하면 액세스 토큰만 폐기하고, 관리자의 리프레시 토큰 쿠키는 건드리지 않는다
+			name: "Ends Impersonation",
+			req: &domain.LogoutRequest{
+				UserID:               2,
+				ActorID:              1,
+				AccessTokenID:        "impersonation-jti",
+				AccessTokenExpiresAt: accessTokenExpiresAt,
+				RefreshToken:         refreshToken,
+			},
 			expectErr: nil,
 		},
 	}
@@ -287,29 +557,40 @@ func TestLogout(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockAuthRepo := new(mocks.AuthRepository)
 			mockUserRepo := new(mocks.UserRepository)
+			mockRefreshTokenRepo := new(mocks.RefreshTokenRepository)
+			mockRevocationRepo := new(mocks.TokenRevocationRepository)
 
-			uc, err := usecase.NewAuthUseCase(mockAuthRepo, mockUserRepo, cfg)
-			assert.NoError(t, err)
+			if tt.req.RefreshToken != "" && tt.req.ActorID == 0 {
+				mockRefreshTokenRepo.On("GetByHash", mock.Anything, security.HashToken(tt.req.RefreshToken)).Return(tt.mockStored, tt.mockError)
+			}
+			if tt.expectRevoke {
+				mockRefreshTokenRepo.On("RevokeFamily", mock.Anything, tt.mockStored.FamilyID).Return(nil)
+			}
+			if tt.req.AccessTokenID != "" {
+				mockRevocationRepo.On("RevokeToken", mock.Anything, tt.req.AccessTokenID, tt.req.AccessTokenExpiresAt).Return(nil)
+			}
+
+			uc := usecase.NewAuthUseCase(mockAuthRepo, mockUserRepo, mockRefreshTokenRepo, mockRevocationRepo, new(mocks.OneTimeTokenRepository), new(mocks.MFARepository), new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
 
 			ctx := context.Background()
-			err = uc.Logout(ctx, tt.userID)
+			err = uc.Logout(ctx, tt.req)
 
 			assert.Equal(t, tt.expectErr, err)
+			mockRefreshTokenRepo.AssertExpectations(t)
+			mockRevocationRepo.AssertExpectations(t)
 		})
 	}
 }
 
 func TestRefreshToken(t *testing.T) {
-	// 테스트용 RSA 키 생성
-	privateKeyPEM, publicKeyPEM, err := generateTestRSAKeys()
+	// 테스트용 키 링 생성
+	keyRing, err := generateTestKeyRing()
 	assert.NoError(t, err)
 
 	// Create a test config
 	cfg := &config.Config{
 		Secure: config.SecureConfig{
 			JWT: config.JWTConfig{
-				PrivateKey:           privateKeyPEM,
-				PublicKey:            publicKeyPEM,
 				AccessExpirationMin:  15,
 				RefreshExpirationDay: 7,
 				Cookie: config.CookieConfig{
@@ -331,26 +612,42 @@ func TestRefreshToken(t *testing.T) {
 	}
 
 	// 실제 리프레시 토큰 생성
-	privateKey, _ := security.ParseRSAPrivateKeyFromPEM(privateKeyPEM)
 	refreshTokenExpiration := time.Duration(cfg.Secure.JWT.RefreshExpirationDay) * 24 * time.Hour
-	validRefreshToken, _ := security.GenerateRefreshToken(user.ID, user.Email, user.Roles, privateKey, refreshTokenExpiration)
+	validRefreshToken, _ := security.GenerateRefreshToken(user.ID, user.Email, user.Roles, keyRing, refreshTokenExpiration)
 
 	// 유효하지 않은 토큰
 	invalidRefreshToken := "invalid.refresh.token"
 
+	// 서버에 저장된 리프레시 토큰 상태
+	rotatedAt := time.Now().Add(-time.Minute)
+	storedExpiresAt := time.Now().Add(refreshTokenExpiration)
+	activeToken := &domain.RefreshToken{ID: 1, UserID: user.ID, FamilyID: "family-1", ExpiresAt: storedExpiresAt}
+	rotatedToken := &domain.RefreshToken{ID: 1, UserID: user.ID, FamilyID: "family-1", ExpiresAt: storedExpiresAt, RotatedAt: &rotatedAt}
+	revokedToken := &domain.RefreshToken{ID: 1, UserID: user.ID, FamilyID: "family-1", ExpiresAt: storedExpiresAt, RevokedAt: &rotatedAt}
+	// 서버 기록의 만료 시각이 지났으면 JWT 가 유효해도 거부한다
+	expiredToken := &domain.RefreshToken{ID: 1, UserID: user.ID, FamilyID: "family-1", ExpiresAt: time.Now().Add(-time.Minute)}
+
 	tests := []struct {
 		name           string
 		refreshToken   string
+		mockStored     *domain.RefreshToken
+		mockStoredErr  error
 		mockUser       *domain.User
 		mockError      error
+		mockRotateErr  error
+		mockTouchErr   error
+		expectRevoke   bool
+		expectIssue    bool
 		expectedResult *domain.LoginResponse
 		expectErr      error
 	}{
 		{
 			name:         "Success",
 			refreshToken: validRefreshToken,
+			mockStored:   activeToken,
 			mockUser:     user,
 			mockError:    nil,
+			expectIssue:  true,
 			expectedResult: &domain.LoginResponse{
 				ID:    user.ID,
 				Email: user.Email,
@@ -365,9 +662,59 @@ func TestRefreshToken(t *testing.T) {
 			expectedResult: nil,
 			expectErr:      domain.ErrUnauthorized,
 		},
+		{
+			name:           "Unknown Token",
+			refreshToken:   validRefreshToken,
+			mockStoredErr:  domain.ErrNotFound,
+			expectedResult: nil,
+			expectErr:      domain.ErrUnauthorized,
+		},
+		{
+			name:           "Revoked Token",
+			refreshToken:   validRefreshToken,
+			mockStored:     revokedToken,
+			expectedResult: nil,
+			expectErr:      domain.ErrUnauthorized,
+		},
+		{
+			name:           "Expired Stored Token",
+			refreshToken:   validRefreshToken,
+			mockStored:     expiredToken,
+			expectedResult: nil,
+			expectErr:      domain.ErrUnauthorized,
+		},
+		{
+			name:           "Reused Token Revokes Family",
+			refreshToken:   validRefreshToken,
+			mockStored:     rotatedToken,
+			expectRevoke:   true,
+			expectedResult: nil,
+			expectErr:      domain.ErrUnauthorized,
+		},
+		{
+			name:           "Concurrent Rotation Revokes Family",
+			refreshToken:   validRefreshToken,
+			mockStored:     activeToken,
+			mockUser:       user,
+			mockRotateErr:  domain.ErrNotFound,
+			expectRevoke:   true,
+			expectedResult: nil,
+			expectErr:      domain.ErrUnauthorized,
+		},
+		{
+			name:           "Deleted Session Revokes Family",
+			refreshToken:   validRefreshToken,
+			mockStored:     activeToken,
+			mockUser:       user,
+			mockTouchErr:   domain.ErrNotFound,
+			expectRevoke:   true,
+			expectedResult: nil,
+			expectErr:      domain.ErrUnauthorized,
+		},
 		{
 			name:           "User Not Found",
 			refreshToken:   validRefreshToken,
+			mockStored:     activeToken,
 			mockUser:       nil,
 			mockError:      domain.ErrNotFound,
 			expectedResult: nil,
@@ -376,6 +723,7 @@ func TestRefreshToken(t *testing.T) {
 		{
 			name:           "Database Error",
 			refreshToken:   validRefreshToken,
+			mockStored:     activeToken,
 			mockUser:       nil,
 			mockError:      domain.ErrInternal,
 			expectedResult: nil,
@@ -387,14 +735,40 @@ func TestRefreshToken(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockAuthRepo := new(mocks.AuthRepository)
 			mockUserRepo := new(mocks.UserRepository)
+			mockRefreshTokenRepo := new(mocks.RefreshTokenRepository)
+			mockRevocationRepo := new(mocks.TokenRevocationRepository)
+			mockSessionRepo := new(mocks.SessionRepository)
 
-			// 유효한 토큰이고 사용자 조회 모킹이 필요한 경우
-			if tt.refreshToken == validRefreshToken && tt.name != "Invalid Token" {
+			// 유효한 토큰이면 저장소 조회를 모킹한다
+			if tt.refreshToken == validRefreshToken {
+				mockRefreshTokenRepo.On("GetByHash", mock.Anything, security.HashToken(validRefreshToken)).Return(tt.mockStored, tt.mockStoredErr)
+			}
+			// 활성 토큰이면 사용자 조회를 모킹한다
+			if tt.mockStored == activeToken {
 				mockUserRepo.On("GetByID", mock.Anything, user.ID).Return(tt.mockUser, tt.mockError)
 			}
+			if tt.mockUser != nil {
+				mockRefreshTokenRepo.On("MarkRotated", mock.Anything, activeToken.ID).Return(tt.mockRotateErr)
+			}
+			// 교체에 성공하면 세션의 마지막 사용 시각을 갱신한다
+			if tt.mockUser != nil && tt.mockRotateErr == nil {
+				mockSessionRepo.On("Touch", mock.Anything, "family-1", mock.Anything, mock.AnythingOfType("time.Time")).Return(tt.mockTouchErr)
+			}
+			if tt.expectRevoke {
+				mockRefreshTokenRepo.On("RevokeFamily", mock.Anything, "family-1").Return(nil)
+			}
+			// 재사용이 감지되면 세션도 삭제한다
+			if tt.expectRevoke && tt.mockTouchErr == nil {
+				mockSessionRepo.On("Delete", mock.Anything, user.ID, "family-1").Return(nil)
+			}
+			if tt.expectIssue {
+				// 같은 family 로 새 리프레시 토큰이 저장되어야 한다
+				mockRefreshTokenRepo.On("Save", mock.Anything, mock.MatchedBy(func(token *domain.RefreshToken) bool {
+					return token.FamilyID == "family-1" && token.TokenHash != security.HashToken(validRefreshToken)
+				})).Return(&domain.RefreshToken{ID: 2}, nil)
+			}
 
-			uc, err := usecase.NewAuthUseCase(mockAuthRepo, mockUserRepo, cfg)
-			assert.NoError(t, err)
+			uc := usecase.NewAuthUseCase(mockAuthRepo, mockUserRepo, mockRefreshTokenRepo, mockRevocationRepo, new(mocks.OneTimeTokenRepository), new(mocks.MFARepository), new(mocks.LoginAttemptRepository), mockSessionRepo, new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
 
 			ctx := context.Background()
 			result, err := uc.RefreshToken(ctx, tt.refreshToken)
@@ -412,6 +786,465 @@ func TestRefreshToken(t *testing.T) {
 			}
 
 			mockUserRepo.AssertExpectations(t)
+			mockRefreshTokenRepo.AssertExpectations(t)
+			mockSessionRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRevokeUserTokens(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	cfg := &config.Config{
+		Secure: config.SecureConfig{
+			JWT: config.JWTConfig{
+				AccessExpirationMin:  15,
+				RefreshExpirationDay: 7,
+			},
+		},
+	}
+
+	user := &domain.User{ID: 1, Email: "test@example.com", Roles: []string{domain.RoleUser}}
+
+	tests := []struct {
+		name             string
+		userID           int64
+		impersonationMin int
+		mockUser         *domain.User
+		mockError        error
+		expectRetention  time.Duration
+		expectErr        error
+	}{
+		{
+			name:            "Success",
+			userID:          1,
+			mockUser:        user,
+			expectRetention: 15 * time.Minute,
+			expectErr:       nil,
+		},
+		{
+			// 대리 로그인 토큰이 액세스 토큰보다 오래 살면 그만큼 기록을 유지해야 한다
+			name:             "Impersonation Token Outlives Access Token",
+			userID:           1,
+			impersonationMin: 60,
+			mockUser:         user,
+			expectRetention:  60 * time.Minute,
+			expectErr:        nil,
+		},
+		{
+			name:      "User Not Found",
+			userID:    2,
+			mockError: domain.ErrNotFound,
+			expectErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAuthRepo := new(mocks.AuthRepository)
+			mockUserRepo := new(mocks.UserRepository)
+			mockRefreshTokenRepo := new(mocks.RefreshTokenRepository)
+			mockRevocationRepo := new(mocks.TokenRevocationRepository)
+
+			mockUserRepo.On("GetByID", mock.Anything, tt.userID).Return(tt.mockUser, tt.mockError)
+			if tt.expectErr == nil {
+				// 기준 시각 이후 가장 긴 토큰 수명만큼 기록이 유지되어야 한다
+				mockRevocationRepo.On("RevokeUserTokens", mock.Anything, tt.userID, mock.AnythingOfType("time.Time"),
+					mock.MatchedBy(func(expiresAt time.Time) bool {
+						return expiresAt.After(time.Now().Add(tt.expectRetention - time.Minute))
+					})).Return(nil)
+				mockRefreshTokenRepo.On("RevokeAllByUserID", mock.Anything, tt.userID).Return(nil)
+			}
+
+			caseCfg := *cfg
+			caseCfg.Secure.Impersonation.TokenExpirationMin = tt.impersonationMin
+
+			uc := usecase.NewAuthUseCase(mockAuthRepo, mockUserRepo, mockRefreshTokenRepo, mockRevocationRepo, new(mocks.OneTimeTokenRepository), new(mocks.MFARepository), new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, &caseCfg)
+
+			err = uc.RevokeUserTokens(context.Background(), tt.userID)
+			assert.Equal(t, tt.expectErr, err)
+
+			mockUserRepo.AssertExpectations(t)
+			mockRefreshTokenRepo.AssertExpectations(t)
+			mockRevocationRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// 폐기 직전, 같은 초에 발급한 액세스 토큰도 폐기되어야 한다 (iat 는 초 단위로 내림된다)
+func TestRevokeUserTokensSameSecond(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	cfg := &config.Config{
+		Secure: config.SecureConfig{
+			JWT: config.JWTConfig{
+				AccessExpirationMin:  15,
+				RefreshExpirationDay: 7,
+			},
+		},
+	}
+
+	user := &domain.User{ID: 1, Email: "test@example.com", Roles: []string{domain.RoleUser}}
+	accessToken, err := security.GenerateAccessToken(user.ID, user.Email, user.Roles, keyRing, 15*time.Minute)
+	assert.NoError(t, err)
+	claims, err := security.ValidateAccessToken(accessToken, keyRing)
+	assert.NoError(t, err)
+
+	mockUserRepo := new(mocks.UserRepository)
+	mockRefreshTokenRepo := new(mocks.RefreshTokenRepository)
+	revocationRepo := memory.NewTokenRevocationRepository()
+
+	mockUserRepo.On("GetByID", mock.Anything, user.ID).Return(user, nil)
+	mockRefreshTokenRepo.On("RevokeAllByUserID", mock.Anything, user.ID).Return(nil)
+
+	uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, mockRefreshTokenRepo, revocationRepo, new(mocks.OneTimeTokenRepository), new(mocks.MFARepository), new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
+
+	err = uc.RevokeUserTokens(context.Background(), user.ID)
+	assert.NoError(t, err)
+
+	revoked, err := revocationRepo.IsRevoked(context.Background(), claims.ID, user.ID, claims.IssuedAt.Time)
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+func TestForgotPassword(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	cfg := &config.Config{
+		Secure: config.SecureConfig{
+			JWT: config.JWTConfig{
+				AccessExpirationMin:  15,
+				RefreshExpirationDay: 7,
+			},
+			PasswordReset: config.PasswordResetConfig{
+				URL:                "https://example.com/reset-password",
+				TokenExpirationMin: 30,
+			},
+		},
+	}
+
+	user := &domain.User{ID: 1, Email: "test@example.com", Roles: []string{domain.RoleUser}}
+
+	tests := []struct {
+		name      string
+		email     string
+		mockUser  *domain.User
+		mockError error
+		mailError error
+		expectErr error
+	}{
+		{
+			name:     "Success",
+			email:    "test@example.com",
+			mockUser: user,
+		},
+		{
+			name:      "Unknown Email",
+			email:     "unknown@example.com",
+			mockError: domain.ErrNotFound,
+		},
+		{
+			name:      "Mail Failure Is Not Reported",
+			email:     "test@example.com",
+			mockUser:  user,
+			mailError: errors.New("smtp error"),
+		},
+		{
+			name:      "Database Error",
+			email:     "test@example.com",
+			mockError: errors.New("database error"),
+			expectErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := new(mocks.UserRepository)
+			mockOneTimeTokenRepo := new(mocks.OneTimeTokenRepository)
+			mockMailer := new(mocks.Mailer)
+
+			mockUserRepo.On("GetUserByEmail", mock.Anything, tt.email).Return(tt.mockUser, tt.mockError)
+
+			var savedHash string
+			if tt.mockUser != nil {
+				mockOneTimeTokenRepo.On("DeleteByUserID", mock.Anything, user.ID, domain.PurposePasswordReset).Return(nil)
+				mockOneTimeTokenRepo.On("Save", mock.Anything, mock.MatchedBy(func(token *domain.OneTimeToken) bool {
+					return token.UserID == user.ID &&
+						token.Purpose == domain.PurposePasswordReset &&
+						token.ExpiresAt.After(time.Now().Add(29*time.Minute))
+				})).Return(func(_ context.Context, token *domain.OneTimeToken) *domain.OneTimeToken {
+					savedHash = token.TokenHash
+					return token
+				}, nil)
+				mockMailer.On("Send", mock.Anything, mock.MatchedBy(func(mail *domain.Mail) bool {
+					return mail.To == user.Email
+				})).Return(tt.mailError)
+			}
+
+			uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, new(mocks.RefreshTokenRepository), new(mocks.TokenRevocationRepository), mockOneTimeTokenRepo, new(mocks.MFARepository), new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, mockMailer, keyRing, nil, nil, cfg)
+
+			err := uc.ForgotPassword(context.Background(), tt.email)
+			assert.Equal(t, tt.expectErr, err)
+
+			if tt.mockUser != nil {
+				// 메일에는 토큰 원문이, 저장소에는 해시만 전달되어야 한다
+				mail := mockMailer.Calls[0].Arguments.Get(1).(*domain.Mail)
+				assert.Contains(t, mail.Body, "https://example.com/reset-password?token=")
+				assert.NotContains(t, mail.Body, savedHash)
+			}
+
+			mockUserRepo.AssertExpectations(t)
+			mockOneTimeTokenRepo.AssertExpectations(t)
+			mockMailer.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	cfg := &config.Config{
+		Secure: config.SecureConfig{
+			JWT: config.JWTConfig{
+				AccessExpirationMin:  15,
+				RefreshExpirationDay: 7,
+			},
+		},
+	}
+
+	token := &domain.OneTimeToken{ID: 1, UserID: 1, Purpose: domain.PurposePasswordReset}
+	user := &domain.User{ID: 1, Email: "resetuser@example.com"}
+
+	tests := []struct {
+		name         string
+		password     string
+		findToken    *domain.OneTimeToken
+		findError    error
+		consume      bool // 정책 검사를 통과해 토큰을 사용 처리하는지
+		consumeError error
+		expectErr    error
+		expectCodes  []string // 정책 위반 사유 코드
+	}{
+		{
+			name:      "Success",
+			password:  "newpassword123",
+			findToken: token,
+			consume:   true,
+		},
+		{
+			name:      "Invalid Or Used Token",
+			password:  "newpassword123",
+			findError: domain.ErrNotFound,
+			expectErr: domain.ErrInvalidToken,
+		},
+		{
+			name:        "Weak Password Keeps Token",
+			password:    "resetuser!",
+			findToken:   token,
+			expectErr:   domain.ErrWeakPassword,
+			expectCodes: []string{security.ViolationContainsEmail},
+		},
+		{
+			name:         "Token Used Concurrently",
+			password:     "newpassword123",
+			findToken:    token,
+			consume:      true,
+			consumeError: domain.ErrNotFound,
+			expectErr:    domain.ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := new(mocks.UserRepository)
+			mockRefreshTokenRepo := new(mocks.RefreshTokenRepository)
+			mockRevocationRepo := new(mocks.TokenRevocationRepository)
+			mockOneTimeTokenRepo := new(mocks.OneTimeTokenRepository)
+
+			tokenHash := security.HashToken("reset-token")
+			mockOneTimeTokenRepo.On("Find", mock.Anything, domain.PurposePasswordReset, tokenHash).
+				Return(tt.findToken, tt.findError)
+			if tt.findToken != nil {
+				mockUserRepo.On("GetByID", mock.Anything, int64(1)).Return(user, nil)
+			}
+			if tt.consume {
+				var consumed *domain.OneTimeToken
+				if tt.consumeError == nil {
+					consumed = token
+				}
+				mockOneTimeTokenRepo.On("Consume", mock.Anything, domain.PurposePasswordReset, tokenHash).
+					Return(consumed, tt.consumeError)
+			}
+			if tt.expectErr == nil {
+				mockUserRepo.On("UpdatePassword", mock.Anything, int64(1), mock.MatchedBy(func(hash string) bool {
+					match, err := security.ComparePasswordHash(tt.password, hash)
+					return err == nil && match
+				})).Return(nil)
+				// 비밀번호가 바뀌면 기존 토큰은 모두 폐기된다
+				mockRevocationRepo.On("RevokeUserTokens", mock.Anything, int64(1), mock.Anything, mock.Anything).Return(nil)
+				mockRefreshTokenRepo.On("RevokeAllByUserID", mock.Anything, int64(1)).Return(nil)
+			}
+
+			uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, mockRefreshTokenRepo, mockRevocationRepo, mockOneTimeTokenRepo, new(mocks.MFARepository), new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
+
+			err := uc.ResetPassword(context.Background(), &domain.ResetPasswordRequest{Token: "reset-token", Password: tt.password})
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.expectCodes != nil {
+				var policyErr *domain.PasswordPolicyError
+				assert.ErrorAs(t, err, &policyErr)
+				var codes []string
+				for _, reason := range policyErr.Reasons {
+					codes = append(codes, reason.Code)
+				}
+				assert.Equal(t, tt.expectCodes, codes)
+			}
+
+			mockUserRepo.AssertExpectations(t)
+			mockRefreshTokenRepo.AssertExpectations(t)
+			mockRevocationRepo.AssertExpectations(t)
+			mockOneTimeTokenRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestVerifyEmail(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	cfg := &config.Config{}
+
+	tests := []struct {
+		name         string
+		consumeToken *domain.OneTimeToken
+		consumeError error
+		expectErr    error
+	}{
+		{
+			name:         "Success",
+			consumeToken: &domain.OneTimeToken{ID: 1, UserID: 1, Purpose: domain.PurposeEmailVerification},
+		},
+		{
+			name:         "Invalid Or Used Token",
+			consumeError: domain.ErrNotFound,
+			expectErr:    domain.ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := new(mocks.UserRepository)
+			mockOneTimeTokenRepo := new(mocks.OneTimeTokenRepository)
+
+			mockOneTimeTokenRepo.On("Consume", mock.Anything, domain.PurposeEmailVerification, security.HashToken("verify-token")).
+				Return(tt.consumeToken, tt.consumeError)
+			if tt.expectErr == nil {
+				mockUserRepo.On("MarkVerified", mock.Anything, int64(1)).Return(nil)
+			}
+
+			uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, new(mocks.RefreshTokenRepository), new(mocks.TokenRevocationRepository), mockOneTimeTokenRepo, new(mocks.MFARepository), new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
+
+			err := uc.VerifyEmail(context.Background(), "verify-token")
+			assert.Equal(t, tt.expectErr, err)
+
+			mockUserRepo.AssertExpectations(t)
+			mockOneTimeTokenRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResendVerification(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	cfg := &config.Config{
+		Secure: config.SecureConfig{
+			EmailVerification: config.EmailVerificationConfig{
+				URL:                "https://example.com/auth/verify",
+				TokenExpirationMin: 60,
+				ResendIntervalSec:  60,
+			},
+		},
+	}
+
+	verifiedAt := time.Now()
+	unverifiedUser := &domain.User{ID: 1, Email: "test@example.com"}
+	verifiedUser := &domain.User{ID: 1, Email: "test@example.com", VerifiedAt: &verifiedAt}
+
+	tests := []struct {
+		name        string
+		mockUser    *domain.User
+		mockError   error
+		lastSentAt  time.Time
+		lastSentErr error
+		expectSend  bool
+	}{
+		{
+			name:        "Success",
+			mockUser:    unverifiedUser,
+			lastSentAt:  time.Now().Add(-2 * time.Minute),
+			lastSentErr: nil,
+			expectSend:  true,
+		},
+		{
+			name:        "First Token",
+			mockUser:    unverifiedUser,
+			lastSentErr: domain.ErrNotFound,
+			expectSend:  true,
+		},
+		{
+			name:        "Throttled",
+			mockUser:    unverifiedUser,
+			lastSentAt:  time.Now().Add(-10 * time.Second),
+			lastSentErr: nil,
+			expectSend:  false,
+		},
+		{
+			name:       "Already Verified",
+			mockUser:   verifiedUser,
+			expectSend: false,
+		},
+		{
+			name:       "Unknown Email",
+			mockError:  domain.ErrNotFound,
+			expectSend: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := new(mocks.UserRepository)
+			mockOneTimeTokenRepo := new(mocks.OneTimeTokenRepository)
+			mockMailer := new(mocks.Mailer)
+
+			mockUserRepo.On("GetUserByEmail", mock.Anything, "test@example.com").Return(tt.mockUser, tt.mockError)
+			if tt.mockUser != nil && !tt.mockUser.IsVerified() {
+				mockOneTimeTokenRepo.On("LatestCreatedAt", mock.Anything, int64(1), domain.PurposeEmailVerification).
+					Return(tt.lastSentAt, tt.lastSentErr)
+			}
+			if tt.expectSend {
+				mockOneTimeTokenRepo.On("DeleteByUserID", mock.Anything, int64(1), domain.PurposeEmailVerification).Return(nil)
+				mockOneTimeTokenRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.OneTimeToken")).Return(&domain.OneTimeToken{}, nil)
+				mockMailer.On("Send", mock.Anything, mock.MatchedBy(func(mail *domain.Mail) bool {
+					return mail.To == "test@example.com" && strings.Contains(mail.Body, "https://example.com/auth/verify?token=")
+				})).Return(nil)
+			}
+
+			uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, new(mocks.RefreshTokenRepository), new(mocks.TokenRevocationRepository), mockOneTimeTokenRepo, new(mocks.MFARepository), new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, mockMailer, keyRing, nil, nil, cfg)
+
+			err := uc.ResendVerification(context.Background(), "test@example.com")
+			assert.NoError(t, err)
+
+			mockUserRepo.AssertExpectations(t)
+			mockOneTimeTokenRepo.AssertExpectations(t)
+			mockMailer.AssertExpectations(t)
 		})
 	}
 }
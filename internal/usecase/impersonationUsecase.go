@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/pkg/contextutil"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+// defaultImpersonationExpiration 은 impersonation.token_expiration_min 을 설정하지 않았을 때의 대리 로그인 토큰 만료 시간이다
+const defaultImpersonationExpiration = 15 * time.Minute
+
+// Impersonate 요청한 관리자가 사용자로 대리 로그인할 수 있는 액세스 토큰을 발급한다
+// 토큰에는 사용자의 역할이 담기고 act claim 으로 관리자를 기록한다. 리프레시 토큰은 발급하지 않으므로 만료되면 다시 요청해야 한다
+// 자기 자신, Admin 역할을 가진 사용자로는 대리 로그인할 수 없고, 대리 로그인 중에 다시 대리 로그인할 수도 없다
+func (uc *authUseCase) Impersonate(ctx context.Context, userID int64) (*domain.ImpersonationResponse, error) {
+	principal, err := requirePrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if principal.IsService() || principal.IsImpersonated() || principal.UserID == userID {
+		return nil, domain.ErrForbidden
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	// 다른 관리자의 권한으로 작업하면 누가 한 일인지 흐려지므로 막는다
+	if user.HasRole(domain.RoleAdmin) {
+		return nil, domain.ErrForbidden
+	}
+
+	expiration := uc.impersonationExpiration()
+	accessToken, err := security.GenerateImpersonationAccessToken(
+		user.ID,
+		user.Email,
+		user.Roles,
+		principal.UserID,
+		principal.Email,
+		uc.keyRing,
+		expiration,
+	)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := time.Now().Add(expiration)
+
+	logger := contextutil.GetLogger(ctx)
+	logger.Warn("Impersonation started",
+		slog.Int64("user_id", user.ID),
+		slog.Int64("actor_id", principal.UserID),
+		slog.Time("expires_at", expiresAt),
+	)
+
+	return &domain.ImpersonationResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(expiration.Seconds()),
+		ExpiresAt:   expiresAt,
+		UserID:      user.ID,
+		Email:       user.Email,
+		ActorID:     principal.UserID,
+	}, nil
+}
+
+// forbidImpersonation 은 대리 로그인한 관리자가 사용자의 인증 수단(비밀번호, 이메일, MFA, API 키)을 바꾸지 못하게 한다
+func forbidImpersonation(ctx context.Context) error {
+	if principal, ok := contextutil.PrincipalFrom(ctx); ok && principal.IsImpersonated() {
+		contextutil.GetLogger(ctx).Warn("Credential change blocked during impersonation",
+			slog.Int64("user_id", principal.UserID),
+			slog.Int64("actor_id", principal.ActorID),
+		)
+		return domain.ErrImpersonated
+	}
+	return nil
+}
+
+func (uc *authUseCase) impersonationExpiration() time.Duration {
+	if minutes := uc.config.Secure.Impersonation.TokenExpirationMin; minutes > 0 {
+		return time.Duration(minutes) * time.Minute
+	}
+	return defaultImpersonationExpiration
+}
@@ -0,0 +1,141 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicewook/gocore/internal/config"
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/internal/domain/mocks"
+	"github.com/nicewook/gocore/internal/usecase"
+	"github.com/nicewook/gocore/pkg/contextutil"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+func TestImpersonate(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	cfg := &config.Config{
+		Secure: config.SecureConfig{
+			Impersonation: config.ImpersonationConfig{TokenExpirationMin: 10},
+		},
+	}
+	admin := &contextutil.Principal{UserID: 9, Email: "admin@example.com", Roles: []string{domain.RoleAdmin}}
+
+	tests := []struct {
+		name      string
+		principal *contextutil.Principal
+		userID    int64
+		mockUser  *domain.User
+		mockErr   error
+		expectErr error
+	}{
+		{
+			name:      "Success",
+			principal: admin,
+			userID:    1,
+			mockUser:  &domain.User{ID: 1, Email: "user@example.com", Roles: []string{domain.RoleUser}},
+		},
+		{
+			name:      "No Principal",
+			userID:    1,
+			expectErr: domain.ErrUnauthorized,
+		},
+		{
+			name:      "Self",
+			principal: admin,
+			userID:    9,
+			expectErr: domain.ErrForbidden,
+		},
+		{
+			name:      "Already Impersonating",
+			principal: &contextutil.Principal{UserID: 2, Roles: []string{domain.RoleManager}, ActorID: 9},
+			userID:    1,
+			expectErr: domain.ErrForbidden,
+		},
+		{
+			name:      "Target Is Admin",
+			principal: admin,
+			userID:    3,
+			mockUser:  &domain.User{ID: 3, Email: "other-admin@example.com", Roles: []string{domain.RoleAdmin}},
+			expectErr: domain.ErrForbidden,
+		},
+		{
+			name:      "User Not Found",
+			principal: admin,
+			userID:    4,
+			mockErr:   domain.ErrNotFound,
+			expectErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := new(mocks.UserRepository)
+			if tt.mockUser != nil || tt.mockErr != nil {
+				mockUserRepo.On("GetByID", mock.Anything, tt.userID).Return(tt.mockUser, tt.mockErr)
+			}
+
+			uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, new(mocks.RefreshTokenRepository), new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), new(mocks.MFARepository), new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
+
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = contextutil.WithPrincipal(ctx, tt.principal)
+			}
+			response, err := uc.Impersonate(ctx, tt.userID)
+
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+				assert.Nil(t, response)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.userID, response.UserID)
+				assert.Equal(t, admin.UserID, response.ActorID)
+				assert.Equal(t, 600, response.ExpiresIn)
+
+				claims, err := security.ValidateAccessToken(response.AccessToken, keyRing)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.userID, claims.UserID)
+				assert.Equal(t, tt.mockUser.Roles, claims.Roles)
+				if assert.NotNil(t, claims.Actor) {
+					assert.Equal(t, admin.UserID, claims.Actor.UserID)
+				}
+			}
+
+			mockUserRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImpersonatedCredentialChange(t *testing.T) {
+	cfg := &config.Config{}
+	mockUserRepo := new(mocks.UserRepository)
+	mockMFARepo := new(mocks.MFARepository)
+
+	uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, new(mocks.RefreshTokenRepository), new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), mockMFARepo, new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), nil, nil, nil, cfg)
+
+	// 대리 로그인 중에는 저장소를 조회하기 전에 거부한다
+	ctx := contextutil.WithPrincipal(context.Background(), &contextutil.Principal{UserID: 1, ActorID: 9})
+
+	_, err := uc.ChangePassword(ctx, 1, &domain.ChangePasswordRequest{CurrentPassword: "old", NewPassword: "new-password"})
+	assert.ErrorIs(t, err, domain.ErrImpersonated)
+
+	_, err = uc.UpdateProfile(ctx, 1, &domain.UpdateProfileRequest{Name: "Mallory", Email: "mallory@example.com", CurrentPassword: "old"})
+	assert.ErrorIs(t, err, domain.ErrImpersonated)
+
+	_, err = uc.EnrollMFA(ctx, 1)
+	assert.ErrorIs(t, err, domain.ErrImpersonated)
+
+	_, err = uc.ConfirmMFA(ctx, 1, "123456")
+	assert.ErrorIs(t, err, domain.ErrImpersonated)
+
+	err = uc.DisableMFA(ctx, 1, "123456")
+	assert.ErrorIs(t, err, domain.ErrImpersonated)
+
+	mockUserRepo.AssertExpectations(t)
+	mockMFARepo.AssertExpectations(t)
+}
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/pkg/contextutil"
+)
+
+// 로그인 실패 횟수 제한 관련 authUseCase 메서드
+// 이메일과 IP 를 각각 key 로 실패 횟수를 세고, 허용 횟수를 넘으면 실패할 때마다 잠금 시간을 두 배로 늘린다.
+// 가입되지 않은 이메일도 똑같이 세어 잠금 여부로 가입 여부를 알 수 없게 한다.
+
+// throttleKey 는 실패 횟수를 세는 기준과 그 기준의 허용 실패 횟수이다
+type throttleKey struct {
+	key         string
+	maxFailures int
+}
+
+func emailThrottleKey(email string) string {
+	return "email:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+// loginThrottleKeys 는 제한을 사용하는 기준의 key 목록을 반환한다
+func (uc *authUseCase) loginThrottleKeys(ctx context.Context, email string) []throttleKey {
+	throttleCfg := uc.config.Secure.LoginThrottle
+
+	var keys []throttleKey
+	if throttleCfg.MaxFailures > 0 {
+		keys = append(keys, throttleKey{key: emailThrottleKey(email), maxFailures: throttleCfg.MaxFailures})
+	}
+	if ip := contextutil.GetClientIP(ctx); ip != "" && throttleCfg.IPMaxFailures > 0 {
+		keys = append(keys, throttleKey{key: "ip:" + ip, maxFailures: throttleCfg.IPMaxFailures})
+	}
+	return keys
+}
+
+// checkLoginLocked 는 잠긴 key 가 하나라도 있으면 ErrTooManyAttempts 를 반환한다
+func (uc *authUseCase) checkLoginLocked(ctx context.Context, keys []throttleKey) error {
+	for _, k := range keys {
+		lockedUntil, err := uc.loginAttemptRepo.LockedUntil(ctx, k.key)
+		if err != nil {
+			return err
+		}
+		if !lockedUntil.IsZero() {
+			return domain.ErrTooManyAttempts
+		}
+	}
+	return nil
+}
+
+// recordLoginFailure 는 각 key 의 실패 횟수를 늘리고, 허용 횟수를 넘은 key 를 잠근다
+func (uc *authUseCase) recordLoginFailure(ctx context.Context, keys []throttleKey) error {
+	throttleCfg := uc.config.Secure.LoginThrottle
+	window := time.Duration(throttleCfg.WindowMin) * time.Minute
+
+	for _, k := range keys {
+		failures, err := uc.loginAttemptRepo.RecordFailure(ctx, k.key, window)
+		if err != nil {
+			return err
+		}
+		if failures < k.maxFailures {
+			continue
+		}
+
+		lockout := lockoutDuration(failures-k.maxFailures,
+			time.Duration(throttleCfg.BaseLockoutSec)*time.Second,
+			time.Duration(throttleCfg.MaxLockoutMin)*time.Minute)
+		if err := uc.loginAttemptRepo.Lock(ctx, k.key, time.Now().Add(lockout)); err != nil {
+			return err
+		}
+		contextutil.GetLogger(ctx).Warn("Login locked after repeated failures",
+			slog.String("key", k.key),
+			slog.Int("failures", failures),
+			slog.Duration("lockout", lockout),
+		)
+	}
+	return nil
+}
+
+// resetLoginFailures 는 로그인에 성공한 이메일의 실패 기록을 지운다.
+// IP 기록은 지우지 않는다. 공격자가 자신의 계정으로 로그인해 IP 기록을 초기화할 수 있기 때문이다
+func (uc *authUseCase) resetLoginFailures(ctx context.Context, email string) error {
+	if uc.config.Secure.LoginThrottle.MaxFailures <= 0 {
+		return nil
+	}
+	return uc.loginAttemptRepo.Reset(ctx, emailThrottleKey(email))
+}
+
+// lockoutDuration 은 허용 횟수를 넘은 횟수(exceeded, 0 부터)에 따라 base 를 두 배씩 늘린 잠금 시간을 max 이하로 반환한다
+func lockoutDuration(exceeded int, base, max time.Duration) time.Duration {
+	lockout := base
+	for i := 0; i < exceeded && lockout < max; i++ {
+		lockout *= 2
+	}
+	if max > 0 && lockout > max {
+		lockout = max
+	}
+	return lockout
+}
@@ -0,0 +1,206 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicewook/gocore/internal/config"
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/internal/domain/mocks"
+	"github.com/nicewook/gocore/internal/usecase"
+	"github.com/nicewook/gocore/pkg/contextutil"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+func TestLoginThrottle(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	cfg := &config.Config{
+		Secure: config.SecureConfig{
+			JWT: config.JWTConfig{
+				AccessExpirationMin:  15,
+				RefreshExpirationDay: 7,
+			},
+			LoginThrottle: config.LoginThrottleConfig{
+				MaxFailures:    3,
+				IPMaxFailures:  10,
+				WindowMin:      15,
+				BaseLockoutSec: 30,
+				MaxLockoutMin:  60,
+			},
+		},
+	}
+
+	hashedPassword, err := security.GeneratePasswordHash("password", nil)
+	assert.NoError(t, err)
+	user := &domain.User{ID: 1, Email: "test@example.com", Password: hashedPassword, Roles: []string{domain.RoleUser}}
+
+	const (
+		emailKey = "email:test@example.com"
+		ipKey    = "ip:10.0.0.1"
+	)
+	window := 15 * time.Minute
+
+	// until 이 지금부터 lockout 후인지 확인한다
+	lockedFor := func(lockout time.Duration) interface{} {
+		return mock.MatchedBy(func(until time.Time) bool {
+			remaining := time.Until(until)
+			return remaining > lockout-5*time.Second && remaining <= lockout
+		})
+	}
+
+	tests := []struct {
+		name      string
+		email     string
+		password  string
+		setup     func(mockUserRepo *mocks.UserRepository, mockAttemptRepo *mocks.LoginAttemptRepository)
+		expectErr error
+	}{
+		{
+			name:     "Locked Email",
+			email:    "Test@Example.com",
+			password: "password",
+			setup: func(mockUserRepo *mocks.UserRepository, mockAttemptRepo *mocks.LoginAttemptRepository) {
+				// 대소문자가 달라도 같은 이메일로 본다. 잠긴 동안에는 비밀번호를 확인하지 않는다
+				mockAttemptRepo.On("LockedUntil", mock.Anything, emailKey).Return(time.Now().Add(time.Minute), nil)
+			},
+			expectErr: domain.ErrTooManyAttempts,
+		},
+		{
+			name:     "Locked IP",
+			email:    "test@example.com",
+			password: "password",
+			setup: func(mockUserRepo *mocks.UserRepository, mockAttemptRepo *mocks.LoginAttemptRepository) {
+				mockAttemptRepo.On("LockedUntil", mock.Anything, emailKey).Return(time.Time{}, nil)
+				mockAttemptRepo.On("LockedUntil", mock.Anything, ipKey).Return(time.Now().Add(time.Minute), nil)
+			},
+			expectErr: domain.ErrTooManyAttempts,
+		},
+		{
+			name:     "Unknown Email Counts As Failure",
+			email:    "unknown@example.com",
+			password: "password",
+			setup: func(mockUserRepo *mocks.UserRepository, mockAttemptRepo *mocks.LoginAttemptRepository) {
+				mockAttemptRepo.On("LockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+				mockUserRepo.On("GetUserByEmail", mock.Anything, "unknown@example.com").Return(nil, domain.ErrNotFound)
+				mockAttemptRepo.On("RecordFailure", mock.Anything, "email:unknown@example.com", window).Return(1, nil)
+				mockAttemptRepo.On("RecordFailure", mock.Anything, ipKey, window).Return(1, nil)
+			},
+			expectErr: domain.ErrInvalidCredentials,
+		},
+		{
+			name:     "Failure Reaches Threshold",
+			email:    "test@example.com",
+			password: "wrongpassword",
+			setup: func(mockUserRepo *mocks.UserRepository, mockAttemptRepo *mocks.LoginAttemptRepository) {
+				mockAttemptRepo.On("LockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+				mockUserRepo.On("GetUserByEmail", mock.Anything, user.Email).Return(user, nil)
+				mockAttemptRepo.On("RecordFailure", mock.Anything, emailKey, window).Return(3, nil)
+				mockAttemptRepo.On("RecordFailure", mock.Anything, ipKey, window).Return(3, nil)
+				mockAttemptRepo.On("Lock", mock.Anything, emailKey, lockedFor(30*time.Second)).Return(nil)
+			},
+			expectErr: domain.ErrInvalidCredentials,
+		},
+		{
+			name:     "Lockout Doubles After Threshold",
+			email:    "test@example.com",
+			password: "wrongpassword",
+			setup: func(mockUserRepo *mocks.UserRepository, mockAttemptRepo *mocks.LoginAttemptRepository) {
+				mockAttemptRepo.On("LockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+				mockUserRepo.On("GetUserByEmail", mock.Anything, user.Email).Return(user, nil)
+				mockAttemptRepo.On("RecordFailure", mock.Anything, emailKey, window).Return(5, nil)
+				mockAttemptRepo.On("RecordFailure", mock.Anything, ipKey, window).Return(5, nil)
+				mockAttemptRepo.On("Lock", mock.Anything, emailKey, lockedFor(2*time.Minute)).Return(nil)
+			},
+			expectErr: domain.ErrInvalidCredentials,
+		},
+		{
+			name:     "Lockout Capped At Max",
+			email:    "test@example.com",
+			password: "wrongpassword",
+			setup: func(mockUserRepo *mocks.UserRepository, mockAttemptRepo *mocks.LoginAttemptRepository) {
+				mockAttemptRepo.On("LockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+				mockUserRepo.On("GetUserByEmail", mock.Anything, user.Email).Return(user, nil)
+				mockAttemptRepo.On("RecordFailure", mock.Anything, emailKey, window).Return(100, nil)
+				mockAttemptRepo.On("RecordFailure", mock.Anything, ipKey, window).Return(100, nil)
+				mockAttemptRepo.On("Lock", mock.Anything, emailKey, lockedFor(time.Hour)).Return(nil)
+				mockAttemptRepo.On("Lock", mock.Anything, ipKey, lockedFor(time.Hour)).Return(nil)
+			},
+			expectErr: domain.ErrInvalidCredentials,
+		},
+		{
+			name:     "Success Resets Email Failures",
+			email:    "test@example.com",
+			password: "password",
+			setup: func(mockUserRepo *mocks.UserRepository, mockAttemptRepo *mocks.LoginAttemptRepository) {
+				mockAttemptRepo.On("LockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+				mockUserRepo.On("GetUserByEmail", mock.Anything, user.Email).Return(user, nil)
+				mockAttemptRepo.On("Reset", mock.Anything, emailKey).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := new(mocks.UserRepository)
+			mockRefreshTokenRepo := new(mocks.RefreshTokenRepository)
+			mockMFARepo := new(mocks.MFARepository)
+			mockAttemptRepo := new(mocks.LoginAttemptRepository)
+
+			tt.setup(mockUserRepo, mockAttemptRepo)
+			if tt.expectErr == nil {
+				mockMFARepo.On("GetByUserID", mock.Anything, user.ID).Return(nil, domain.ErrNotFound)
+				mockRefreshTokenRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).Return(&domain.RefreshToken{ID: 1}, nil)
+			}
+
+			uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, mockRefreshTokenRepo, new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), mockMFARepo, mockAttemptRepo, newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
+
+			ctx := contextutil.WithClientIP(context.Background(), "10.0.0.1")
+			result, err := uc.Login(ctx, tt.email, tt.password)
+			if tt.expectErr != nil {
+				assert.Equal(t, tt.expectErr, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, result.AccessToken)
+			}
+
+			mockUserRepo.AssertExpectations(t)
+			mockAttemptRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUnlockUser(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	cfg := &config.Config{}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUserRepo := new(mocks.UserRepository)
+		mockAttemptRepo := new(mocks.LoginAttemptRepository)
+
+		mockUserRepo.On("GetByID", mock.Anything, int64(1)).Return(&domain.User{ID: 1, Email: "Test@Example.com"}, nil)
+		mockAttemptRepo.On("Reset", mock.Anything, "email:test@example.com").Return(nil)
+
+		uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, new(mocks.RefreshTokenRepository), new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), new(mocks.MFARepository), mockAttemptRepo, newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
+
+		assert.NoError(t, uc.UnlockUser(context.Background(), 1))
+		mockAttemptRepo.AssertExpectations(t)
+	})
+
+	t.Run("User Not Found", func(t *testing.T) {
+		mockUserRepo := new(mocks.UserRepository)
+		mockUserRepo.On("GetByID", mock.Anything, int64(999)).Return(nil, domain.ErrNotFound)
+
+		uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, new(mocks.RefreshTokenRepository), new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), new(mocks.MFARepository), new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
+
+		assert.Equal(t, domain.ErrNotFound, uc.UnlockUser(context.Background(), 999))
+	})
+}
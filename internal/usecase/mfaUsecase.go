@@ -0,0 +1,292 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/pkg/contextutil"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+// TOTP 2단계 인증 관련 authUseCase 메서드
+// 등록(EnrollMFA) 후 인증 앱의 코드로 확인(ConfirmMFA)해야 로그인에 적용되며,
+// 확인할 때 한 번만 보여주는 복구 코드를 함께 발급한다.
+
+// EnrollMFA 새 TOTP 비밀키를 발급한다. 확인 전에 다시 호출하면 비밀키가 교체된다
+func (uc *authUseCase) EnrollMFA(ctx context.Context, userID int64) (*domain.MFAEnrollResponse, error) {
+	if err := forbidImpersonation(ctx); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := security.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.mfaRepo.SaveSecret(ctx, userID, secret); err != nil {
+		if errors.Is(err, domain.ErrAlreadyExists) {
+			return nil, domain.ErrMFAAlreadyEnabled
+		}
+		return nil, err
+	}
+
+	return &domain.MFAEnrollResponse{
+		Secret: secret,
+		URI:    security.TOTPURI(uc.config.Secure.MFA.Issuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmMFA 인증 앱의 코드로 등록을 확인하고 복구 코드를 발급한다
+func (uc *authUseCase) ConfirmMFA(ctx context.Context, userID int64, code string) (*domain.MFAConfirmResponse, error) {
+	if err := forbidImpersonation(ctx); err != nil {
+		return nil, err
+	}
+
+	mfa, err := uc.mfaRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrMFAEnrollmentNeeded
+		}
+		return nil, err
+	}
+	if mfa.IsEnabled() {
+		return nil, domain.ErrMFAAlreadyEnabled
+	}
+
+	recoveryCodes, err := uc.confirmEnrollment(ctx, mfa, code)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.MFAConfirmResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+// DisableMFA TOTP 코드 또는 복구 코드를 확인하고 MFA 를 해제한다
+func (uc *authUseCase) DisableMFA(ctx context.Context, userID int64, code string) error {
+	if err := forbidImpersonation(ctx); err != nil {
+		return err
+	}
+
+	mfa, err := uc.mfaRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !mfa.IsEnabled() {
+		return domain.ErrNotFound
+	}
+
+	if err := uc.verifySecondFactor(ctx, mfa, code); err != nil {
+		return err
+	}
+
+	if err := uc.mfaRepo.Delete(ctx, userID); err != nil {
+		return err
+	}
+	contextutil.GetLogger(ctx).Info("MFA disabled", slog.Int64("user_id", userID))
+	return nil
+}
+
+// EnrollMFAWithChallenge MFA 가 필수인 역할의 사용자가 로그인 중에 등록을 시작한다
+func (uc *authUseCase) EnrollMFAWithChallenge(ctx context.Context, mfaToken string) (*domain.MFAEnrollResponse, error) {
+	claims, err := security.ValidateMFAToken(mfaToken, uc.keyRing)
+	if err != nil {
+		return nil, domain.ErrUnauthorized
+	}
+
+	return uc.EnrollMFA(ctx, claims.UserID)
+}
+
+// LoginMFA MFA 토큰과 코드를 확인하고 토큰을 발급한다
+// 로그인 중 등록을 시작한 경우에는 이 단계에서 등록을 확인하고 복구 코드도 함께 반환한다
+func (uc *authUseCase) LoginMFA(ctx context.Context, req *domain.MFALoginRequest) (*domain.LoginResponse, error) {
+	claims, err := security.ValidateMFAToken(req.MFAToken, uc.keyRing)
+	if err != nil {
+		return nil, domain.ErrUnauthorized
+	}
+
+	// MFA 코드 추측도 비밀번호와 같은 실패 횟수 제한을 받는다
+	throttleKeys := uc.loginThrottleKeys(ctx, claims.Email)
+	if err := uc.checkLoginLocked(ctx, throttleKeys); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, claims.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrUnauthorized
+		}
+		return nil, err
+	}
+	// MFA 토큰을 받은 뒤에 비활성화되었을 수 있다
+	if err := checkAccountActive(user); err != nil {
+		return nil, err
+	}
+
+	mfa, err := uc.mfaRepo.GetByUserID(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrMFAEnrollmentNeeded
+		}
+		return nil, err
+	}
+
+	var recoveryCodes []string
+	if mfa.IsEnabled() {
+		err = uc.verifySecondFactor(ctx, mfa, req.Code)
+	} else {
+		recoveryCodes, err = uc.confirmEnrollment(ctx, mfa, req.Code)
+	}
+	if errors.Is(err, domain.ErrInvalidMFACode) {
+		if err := uc.recordLoginFailure(ctx, throttleKeys); err != nil {
+			return nil, err
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := uc.startSession(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	response.RecoveryCodes = recoveryCodes
+	return response, nil
+}
+
+// mfaStatus 는 로그인에 MFA 가 필요한지, 필요한데 아직 등록하지 않았는지를 반환한다
+func (uc *authUseCase) mfaStatus(ctx context.Context, user *domain.User) (required bool, enrollmentRequired bool, err error) {
+	mfa, err := uc.mfaRepo.GetByUserID(ctx, user.ID)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return false, false, err
+	}
+	if err == nil && mfa.IsEnabled() {
+		return true, false, nil
+	}
+
+	for _, role := range uc.config.Secure.MFA.RequiredRoles {
+		if user.HasRole(role) {
+			return true, true, nil
+		}
+	}
+	return false, false, nil
+}
+
+// mfaChallenge 는 토큰 대신 두 번째 인증에 사용할 MFA 토큰을 발급한다
+func (uc *authUseCase) mfaChallenge(user *domain.User, enrollmentRequired bool) (*domain.LoginResponse, error) {
+	expiration := time.Duration(uc.config.Secure.MFA.ChallengeExpirationMin) * time.Minute
+	mfaToken, err := security.GenerateMFAToken(user.ID, user.Email, user.Roles, uc.keyRing, expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.LoginResponse{
+		ID:                    user.ID,
+		Email:                 user.Email,
+		MFARequired:           true,
+		MFAEnrollmentRequired: enrollmentRequired,
+		MFAToken:              mfaToken,
+	}, nil
+}
+
+// confirmEnrollment 는 TOTP 코드로 등록을 확인하고 새 복구 코드를 발급한다
+func (uc *authUseCase) confirmEnrollment(ctx context.Context, mfa *domain.MFA, code string) ([]string, error) {
+	if err := uc.verifyTOTP(ctx, mfa, code); err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, err := security.GenerateRecoveryCodes(uc.config.Secure.MFA.RecoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([]string, 0, len(recoveryCodes))
+	for _, recoveryCode := range recoveryCodes {
+		hash, err := security.GeneratePasswordHash(security.NormalizeRecoveryCode(recoveryCode), uc.hashParams)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+
+	// 동시에 확인 요청이 들어와 먼저 확인된 경우
+	if err := uc.mfaRepo.Enable(ctx, mfa.UserID, hashes); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrMFAAlreadyEnabled
+		}
+		return nil, err
+	}
+
+	contextutil.GetLogger(ctx).Info("MFA enabled", slog.Int64("user_id", mfa.UserID))
+	return recoveryCodes, nil
+}
+
+// verifySecondFactor 는 6자리 숫자면 TOTP 코드로, 아니면 복구 코드로 확인한다
+func (uc *authUseCase) verifySecondFactor(ctx context.Context, mfa *domain.MFA, code string) error {
+	if isTOTPCode(code) {
+		return uc.verifyTOTP(ctx, mfa, code)
+	}
+	return uc.useRecoveryCode(ctx, mfa.UserID, code)
+}
+
+// verifyTOTP 는 TOTP 코드를 확인한다. 이미 사용된 주기의 코드는 거부하여 재사용을 막는다
+func (uc *authUseCase) verifyTOTP(ctx context.Context, mfa *domain.MFA, code string) error {
+	step, ok := security.ValidateTOTPCode(mfa.Secret, code, time.Now())
+	if !ok {
+		return domain.ErrInvalidMFACode
+	}
+
+	if err := uc.mfaRepo.UpdateLastUsedStep(ctx, mfa.UserID, step); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrInvalidMFACode
+		}
+		return err
+	}
+	return nil
+}
+
+// useRecoveryCode 는 사용되지 않은 복구 코드 중 일치하는 코드를 사용 처리한다
+func (uc *authUseCase) useRecoveryCode(ctx context.Context, userID int64, code string) error {
+	codes, err := uc.mfaRepo.GetUnusedRecoveryCodes(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	normalized := security.NormalizeRecoveryCode(code)
+	for _, recoveryCode := range codes {
+		match, err := security.ComparePasswordHash(normalized, recoveryCode.CodeHash)
+		if err != nil || !match {
+			continue
+		}
+
+		if err := uc.mfaRepo.UseRecoveryCode(ctx, recoveryCode.ID); err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return domain.ErrInvalidMFACode
+			}
+			return err
+		}
+		contextutil.GetLogger(ctx).Warn("MFA recovery code used",
+			slog.Int64("user_id", userID),
+			slog.Int("remaining", len(codes)-1),
+		)
+		return nil
+	}
+	return domain.ErrInvalidMFACode
+}
+
+func isTOTPCode(code string) bool {
+	if len(code) != 6 {
+		return false
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
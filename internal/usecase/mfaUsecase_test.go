@@ -0,0 +1,396 @@
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicewook/gocore/internal/config"
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/internal/domain/mocks"
+	"github.com/nicewook/gocore/internal/usecase"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+func newMFATestConfig() *config.Config {
+	return &config.Config{
+		Secure: config.SecureConfig{
+			JWT: config.JWTConfig{
+				AccessExpirationMin:  60,
+				RefreshExpirationDay: 30,
+			},
+			MFA: config.MFAConfig{
+				Issuer:                 "gocore",
+				ChallengeExpirationMin: 5,
+				RecoveryCodeCount:      2,
+				RequiredRoles:          []string{domain.RoleAdmin},
+			},
+		},
+	}
+}
+
+func newEnabledMFA(t *testing.T) *domain.MFA {
+	secret, err := security.GenerateTOTPSecret()
+	assert.NoError(t, err)
+
+	enabledAt := time.Now()
+	return &domain.MFA{UserID: 1, Secret: secret, EnabledAt: &enabledAt}
+}
+
+func TestLoginMFAChallenge(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	cfg := newMFATestConfig()
+	hashedPassword, err := security.GeneratePasswordHash("password", nil)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name                 string
+		roles                []string
+		mockMFA              *domain.MFA
+		mockMFAError         error
+		expectChallenge      bool
+		expectEnrollRequired bool
+	}{
+		{
+			name:            "MFA Enabled",
+			roles:           []string{domain.RoleUser},
+			mockMFA:         newEnabledMFA(t),
+			expectChallenge: true,
+		},
+		{
+			name:                 "Required Role Without Enrollment",
+			roles:                []string{domain.RoleAdmin},
+			mockMFAError:         domain.ErrNotFound,
+			expectChallenge:      true,
+			expectEnrollRequired: true,
+		},
+		{
+			name:            "MFA Not Enabled",
+			roles:           []string{domain.RoleUser},
+			mockMFA:         &domain.MFA{UserID: 1, Secret: "PENDING"},
+			expectChallenge: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := &domain.User{ID: 1, Email: "test@example.com", Password: hashedPassword, Roles: tt.roles}
+
+			mockUserRepo := new(mocks.UserRepository)
+			mockRefreshTokenRepo := new(mocks.RefreshTokenRepository)
+			mockMFARepo := new(mocks.MFARepository)
+
+			mockUserRepo.On("GetUserByEmail", mock.Anything, user.Email).Return(user, nil)
+			mockMFARepo.On("GetByUserID", mock.Anything, user.ID).Return(tt.mockMFA, tt.mockMFAError)
+			if !tt.expectChallenge {
+				mockRefreshTokenRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).Return(&domain.RefreshToken{ID: 1}, nil)
+			}
+
+			uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, mockRefreshTokenRepo, new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), mockMFARepo, new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
+
+			result, err := uc.Login(context.Background(), user.Email, "password")
+			assert.NoError(t, err)
+
+			if tt.expectChallenge {
+				// 두 번째 인증 전에는 액세스 토큰과 리프레시 토큰을 발급하지 않는다
+				assert.True(t, result.MFARequired)
+				assert.Equal(t, tt.expectEnrollRequired, result.MFAEnrollmentRequired)
+				assert.Empty(t, result.AccessToken)
+				assert.Empty(t, result.RefreshToken)
+
+				claims, err := security.ValidateMFAToken(result.MFAToken, keyRing)
+				assert.NoError(t, err)
+				assert.Equal(t, user.ID, claims.UserID)
+			} else {
+				assert.False(t, result.MFARequired)
+				assert.NotEmpty(t, result.AccessToken)
+			}
+
+			mockUserRepo.AssertExpectations(t)
+			mockRefreshTokenRepo.AssertExpectations(t)
+			mockMFARepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEnrollMFA(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	cfg := newMFATestConfig()
+	user := &domain.User{ID: 1, Email: "test@example.com"}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUserRepo := new(mocks.UserRepository)
+		mockMFARepo := new(mocks.MFARepository)
+
+		mockUserRepo.On("GetByID", mock.Anything, user.ID).Return(user, nil)
+		mockMFARepo.On("SaveSecret", mock.Anything, user.ID, mock.AnythingOfType("string")).Return(nil)
+
+		uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, new(mocks.RefreshTokenRepository), new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), mockMFARepo, new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
+
+		result, err := uc.EnrollMFA(context.Background(), user.ID)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, result.Secret)
+		assert.True(t, strings.HasPrefix(result.URI, "otpauth://totp/gocore:test@example.com?"))
+		assert.Contains(t, result.URI, "secret="+result.Secret)
+
+		mockMFARepo.AssertExpectations(t)
+	})
+
+	t.Run("Already Enabled", func(t *testing.T) {
+		mockUserRepo := new(mocks.UserRepository)
+		mockMFARepo := new(mocks.MFARepository)
+
+		mockUserRepo.On("GetByID", mock.Anything, user.ID).Return(user, nil)
+		mockMFARepo.On("SaveSecret", mock.Anything, user.ID, mock.AnythingOfType("string")).Return(domain.ErrAlreadyExists)
+
+		uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, new(mocks.RefreshTokenRepository), new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), mockMFARepo, new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
+
+		_, err := uc.EnrollMFA(context.Background(), user.ID)
+		assert.Equal(t, domain.ErrMFAAlreadyEnabled, err)
+	})
+}
+
+func TestConfirmMFA(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	cfg := newMFATestConfig()
+	secret, err := security.GenerateTOTPSecret()
+	assert.NoError(t, err)
+	validCode, err := security.GenerateTOTPCode(secret, time.Now())
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		code         string
+		mockMFA      *domain.MFA
+		mockMFAError error
+		expectEnable bool
+		expectErr    error
+	}{
+		{
+			name:         "Success",
+			code:         validCode,
+			mockMFA:      &domain.MFA{UserID: 1, Secret: secret},
+			expectEnable: true,
+		},
+		{
+			name:      "Invalid Code",
+			code:      "000000",
+			mockMFA:   &domain.MFA{UserID: 1, Secret: secret},
+			expectErr: domain.ErrInvalidMFACode,
+		},
+		{
+			name:         "Not Enrolled",
+			code:         validCode,
+			mockMFAError: domain.ErrNotFound,
+			expectErr:    domain.ErrMFAEnrollmentNeeded,
+		},
+		{
+			name:      "Already Enabled",
+			code:      validCode,
+			mockMFA:   newEnabledMFA(t),
+			expectErr: domain.ErrMFAAlreadyEnabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockMFARepo := new(mocks.MFARepository)
+
+			mockMFARepo.On("GetByUserID", mock.Anything, int64(1)).Return(tt.mockMFA, tt.mockMFAError)
+			if tt.expectEnable {
+				mockMFARepo.On("UpdateLastUsedStep", mock.Anything, int64(1), mock.AnythingOfType("int64")).Return(nil)
+				mockMFARepo.On("Enable", mock.Anything, int64(1), mock.MatchedBy(func(hashes []string) bool {
+					return len(hashes) == cfg.Secure.MFA.RecoveryCodeCount
+				})).Return(nil)
+			}
+
+			uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), new(mocks.UserRepository), new(mocks.RefreshTokenRepository), new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), mockMFARepo, new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
+
+			result, err := uc.ConfirmMFA(context.Background(), 1, tt.code)
+			if tt.expectErr != nil {
+				assert.Equal(t, tt.expectErr, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result.RecoveryCodes, cfg.Secure.MFA.RecoveryCodeCount)
+			}
+
+			mockMFARepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLoginMFA(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	cfg := newMFATestConfig()
+	user := &domain.User{ID: 1, Email: "test@example.com", Roles: []string{domain.RoleAdmin}}
+
+	mfaToken, err := security.GenerateMFAToken(user.ID, user.Email, user.Roles, keyRing, 5*time.Minute)
+	assert.NoError(t, err)
+	accessToken, err := security.GenerateAccessToken(user.ID, user.Email, user.Roles, keyRing, 5*time.Minute)
+	assert.NoError(t, err)
+
+	enabledMFA := newEnabledMFA(t)
+	validCode, err := security.GenerateTOTPCode(enabledMFA.Secret, time.Now())
+	assert.NoError(t, err)
+
+	recoveryCodeHash, err := security.GeneratePasswordHash("abcde23456", nil)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		mfaToken  string
+		code      string
+		setup     func(mockMFARepo *mocks.MFARepository)
+		expectErr error
+	}{
+		{
+			name:     "Success With TOTP",
+			mfaToken: mfaToken,
+			code:     validCode,
+			setup: func(mockMFARepo *mocks.MFARepository) {
+				mockMFARepo.On("GetByUserID", mock.Anything, user.ID).Return(enabledMFA, nil)
+				mockMFARepo.On("UpdateLastUsedStep", mock.Anything, user.ID, mock.AnythingOfType("int64")).Return(nil)
+			},
+		},
+		{
+			name:     "Replayed TOTP",
+			mfaToken: mfaToken,
+			code:     validCode,
+			setup: func(mockMFARepo *mocks.MFARepository) {
+				mockMFARepo.On("GetByUserID", mock.Anything, user.ID).Return(enabledMFA, nil)
+				mockMFARepo.On("UpdateLastUsedStep", mock.Anything, user.ID, mock.AnythingOfType("int64")).Return(domain.ErrNotFound)
+			},
+			expectErr: domain.ErrInvalidMFACode,
+		},
+		{
+			name:     "Success With Recovery Code",
+			mfaToken: mfaToken,
+			code:     "ABCDE-23456",
+			setup: func(mockMFARepo *mocks.MFARepository) {
+				mockMFARepo.On("GetByUserID", mock.Anything, user.ID).Return(enabledMFA, nil)
+				mockMFARepo.On("GetUnusedRecoveryCodes", mock.Anything, user.ID).
+					Return([]domain.RecoveryCode{{ID: 7, UserID: user.ID, CodeHash: recoveryCodeHash}}, nil)
+				mockMFARepo.On("UseRecoveryCode", mock.Anything, int64(7)).Return(nil)
+			},
+		},
+		{
+			name:     "Wrong Recovery Code",
+			mfaToken: mfaToken,
+			code:     "zzzzz-zzzzz",
+			setup: func(mockMFARepo *mocks.MFARepository) {
+				mockMFARepo.On("GetByUserID", mock.Anything, user.ID).Return(enabledMFA, nil)
+				mockMFARepo.On("GetUnusedRecoveryCodes", mock.Anything, user.ID).
+					Return([]domain.RecoveryCode{{ID: 7, UserID: user.ID, CodeHash: recoveryCodeHash}}, nil)
+			},
+			expectErr: domain.ErrInvalidMFACode,
+		},
+		{
+			name:      "Access Token Is Not MFA Token",
+			mfaToken:  accessToken,
+			code:      validCode,
+			setup:     func(mockMFARepo *mocks.MFARepository) {},
+			expectErr: domain.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := new(mocks.UserRepository)
+			mockRefreshTokenRepo := new(mocks.RefreshTokenRepository)
+			mockMFARepo := new(mocks.MFARepository)
+
+			if tt.expectErr != domain.ErrUnauthorized {
+				mockUserRepo.On("GetByID", mock.Anything, user.ID).Return(user, nil)
+			}
+			tt.setup(mockMFARepo)
+			if tt.expectErr == nil {
+				mockRefreshTokenRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).Return(&domain.RefreshToken{ID: 1}, nil)
+			}
+
+			uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, mockRefreshTokenRepo, new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), mockMFARepo, new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
+
+			result, err := uc.LoginMFA(context.Background(), &domain.MFALoginRequest{MFAToken: tt.mfaToken, Code: tt.code})
+			if tt.expectErr != nil {
+				assert.Equal(t, tt.expectErr, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, result.AccessToken)
+				assert.NotEmpty(t, result.RefreshToken)
+				assert.Empty(t, result.RecoveryCodes)
+			}
+
+			mockUserRepo.AssertExpectations(t)
+			mockRefreshTokenRepo.AssertExpectations(t)
+			mockMFARepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Enrollment During Login", func(t *testing.T) {
+		pendingMFA := &domain.MFA{UserID: user.ID, Secret: enabledMFA.Secret}
+
+		mockUserRepo := new(mocks.UserRepository)
+		mockRefreshTokenRepo := new(mocks.RefreshTokenRepository)
+		mockMFARepo := new(mocks.MFARepository)
+
+		mockUserRepo.On("GetByID", mock.Anything, user.ID).Return(user, nil)
+		mockMFARepo.On("GetByUserID", mock.Anything, user.ID).Return(pendingMFA, nil)
+		mockMFARepo.On("UpdateLastUsedStep", mock.Anything, user.ID, mock.AnythingOfType("int64")).Return(nil)
+		mockMFARepo.On("Enable", mock.Anything, user.ID, mock.AnythingOfType("[]string")).Return(nil)
+		mockRefreshTokenRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).Return(&domain.RefreshToken{ID: 1}, nil)
+
+		uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, mockRefreshTokenRepo, new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), mockMFARepo, new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
+
+		result, err := uc.LoginMFA(context.Background(), &domain.MFALoginRequest{MFAToken: mfaToken, Code: validCode})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, result.AccessToken)
+		assert.Len(t, result.RecoveryCodes, cfg.Secure.MFA.RecoveryCodeCount)
+
+		mockMFARepo.AssertExpectations(t)
+	})
+}
+
+func TestDisableMFA(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	cfg := newMFATestConfig()
+	enabledMFA := newEnabledMFA(t)
+	validCode, err := security.GenerateTOTPCode(enabledMFA.Secret, time.Now())
+	assert.NoError(t, err)
+
+	t.Run("Success", func(t *testing.T) {
+		mockMFARepo := new(mocks.MFARepository)
+		mockMFARepo.On("GetByUserID", mock.Anything, int64(1)).Return(enabledMFA, nil)
+		mockMFARepo.On("UpdateLastUsedStep", mock.Anything, int64(1), mock.AnythingOfType("int64")).Return(nil)
+		mockMFARepo.On("Delete", mock.Anything, int64(1)).Return(nil)
+
+		uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), new(mocks.UserRepository), new(mocks.RefreshTokenRepository), new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), mockMFARepo, new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
+
+		assert.NoError(t, uc.DisableMFA(context.Background(), 1, validCode))
+		mockMFARepo.AssertExpectations(t)
+	})
+
+	t.Run("Invalid Code", func(t *testing.T) {
+		mockMFARepo := new(mocks.MFARepository)
+		mockMFARepo.On("GetByUserID", mock.Anything, int64(1)).Return(enabledMFA, nil)
+
+		uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), new(mocks.UserRepository), new(mocks.RefreshTokenRepository), new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), mockMFARepo, new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
+
+		assert.Equal(t, domain.ErrInvalidMFACode, uc.DisableMFA(context.Background(), 1, "000000"))
+		mockMFARepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/pkg/contextutil"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+// ClientCredentialsToken OAuth 클라이언트를 인증하고 서비스용 액세스 토큰을 발급한다
+// 사용자 토큰과 같은 키 링으로 서명하며, 리프레시 토큰은 발급하지 않는다 (RFC 6749 4.4.3)
+func (uc *authUseCase) ClientCredentialsToken(ctx context.Context, clientID, clientSecret, scope string) (*domain.TokenResponse, error) {
+	client, err := uc.authenticateClient(ctx, clientID, clientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	// scope 를 지정하지 않으면 클라이언트에 허용된 scope 를 모두 부여한다
+	scopes := strings.Fields(scope)
+	if len(scopes) == 0 {
+		scopes = client.Scopes
+	}
+	for _, s := range scopes {
+		if !slices.Contains(client.Scopes, s) {
+			return nil, domain.ErrInvalidScope
+		}
+	}
+
+	expiration := uc.clientTokenExpiration()
+	accessToken, err := security.GenerateClientAccessToken(client.ClientID, scopes, uc.keyRing, expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TokenResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(expiration.Seconds()),
+		Scope:       strings.Join(scopes, " "),
+	}, nil
+}
+
+// IntrospectToken 토큰이 지금도 유효한지 확인한다 (RFC 7662)
+// 서명과 만료뿐 아니라 폐기 목록과, 토큰의 사용자나 클라이언트가 아직 남아 있는지도 확인한다.
+// 유효하지 않은 이유는 알려주지 않고 active=false 만 반환한다
+func (uc *authUseCase) IntrospectToken(ctx context.Context, clientID, clientSecret, token string) (*domain.IntrospectionResponse, error) {
+	if _, err := uc.authenticateClient(ctx, clientID, clientSecret); err != nil {
+		return nil, err
+	}
+
+	inactive := &domain.IntrospectionResponse{Active: false}
+
+	// 리프레시 토큰과 MFA 토큰은 API 호출에 사용할 수 없으므로 active 로 보지 않는다
+	claims, err := security.ValidateAccessToken(token, uc.keyRing)
+	if err != nil || claims.IssuedAt == nil || claims.ExpiresAt == nil {
+		return inactive, nil
+	}
+
+	revoked, err := uc.revocationRepo.IsRevoked(ctx, claims.ID, claims.UserID, claims.IssuedAt.Time)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return inactive, nil
+	}
+
+	response := &domain.IntrospectionResponse{
+		Active:    true,
+		TokenType: "Bearer",
+		Exp:       claims.ExpiresAt.Unix(),
+		Iat:       claims.IssuedAt.Unix(),
+		Iss:       claims.Issuer,
+		Jti:       claims.ID,
+	}
+	if len(claims.Audience) > 0 {
+		response.Aud = claims.Audience[0]
+	}
+
+	// 서비스 토큰은 클라이언트가 삭제되지 않았는지 확인한다
+	if claims.ClientID != "" {
+		if _, err := uc.oauthClientRepo.GetByClientID(ctx, claims.ClientID); err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return inactive, nil
+			}
+			return nil, err
+		}
+		response.Sub = claims.ClientID
+		response.ClientID = claims.ClientID
+		response.Scope = claims.Scope
+		return response, nil
+	}
+
+	// 사용자 토큰은 발급 이후 역할이 바뀌었을 수 있으므로 현재 역할을 알려준다
+	user, err := uc.userRepo.GetByID(ctx, claims.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return inactive, nil
+		}
+		return nil, err
+	}
+	if user.IsDisabled() {
+		return inactive, nil
+	}
+	response.Sub = strconv.FormatInt(user.ID, 10)
+	response.Username = user.Email
+	response.Roles = user.Roles
+	if claims.Actor != nil {
+		response.Act = &domain.IntrospectionActor{Sub: claims.Actor.Subject}
+	}
+	return response, nil
+}
+
+// UserInfo 사용자의 현재 프로필을 반환한다. 토큰의 claim 이 아니라 저장된 값을 사용한다
+func (uc *authUseCase) UserInfo(ctx context.Context, userID int64) (*domain.UserInfoResponse, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.UserInfoResponse{
+		Sub:           strconv.FormatInt(user.ID, 10),
+		Email:         user.Email,
+		EmailVerified: user.IsVerified(),
+		Name:          user.Name,
+		Roles:         user.Roles,
+	}, nil
+}
+
+// authenticateClient 는 클라이언트 ID 와 시크릿을 확인한다. 실패하면 이유와 관계없이 ErrInvalidClient 를 반환한다
+func (uc *authUseCase) authenticateClient(ctx context.Context, clientID, clientSecret string) (*domain.OAuthClient, error) {
+	client, err := uc.oauthClientRepo.GetByClientID(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrInvalidClient
+		}
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(security.HashToken(clientSecret)), []byte(client.SecretHash)) != 1 {
+		contextutil.GetLogger(ctx).Warn("Invalid client secret", slog.String("client_id", clientID))
+		return nil, domain.ErrInvalidClient
+	}
+	return client, nil
+}
+
+func (uc *authUseCase) clientTokenExpiration() time.Duration {
+	if minutes := uc.config.Secure.OAuth.ClientTokenExpirationMin; minutes > 0 {
+		return time.Duration(minutes) * time.Minute
+	}
+	return time.Duration(uc.config.Secure.JWT.AccessExpirationMin) * time.Minute
+}
+
+type oauthClientUseCase struct {
+	oauthClientRepo domain.OAuthClientRepository
+}
+
+func NewOAuthClientUseCase(oauthClientRepo domain.OAuthClientRepository) domain.OAuthClientUseCase {
+	return &oauthClientUseCase{oauthClientRepo: oauthClientRepo}
+}
+
+// Create 새 OAuth 클라이언트를 등록한다. 클라이언트 시크릿은 응답에서만 확인할 수 있다
+func (uc *oauthClientUseCase) Create(ctx context.Context, req *domain.CreateOAuthClientRequest) (*domain.CreateOAuthClientResponse, error) {
+	secret, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := uc.oauthClientRepo.Save(ctx, &domain.OAuthClient{
+		ClientID:   uuid.NewString(),
+		Name:       req.Name,
+		SecretHash: security.HashToken(secret),
+		Scopes:     slices.Compact(slices.Sorted(slices.Values(req.Scopes))),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	contextutil.GetLogger(ctx).Info("OAuth client registered", slog.String("client_id", client.ClientID))
+	return &domain.CreateOAuthClientResponse{OAuthClient: client, ClientSecret: secret}, nil
+}
+
+func (uc *oauthClientUseCase) GetAll(ctx context.Context) ([]*domain.OAuthClient, error) {
+	return uc.oauthClientRepo.GetAll(ctx)
+}
+
+// Delete OAuth 클라이언트를 삭제한다. 이미 발급된 토큰은 만료될 때까지 유효하다
+func (uc *oauthClientUseCase) Delete(ctx context.Context, id int64) error {
+	if err := uc.oauthClientRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	contextutil.GetLogger(ctx).Info("OAuth client deleted", slog.Int64("id", id))
+	return nil
+}
@@ -0,0 +1,290 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicewook/gocore/internal/config"
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/internal/domain/mocks"
+	"github.com/nicewook/gocore/internal/usecase"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+func TestClientCredentialsToken(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	cfg := &config.Config{
+		Secure: config.SecureConfig{
+			JWT:   config.JWTConfig{AccessExpirationMin: 60},
+			OAuth: config.OAuthConfig{ClientTokenExpirationMin: 15},
+		},
+	}
+
+	client := &domain.OAuthClient{
+		ID:         1,
+		ClientID:   "billing-service",
+		SecretHash: security.HashToken("client-secret"),
+		Scopes:     []string{domain.ScopeOrdersRead, domain.ScopeUsersRead},
+	}
+
+	tests := []struct {
+		name          string
+		clientID      string
+		clientSecret  string
+		scope         string
+		mockClientErr error
+		expectedScope string
+		expectErr     error
+	}{
+		{
+			name:          "Success With All Scopes",
+			clientID:      "billing-service",
+			clientSecret:  "client-secret",
+			expectedScope: "orders:read users:read",
+		},
+		{
+			name:          "Success With Requested Scope",
+			clientID:      "billing-service",
+			clientSecret:  "client-secret",
+			scope:         "orders:read",
+			expectedScope: "orders:read",
+		},
+		{
+			name:          "Unknown Client",
+			clientID:      "unknown",
+			clientSecret:  "client-secret",
+			mockClientErr: domain.ErrNotFound,
+			expectErr:     domain.ErrInvalidClient,
+		},
+		{
+			name:         "Wrong Secret",
+			clientID:     "billing-service",
+			clientSecret: "wrong-secret",
+			expectErr:    domain.ErrInvalidClient,
+		},
+		{
+			name:         "Scope Not Allowed",
+			clientID:     "billing-service",
+			clientSecret: "client-secret",
+			scope:        "orders:read orders:write",
+			expectErr:    domain.ErrInvalidScope,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOAuthClientRepo := new(mocks.OAuthClientRepository)
+			if tt.mockClientErr != nil {
+				mockOAuthClientRepo.On("GetByClientID", mock.Anything, tt.clientID).Return(nil, tt.mockClientErr)
+			} else {
+				mockOAuthClientRepo.On("GetByClientID", mock.Anything, tt.clientID).Return(client, nil)
+			}
+
+			uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), new(mocks.UserRepository), new(mocks.RefreshTokenRepository), new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), new(mocks.MFARepository), new(mocks.LoginAttemptRepository), newTestSessionRepo(), mockOAuthClientRepo, nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
+
+			result, err := uc.ClientCredentialsToken(context.Background(), tt.clientID, tt.clientSecret, tt.scope)
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "Bearer", result.TokenType)
+				assert.Equal(t, 15*60, result.ExpiresIn)
+				assert.Equal(t, tt.expectedScope, result.Scope)
+
+				// 사용자 없이 클라이언트 ID 를 주체로 하는 액세스 토큰이다
+				claims, err := security.ValidateAccessToken(result.AccessToken, keyRing)
+				assert.NoError(t, err)
+				assert.Equal(t, "billing-service", claims.Subject)
+				assert.Equal(t, "billing-service", claims.ClientID)
+				assert.Zero(t, claims.UserID)
+				assert.Equal(t, tt.expectedScope, claims.Scope)
+			}
+
+			mockOAuthClientRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestIntrospectToken(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	cfg := &config.Config{}
+	client := &domain.OAuthClient{
+		ID:         1,
+		ClientID:   "billing-service",
+		SecretHash: security.HashToken("client-secret"),
+		Scopes:     []string{domain.ScopeOrdersRead},
+	}
+	user := &domain.User{ID: 7, Email: "john@example.com", Roles: []string{domain.RoleManager}}
+
+	userToken, err := security.GenerateSessionAccessToken(7, "john@example.com", []string{domain.RoleUser}, "session-1", keyRing, time.Hour)
+	assert.NoError(t, err)
+	serviceToken, err := security.GenerateClientAccessToken("billing-service", []string{domain.ScopeOrdersRead}, keyRing, time.Hour)
+	assert.NoError(t, err)
+	refreshToken, err := security.GenerateRefreshToken(7, "john@example.com", []string{domain.RoleUser}, keyRing, time.Hour)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		clientSecret string
+		token        string
+		revoked      bool
+		userErr      error
+		expectActive bool
+		expectSub    string
+		expectErr    error
+	}{
+		{
+			name:         "Active User Token",
+			clientSecret: "client-secret",
+			token:        userToken,
+			expectActive: true,
+			expectSub:    "7",
+		},
+		{
+			name:         "Active Service Token",
+			clientSecret: "client-secret",
+			token:        serviceToken,
+			expectActive: true,
+			expectSub:    "billing-service",
+		},
+		{
+			name:         "Revoked Token",
+			clientSecret: "client-secret",
+			token:        userToken,
+			revoked:      true,
+		},
+		{
+			name:         "User Deleted",
+			clientSecret: "client-secret",
+			token:        userToken,
+			userErr:      domain.ErrNotFound,
+		},
+		{
+			name:         "Refresh Token Is Not Active",
+			clientSecret: "client-secret",
+			token:        refreshToken,
+		},
+		{
+			name:         "Malformed Token",
+			clientSecret: "client-secret",
+			token:        "not-a-jwt",
+		},
+		{
+			name:         "Invalid Client",
+			clientSecret: "wrong-secret",
+			token:        userToken,
+			expectErr:    domain.ErrInvalidClient,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOAuthClientRepo := new(mocks.OAuthClientRepository)
+			mockOAuthClientRepo.On("GetByClientID", mock.Anything, "billing-service").Return(client, nil)
+
+			mockRevocationRepo := new(mocks.TokenRevocationRepository)
+			mockRevocationRepo.On("IsRevoked", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.revoked, nil).Maybe()
+
+			mockUserRepo := new(mocks.UserRepository)
+			if tt.userErr != nil {
+				mockUserRepo.On("GetByID", mock.Anything, int64(7)).Return(nil, tt.userErr)
+			} else {
+				mockUserRepo.On("GetByID", mock.Anything, int64(7)).Return(user, nil).Maybe()
+			}
+
+			uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, new(mocks.RefreshTokenRepository), mockRevocationRepo, new(mocks.OneTimeTokenRepository), new(mocks.MFARepository), new(mocks.LoginAttemptRepository), newTestSessionRepo(), mockOAuthClientRepo, nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
+
+			result, err := uc.IntrospectToken(context.Background(), "billing-service", tt.clientSecret, tt.token)
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectActive, result.Active)
+			if !tt.expectActive {
+				// 유효하지 않은 토큰은 다른 정보를 알려주지 않는다
+				assert.Equal(t, &domain.IntrospectionResponse{Active: false}, result)
+				return
+			}
+
+			assert.Equal(t, tt.expectSub, result.Sub)
+			assert.Equal(t, "Bearer", result.TokenType)
+			assert.NotZero(t, result.Exp)
+			if tt.token == serviceToken {
+				assert.Equal(t, "billing-service", result.ClientID)
+				assert.Equal(t, domain.ScopeOrdersRead, result.Scope)
+			} else {
+				// 토큰 발급 이후 바뀐 현재 역할을 반환한다
+				assert.Equal(t, "john@example.com", result.Username)
+				assert.Equal(t, []string{domain.RoleManager}, result.Roles)
+			}
+		})
+	}
+}
+
+func TestUserInfo(t *testing.T) {
+	verifiedAt := time.Now()
+	mockUserRepo := new(mocks.UserRepository)
+	mockUserRepo.On("GetByID", mock.Anything, int64(7)).Return(&domain.User{
+		ID:         7,
+		Name:       "John",
+		Email:      "john@example.com",
+		Roles:      []string{domain.RoleUser},
+		VerifiedAt: &verifiedAt,
+	}, nil)
+	mockUserRepo.On("GetByID", mock.Anything, int64(8)).Return(nil, domain.ErrNotFound)
+
+	uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, new(mocks.RefreshTokenRepository), new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), new(mocks.MFARepository), new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), nil, nil, nil, &config.Config{})
+
+	result, err := uc.UserInfo(context.Background(), 7)
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.UserInfoResponse{
+		Sub:           "7",
+		Email:         "john@example.com",
+		EmailVerified: true,
+		Name:          "John",
+		Roles:         []string{domain.RoleUser},
+	}, result)
+
+	_, err = uc.UserInfo(context.Background(), 8)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	mockUserRepo.AssertExpectations(t)
+}
+
+func TestCreateOAuthClient(t *testing.T) {
+	mockOAuthClientRepo := new(mocks.OAuthClientRepository)
+	var savedHash string
+	mockOAuthClientRepo.On("Save", mock.Anything, mock.MatchedBy(func(client *domain.OAuthClient) bool {
+		savedHash = client.SecretHash
+		return client.ClientID != "" && client.Name == "Billing" &&
+			assert.ObjectsAreEqual([]string{domain.ScopeOrdersRead, domain.ScopeUsersRead}, client.Scopes)
+	})).Return(func(_ context.Context, client *domain.OAuthClient) *domain.OAuthClient {
+		client.ID = 1
+		return client
+	}, nil)
+
+	uc := usecase.NewOAuthClientUseCase(mockOAuthClientRepo)
+
+	result, err := uc.Create(context.Background(), &domain.CreateOAuthClientRequest{
+		Name:   "Billing",
+		Scopes: []string{domain.ScopeUsersRead, domain.ScopeOrdersRead, domain.ScopeUsersRead},
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, result.ClientSecret)
+	// 시크릿은 해시로만 저장한다
+	assert.Equal(t, security.HashToken(result.ClientSecret), savedHash)
+
+	mockOAuthClientRepo.AssertExpectations(t)
+}
@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/pkg/contextutil"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+// StartOIDCLogin 외부 공급자 로그인을 시작한다
+// state 는 CSRF 를, nonce 는 ID 토큰 재사용을, PKCE verifier 는 인가 코드 가로채기를 막는다
+func (uc *authUseCase) StartOIDCLogin(ctx context.Context) (*domain.OIDCAuthRequest, error) {
+	if uc.oidcProvider == nil {
+		return nil, domain.ErrOIDCDisabled
+	}
+
+	state, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+	codeVerifier, err := security.GeneratePKCEVerifier()
+	if err != nil {
+		return nil, err
+	}
+
+	authURL, err := uc.oidcProvider.AuthCodeURL(ctx, state, nonce, security.PKCEChallenge(codeVerifier))
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.OIDCAuthRequest{
+		URL:          authURL,
+		State:        state,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+	}, nil
+}
+
+// LoginOIDC 인가 코드를 ID 토큰으로 교환하고, 이메일로 사용자를 연결하거나 새로 만든 뒤 토큰을 발급한다
+// 공급자가 인증한 이메일만 사용하고 이메일 인증을 마친 로컬 계정에만 연결하며, 로컬에서 MFA 를 사용하는 사용자는 비밀번호 로그인과 같이 MFA 를 거친다
+func (uc *authUseCase) LoginOIDC(ctx context.Context, code, codeVerifier, nonce string) (*domain.LoginResponse, error) {
+	if uc.oidcProvider == nil {
+		return nil, domain.ErrOIDCDisabled
+	}
+
+	identity, err := uc.oidcProvider.Exchange(ctx, code, codeVerifier, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := contextutil.GetLogger(ctx).With(slog.String("oidc_subject", identity.Subject))
+	if identity.Email == "" || !identity.EmailVerified {
+		logger.Warn("OIDC login rejected: email not verified by provider")
+		return nil, domain.ErrEmailNotVerified
+	}
+	if !uc.oidcEmailAllowed(identity.Email) {
+		logger.Warn("OIDC login rejected: email domain not allowed", slog.String("email", identity.Email))
+		return nil, domain.ErrOIDCNotProvisioned
+	}
+
+	user, err := uc.userRepo.GetUserByEmail(ctx, identity.Email)
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		if !uc.config.Secure.OIDC.AutoProvision {
+			logger.Warn("OIDC login rejected: no linked account", slog.String("email", identity.Email))
+			return nil, domain.ErrOIDCNotProvisioned
+		}
+		user, err = uc.provisionOIDCUser(ctx, identity)
+		// 삭제를 요청한 계정의 이메일은 개인정보가 지워지기 전까지 다시 사용할 수 없다
+		if errors.Is(err, domain.ErrAlreadyExists) {
+			logger.Warn("OIDC login rejected: account pending deletion", slog.String("email", identity.Email))
+			return nil, domain.ErrOIDCNotProvisioned
+		}
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case !user.IsVerified():
+		// 이메일 인증을 마치지 않은 로컬 계정은 연결하지 않는다.
+		// 다른 사람이 피해자의 이메일로 먼저 가입해 두었을 수 있으며, 연결하면 그 사람의 비밀번호, 세션, API 키가 그대로 남는다
+		logger.Warn("OIDC login rejected: local account email not verified", slog.Int64("user_id", user.ID))
+		return nil, domain.ErrOIDCNotProvisioned
+	}
+
+	if err := checkAccountActive(user); err != nil {
+		logger.Warn("OIDC login rejected: account disabled", slog.Int64("user_id", user.ID))
+		return nil, err
+	}
+
+	mfaRequired, enrollmentRequired, err := uc.mfaStatus(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if mfaRequired {
+		return uc.mfaChallenge(user, enrollmentRequired)
+	}
+
+	logger.Info("OIDC login succeeded", slog.Int64("user_id", user.ID))
+	return uc.startSession(ctx, user)
+}
+
+// provisionOIDCUser 는 처음 로그인한 사용자를 User 역할로 만든다
+// 비밀번호는 알 수 없는 임의 값으로 저장하므로, 비밀번호로 로그인하려면 재설정을 거쳐야 한다
+func (uc *authUseCase) provisionOIDCUser(ctx context.Context, identity *domain.OIDCIdentity) (*domain.User, error) {
+	password, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+	hashedPassword, err := security.GeneratePasswordHash(password, uc.hashParams)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.authRepo.CreateUser(ctx, &domain.User{
+		Name:     identity.Name,
+		Email:    identity.Email,
+		Password: hashedPassword,
+		Roles:    []string{domain.RoleUser},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.userRepo.MarkVerified(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	contextutil.GetLogger(ctx).Info("User provisioned from OIDC login",
+		slog.Int64("user_id", user.ID),
+		slog.String("oidc_subject", identity.Subject),
+	)
+	return user, nil
+}
+
+// oidcEmailAllowed 는 이메일 도메인이 허용 목록에 있는지 확인한다. 목록이 비어 있으면 모두 허용한다
+func (uc *authUseCase) oidcEmailAllowed(email string) bool {
+	allowed := uc.config.Secure.OIDC.AllowedDomains
+	if len(allowed) == 0 {
+		return true
+	}
+
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	domainPart := strings.ToLower(email[at+1:])
+	return slices.ContainsFunc(allowed, func(d string) bool {
+		return strings.EqualFold(d, domainPart)
+	})
+}
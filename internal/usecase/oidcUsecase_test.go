@@ -0,0 +1,178 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicewook/gocore/internal/config"
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/internal/domain/mocks"
+	"github.com/nicewook/gocore/internal/usecase"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+func TestStartOIDCLogin(t *testing.T) {
+	cfg := &config.Config{}
+
+	t.Run("Disabled", func(t *testing.T) {
+		uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), new(mocks.UserRepository), new(mocks.RefreshTokenRepository), new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), new(mocks.MFARepository), new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), nil, nil, nil, cfg)
+
+		result, err := uc.StartOIDCLogin(context.Background())
+		assert.ErrorIs(t, err, domain.ErrOIDCDisabled)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		var challenge string
+		mockProvider := new(mocks.OIDCProvider)
+		mockProvider.On("AuthCodeURL", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).
+			Run(func(args mock.Arguments) { challenge = args.String(3) }).
+			Return("https://idp.example.com/authorize", nil)
+
+		uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), new(mocks.UserRepository), new(mocks.RefreshTokenRepository), new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), new(mocks.MFARepository), new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), mockProvider, new(mocks.Mailer), nil, nil, nil, cfg)
+
+		result, err := uc.StartOIDCLogin(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "https://idp.example.com/authorize", result.URL)
+		assert.NotEmpty(t, result.State)
+		assert.NotEmpty(t, result.Nonce)
+		assert.NotEqual(t, result.State, result.Nonce)
+		// 공급자에는 verifier 대신 S256 challenge 를 전달한다
+		assert.Equal(t, security.PKCEChallenge(result.CodeVerifier), challenge)
+
+		mockProvider.AssertExpectations(t)
+	})
+}
+
+func TestLoginOIDC(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	verifiedAt := time.Now().Add(-24 * time.Hour)
+	identity := &domain.OIDCIdentity{
+		Subject:       "idp-user-1",
+		Email:         "staff@example.com",
+		EmailVerified: true,
+		Name:          "Staff Member",
+	}
+
+	tests := []struct {
+		name           string
+		oidcConfig     config.OIDCConfig
+		identity       *domain.OIDCIdentity
+		exchangeErr    error
+		existingUser   *domain.User
+		expectCreate   bool
+		expectVerified bool
+		expectErr      error
+	}{
+		{
+			name:         "Link Existing User",
+			identity:     identity,
+			existingUser: &domain.User{ID: 1, Email: "staff@example.com", Roles: []string{domain.RoleManager}, VerifiedAt: &verifiedAt},
+		},
+		{
+			// 다른 사람이 먼저 가입해 둔 미인증 계정은 연결하지 않는다 (인증 처리, 토큰 발급 모두 하지 않는다)
+			name:         "Unverified Local User Not Linked",
+			oidcConfig:   config.OIDCConfig{AutoProvision: true},
+			identity:     identity,
+			existingUser: &domain.User{ID: 1, Email: "staff@example.com", Roles: []string{domain.RoleUser}},
+			expectErr:    domain.ErrOIDCNotProvisioned,
+		},
+		{
+			name:           "Provision New User",
+			oidcConfig:     config.OIDCConfig{AutoProvision: true},
+			identity:       identity,
+			expectCreate:   true,
+			expectVerified: true,
+		},
+		{
+			name:      "Unknown User Without Auto Provision",
+			identity:  identity,
+			expectErr: domain.ErrOIDCNotProvisioned,
+		},
+		{
+			name:       "Email Domain Not Allowed",
+			oidcConfig: config.OIDCConfig{AllowedDomains: []string{"corp.example.com"}, AutoProvision: true},
+			identity:   identity,
+			expectErr:  domain.ErrOIDCNotProvisioned,
+		},
+		{
+			name:       "Email Not Verified By Provider",
+			oidcConfig: config.OIDCConfig{AutoProvision: true},
+			identity:   &domain.OIDCIdentity{Subject: "idp-user-1", Email: "staff@example.com"},
+			expectErr:  domain.ErrEmailNotVerified,
+		},
+		{
+			name:        "Exchange Failed",
+			exchangeErr: domain.ErrOIDCLoginFailed,
+			expectErr:   domain.ErrOIDCLoginFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{
+				Secure: config.SecureConfig{
+					JWT:  config.JWTConfig{AccessExpirationMin: 15, RefreshExpirationDay: 7},
+					OIDC: tt.oidcConfig,
+				},
+			}
+
+			mockProvider := new(mocks.OIDCProvider)
+			mockProvider.On("Exchange", mock.Anything, "auth-code", "verifier", "nonce").Return(tt.identity, tt.exchangeErr)
+
+			mockUserRepo := new(mocks.UserRepository)
+			mockAuthRepo := new(mocks.AuthRepository)
+			mockMFARepo := new(mocks.MFARepository)
+			mockRefreshTokenRepo := new(mocks.RefreshTokenRepository)
+
+			if tt.existingUser != nil {
+				mockUserRepo.On("GetUserByEmail", mock.Anything, "staff@example.com").Return(tt.existingUser, nil)
+			} else {
+				mockUserRepo.On("GetUserByEmail", mock.Anything, "staff@example.com").Return(nil, domain.ErrNotFound).Maybe()
+			}
+			if tt.expectCreate {
+				mockAuthRepo.On("CreateUser", mock.Anything, mock.MatchedBy(func(u *domain.User) bool {
+					return u.Email == "staff@example.com" && u.Name == "Staff Member" &&
+						len(u.Roles) == 1 && u.Roles[0] == domain.RoleUser && u.Password != ""
+				})).Return(func(_ context.Context, u *domain.User) *domain.User {
+					u.ID = 2
+					return u
+				}, nil)
+			}
+			if tt.expectVerified {
+				mockUserRepo.On("MarkVerified", mock.Anything, mock.AnythingOfType("int64")).Return(nil)
+			}
+			if tt.expectErr == nil {
+				mockMFARepo.On("GetByUserID", mock.Anything, mock.AnythingOfType("int64")).Return(nil, domain.ErrNotFound)
+				mockRefreshTokenRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).
+					Return(&domain.RefreshToken{}, nil)
+			}
+
+			uc := usecase.NewAuthUseCase(mockAuthRepo, mockUserRepo, mockRefreshTokenRepo, new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), mockMFARepo, new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), mockProvider, new(mocks.Mailer), keyRing, nil, nil, cfg)
+
+			result, err := uc.LoginOIDC(context.Background(), "auth-code", "verifier", "nonce")
+			if tt.expectErr != nil {
+				assert.True(t, errors.Is(err, tt.expectErr), "expected %v, got %v", tt.expectErr, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "staff@example.com", result.Email)
+				assert.NotEmpty(t, result.AccessToken)
+				assert.NotEmpty(t, result.RefreshToken)
+			}
+
+			mockProvider.AssertExpectations(t)
+			mockUserRepo.AssertExpectations(t)
+			mockUserRepo.AssertNotCalled(t, "MarkVerified", mock.Anything, int64(1))
+			mockAuthRepo.AssertExpectations(t)
+			mockRefreshTokenRepo.AssertExpectations(t)
+		})
+	}
+}
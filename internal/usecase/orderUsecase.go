@@ -14,14 +14,53 @@ func NewOrderUseCase(orderRepo domain.OrderRepository) domain.OrderUseCase {
 	return &orderUseCase{orderRepo: orderRepo}
 }
 
+// CreateOrder 주문을 생성한다. user_id 가 비어 있으면 요청한 사용자의 주문으로 만든다
+// 다른 사용자의 주문을 만들려면 orders:create:any 권한이 필요하다
 func (uc *orderUseCase) CreateOrder(ctx context.Context, order *domain.Order) (*domain.Order, error) {
+	principal, err := requirePrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.UserID == 0 {
+		order.UserID = principal.UserID
+	}
+	if order.UserID == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+	if !canAccessOwned(principal, order.UserID, domain.PermOrdersCreateAny) {
+		return nil, domain.ErrForbidden
+	}
+
 	return uc.orderRepo.Save(ctx, order)
 }
 
+// GetByID 주문을 조회한다. 다른 사용자의 주문은 orders:read:any 권한이 없으면 존재 여부를 알 수 없도록 ErrNotFound 를 반환한다
 func (uc *orderUseCase) GetByID(ctx context.Context, id int64) (*domain.Order, error) {
-	return uc.orderRepo.GetByID(ctx, id)
+	principal, err := requirePrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := uc.orderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !canAccessOwned(principal, order.UserID, domain.PermOrdersReadAny) {
+		return nil, domain.ErrNotFound
+	}
+	return order, nil
 }
 
+// GetAll 모든 사용자의 주문을 조회한다. orders:read:any 권한이 필요하다
 func (uc *orderUseCase) GetAll(ctx context.Context) ([]domain.Order, error) {
+	principal, err := requirePrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !principal.HasPermission(domain.PermOrdersReadAny) {
+		return nil, domain.ErrForbidden
+	}
+
 	return uc.orderRepo.GetAll(ctx)
 }
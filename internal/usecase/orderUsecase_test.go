@@ -9,11 +9,27 @@ import (
 
 	"github.com/nicewook/gocore/internal/domain"
 	"github.com/nicewook/gocore/internal/domain/mocks"
+	"github.com/nicewook/gocore/pkg/contextutil"
+)
+
+var (
+	orderOwner = &contextutil.Principal{
+		UserID:      1,
+		Roles:       []string{domain.RoleUser},
+		Permissions: domain.DefaultRolePermissions[domain.RoleUser],
+	}
+	orderManager = &contextutil.Principal{
+		UserID:      9,
+		Roles:       []string{domain.RoleManager},
+		Permissions: domain.DefaultRolePermissions[domain.RoleManager],
+	}
 )
 
 func TestCreateOrder(t *testing.T) {
 	tests := []struct {
 		name       string
+		principal  *contextutil.Principal
+		input      *domain.Order
 		mockInput  *domain.Order
 		mockReturn *domain.Order
 		mockError  error
@@ -22,30 +38,64 @@ func TestCreateOrder(t *testing.T) {
 	}{
 		{
 			name:       "Success",
+			principal:  orderOwner,
+			input:      &domain.Order{UserID: 1, ProductID: 1, Quantity: 1, TotalPriceInKRW: 1000},
+			mockInput:  &domain.Order{UserID: 1, ProductID: 1, Quantity: 1, TotalPriceInKRW: 1000},
+			mockReturn: &domain.Order{UserID: 1, ProductID: 1, Quantity: 1, TotalPriceInKRW: 1000},
+			expected:   &domain.Order{UserID: 1, ProductID: 1, Quantity: 1, TotalPriceInKRW: 1000},
+		},
+		{
+			name:       "UserID From Token",
+			principal:  orderOwner,
+			input:      &domain.Order{ProductID: 1, Quantity: 1, TotalPriceInKRW: 1000},
 			mockInput:  &domain.Order{UserID: 1, ProductID: 1, Quantity: 1, TotalPriceInKRW: 1000},
 			mockReturn: &domain.Order{UserID: 1, ProductID: 1, Quantity: 1, TotalPriceInKRW: 1000},
-			mockError:  nil,
 			expected:   &domain.Order{UserID: 1, ProductID: 1, Quantity: 1, TotalPriceInKRW: 1000},
-			expectErr:  nil,
+		},
+		{
+			name:      "User Cannot Order For Others",
+			principal: orderOwner,
+			input:     &domain.Order{UserID: 2, ProductID: 1, Quantity: 1, TotalPriceInKRW: 1000},
+			expectErr: domain.ErrForbidden,
+		},
+		{
+			name:       "Manager Orders For Others",
+			principal:  orderManager,
+			input:      &domain.Order{UserID: 2, ProductID: 1, Quantity: 1, TotalPriceInKRW: 1000},
+			mockInput:  &domain.Order{UserID: 2, ProductID: 1, Quantity: 1, TotalPriceInKRW: 1000},
+			mockReturn: &domain.Order{UserID: 2, ProductID: 1, Quantity: 1, TotalPriceInKRW: 1000},
+			expected:   &domain.Order{UserID: 2, ProductID: 1, Quantity: 1, TotalPriceInKRW: 1000},
 		},
 		{
 			name:       "InvalidInput",
-			mockInput:  &domain.Order{UserID: 0, ProductID: 0, Quantity: 0, TotalPriceInKRW: 0},
+			principal:  orderOwner,
+			input:      &domain.Order{UserID: 1, ProductID: 0, Quantity: 0, TotalPriceInKRW: 0},
+			mockInput:  &domain.Order{UserID: 1, ProductID: 0, Quantity: 0, TotalPriceInKRW: 0},
 			mockReturn: nil,
 			mockError:  domain.ErrInvalidInput,
 			expected:   nil,
 			expectErr:  domain.ErrInvalidInput,
 		},
+		{
+			name:      "Unauthenticated",
+			input:     &domain.Order{ProductID: 1, Quantity: 1, TotalPriceInKRW: 1000},
+			expectErr: domain.ErrUnauthorized,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := new(mocks.OrderRepository)
-			mockRepo.On("Save", mock.Anything, tt.mockInput).Return(tt.mockReturn, tt.mockError).Maybe()
+			if tt.mockInput != nil {
+				mockRepo.On("Save", mock.Anything, tt.mockInput).Return(tt.mockReturn, tt.mockError)
+			}
 
 			uc := NewOrderUseCase(mockRepo)
 			ctx := context.Background()
-			result, err := uc.CreateOrder(ctx, tt.mockInput)
+			if tt.principal != nil {
+				ctx = contextutil.WithPrincipal(ctx, tt.principal)
+			}
+			result, err := uc.CreateOrder(ctx, tt.input)
 
 			assert.Equal(t, tt.expected, result)
 			assert.Equal(t, tt.expectErr, err)
@@ -58,6 +108,7 @@ func TestCreateOrder(t *testing.T) {
 func TestGetOrderByID(t *testing.T) {
 	tests := []struct {
 		name       string
+		principal  *contextutil.Principal
 		inputID    int64
 		mockReturn *domain.Order
 		mockError  error
@@ -66,14 +117,31 @@ func TestGetOrderByID(t *testing.T) {
 	}{
 		{
 			name:       "Order Found",
+			principal:  orderOwner,
 			inputID:    1,
 			mockReturn: &domain.Order{ID: 1, UserID: 1, ProductID: 1, Quantity: 1, TotalPriceInKRW: 1000},
 			mockError:  nil,
 			expected:   &domain.Order{ID: 1, UserID: 1, ProductID: 1, Quantity: 1, TotalPriceInKRW: 1000},
 			expectErr:  nil,
 		},
+		{
+			name:       "Other User's Order Hidden",
+			principal:  orderOwner,
+			inputID:    3,
+			mockReturn: &domain.Order{ID: 3, UserID: 2, ProductID: 1, Quantity: 1, TotalPriceInKRW: 1000},
+			expected:   nil,
+			expectErr:  domain.ErrNotFound,
+		},
+		{
+			name:       "Manager Reads Other User's Order",
+			principal:  orderManager,
+			inputID:    3,
+			mockReturn: &domain.Order{ID: 3, UserID: 2, ProductID: 1, Quantity: 1, TotalPriceInKRW: 1000},
+			expected:   &domain.Order{ID: 3, UserID: 2, ProductID: 1, Quantity: 1, TotalPriceInKRW: 1000},
+		},
 		{
 			name:      "Order Not Found",
+			principal: orderOwner,
 			inputID:   2,
 			mockError: domain.ErrNotFound,
 			expected:  nil,
@@ -87,7 +155,7 @@ func TestGetOrderByID(t *testing.T) {
 			mockRepo.On("GetByID", mock.Anything, tt.inputID).Return(tt.mockReturn, tt.mockError)
 
 			uc := NewOrderUseCase(mockRepo)
-			ctx := context.Background()
+			ctx := contextutil.WithPrincipal(context.Background(), tt.principal)
 			result, err := uc.GetByID(ctx, tt.inputID)
 
 			assert.Equal(t, tt.expected, result)
@@ -101,13 +169,15 @@ func TestGetOrderByID(t *testing.T) {
 func TestGetAllOrders(t *testing.T) {
 	tests := []struct {
 		name       string
+		principal  *contextutil.Principal
 		mockReturn []domain.Order
 		mockError  error
 		expected   []domain.Order
 		expectErr  error
 	}{
 		{
-			name: "Orders Found",
+			name:      "Orders Found",
+			principal: orderManager,
 			mockReturn: []domain.Order{
 				{ID: 1, UserID: 1, ProductID: 1, Quantity: 1, TotalPriceInKRW: 1000},
 				{ID: 2, UserID: 2, ProductID: 2, Quantity: 2, TotalPriceInKRW: 2000},
@@ -121,19 +191,28 @@ func TestGetAllOrders(t *testing.T) {
 		},
 		{
 			name:      "No Orders Found",
+			principal: orderManager,
 			mockError: domain.ErrNotFound,
 			expected:  nil,
 			expectErr: domain.ErrNotFound,
 		},
+		{
+			name:      "User Cannot List All Orders",
+			principal: orderOwner,
+			expected:  nil,
+			expectErr: domain.ErrForbidden,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := new(mocks.OrderRepository)
-			mockRepo.On("GetAll", mock.Anything).Return(tt.mockReturn, tt.mockError)
+			if tt.expectErr != domain.ErrForbidden {
+				mockRepo.On("GetAll", mock.Anything).Return(tt.mockReturn, tt.mockError)
+			}
 
 			uc := NewOrderUseCase(mockRepo)
-			ctx := context.Background()
+			ctx := contextutil.WithPrincipal(context.Background(), tt.principal)
 			result, err := uc.GetAll(ctx)
 
 			assert.Equal(t, tt.expected, result)
@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/pkg/contextutil"
+)
+
+// requirePrincipal 은 context 에서 인증된 주체를 가져온다. 없으면 ErrUnauthorized 를 반환한다
+func requirePrincipal(ctx context.Context) (*contextutil.Principal, error) {
+	principal, ok := contextutil.PrincipalFrom(ctx)
+	if !ok {
+		return nil, domain.ErrUnauthorized
+	}
+	return principal, nil
+}
+
+// canAccessOwned 는 ownerID 사용자의 리소스에 접근할 수 있는지 확인한다.
+// 자신의 리소스이거나 다른 사용자의 리소스에 대한 권한(anyPermission)이 있으면 허용한다
+func canAccessOwned(principal *contextutil.Principal, ownerID int64, anyPermission string) bool {
+	if principal.HasPermission(anyPermission) {
+		return true
+	}
+	// 서비스 주체는 UserID 가 0 이므로 소유한 리소스가 없다
+	return principal.UserID != 0 && principal.UserID == ownerID
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/nicewook/gocore/internal/domain"
+)
+
+// permissionCacheTTL 은 역할별 권한 매핑을 캐시하는 시간이다.
+// 모든 인증 요청에서 매핑을 사용하므로 저장소를 매번 조회하지 않으며, DB 에서 바꾼 매핑은 이 시간 안에 반영된다
+const permissionCacheTTL = time.Minute
+
+type permissionUseCase struct {
+	permissionRepo domain.PermissionRepository
+
+	mu              sync.Mutex
+	rolePermissions map[string][]string
+	loadedAt        time.Time
+}
+
+func NewPermissionUseCase(permissionRepo domain.PermissionRepository) domain.PermissionUseCase {
+	return &permissionUseCase{permissionRepo: permissionRepo}
+}
+
+// Resolve 역할들이 가진 권한의 합집합을 정렬해서 반환한다. 매핑에 없는 역할은 권한이 없다
+func (uc *permissionUseCase) Resolve(ctx context.Context, roles []string) ([]string, error) {
+	rolePermissions, err := uc.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var permissions []string
+	for _, role := range roles {
+		permissions = append(permissions, rolePermissions[role]...)
+	}
+	slices.Sort(permissions)
+	return slices.Compact(permissions), nil
+}
+
+// RolePermissions 현재 적용 중인 역할별 권한 매핑을 반환한다
+func (uc *permissionUseCase) RolePermissions(ctx context.Context) (map[string][]string, error) {
+	rolePermissions, err := uc.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	copied := make(map[string][]string, len(rolePermissions))
+	for role, permissions := range rolePermissions {
+		copied[role] = slices.Clone(permissions)
+	}
+	return copied, nil
+}
+
+// load 는 캐시가 만료되었으면 저장소에서 매핑을 다시 읽는다
+func (uc *permissionUseCase) load(ctx context.Context) (map[string][]string, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if uc.rolePermissions != nil && time.Since(uc.loadedAt) < permissionCacheTTL {
+		return uc.rolePermissions, nil
+	}
+
+	rolePermissions, err := uc.permissionRepo.GetRolePermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	uc.rolePermissions = rolePermissions
+	uc.loadedAt = time.Now()
+	return rolePermissions, nil
+}
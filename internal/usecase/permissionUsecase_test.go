@@ -0,0 +1,92 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/internal/domain/mocks"
+	"github.com/nicewook/gocore/internal/usecase"
+)
+
+func TestResolvePermissions(t *testing.T) {
+	rolePermissions := map[string][]string{
+		domain.RoleManager: {domain.PermProductsWrite, domain.PermOrdersReadAny},
+		domain.RoleUser:    {domain.PermOrdersReadOwn, domain.PermOrdersCreate},
+	}
+
+	tests := []struct {
+		name      string
+		roles     []string
+		mockError error
+		expected  []string
+		expectErr bool
+	}{
+		{
+			name:     "Single Role",
+			roles:    []string{domain.RoleUser},
+			expected: []string{domain.PermOrdersCreate, domain.PermOrdersReadOwn},
+		},
+		{
+			name:  "Union Of Roles",
+			roles: []string{domain.RoleUser, domain.RoleManager},
+			expected: []string{
+				domain.PermOrdersCreate, domain.PermOrdersReadAny, domain.PermOrdersReadOwn, domain.PermProductsWrite,
+			},
+		},
+		{
+			name:     "Unknown Role Has No Permissions",
+			roles:    []string{"Auditor"},
+			expected: nil,
+		},
+		{
+			name:      "Repository Error",
+			roles:     []string{domain.RoleUser},
+			mockError: errors.New("db error"),
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.PermissionRepository)
+			if tt.mockError != nil {
+				mockRepo.On("GetRolePermissions", mock.Anything).Return(nil, tt.mockError)
+			} else {
+				mockRepo.On("GetRolePermissions", mock.Anything).Return(rolePermissions, nil)
+			}
+
+			uc := usecase.NewPermissionUseCase(mockRepo)
+			permissions, err := uc.Resolve(context.Background(), tt.roles)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, permissions)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRolePermissionsCached(t *testing.T) {
+	mockRepo := new(mocks.PermissionRepository)
+	mockRepo.On("GetRolePermissions", mock.Anything).
+		Return(map[string][]string{domain.RoleUser: {domain.PermOrdersCreate}}, nil).Once()
+
+	uc := usecase.NewPermissionUseCase(mockRepo)
+
+	// 캐시 유효 시간 안에서는 저장소를 한 번만 조회한다
+	for range 3 {
+		rolePermissions, err := uc.RolePermissions(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, map[string][]string{domain.RoleUser: {domain.PermOrdersCreate}}, rolePermissions)
+		rolePermissions[domain.RoleUser] = nil
+	}
+
+	mockRepo.AssertExpectations(t)
+}
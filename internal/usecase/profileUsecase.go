@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/pkg/contextutil"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+// 로그인한 사용자가 자신의 계정을 관리하는 authUseCase 메서드 (/me)
+
+// UpdateProfile 이름과 이메일을 수정한다
+// 이메일은 로그인과 비밀번호 재설정에 사용되므로, 탈취된 세션으로 계정을 빼앗지 못하도록 바꿀 때 현재 비밀번호를 확인한다.
+// 이메일을 바꾸면 인증 상태를 초기화하고 새 주소로 인증 메일을 보낸다. 대리 로그인 중에는 수정할 수 없다
+func (uc *authUseCase) UpdateProfile(ctx context.Context, userID int64, req *domain.UpdateProfileRequest) (*domain.User, error) {
+	if err := forbidImpersonation(ctx); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	emailChanged := req.Email != "" && !strings.EqualFold(req.Email, user.Email)
+	if emailChanged {
+		if req.CurrentPassword == "" {
+			return nil, domain.ErrIncorrectPassword
+		}
+		if err := uc.confirmPassword(ctx, user, req.CurrentPassword); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Name != "" {
+		user.Name = req.Name
+	}
+	if emailChanged {
+		user.Email = req.Email
+		user.VerifiedAt = nil
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+	if emailChanged {
+		contextutil.GetLogger(ctx).Info("Email changed, verification required", slog.Int64("user_id", user.ID))
+		// 인증 메일 발송에 실패해도 변경은 완료된다. 사용자는 재발송을 요청할 수 있다
+		if err := uc.sendVerificationMail(ctx, user); err != nil {
+			contextutil.GetLogger(ctx).Error("Failed to send verification mail",
+				slog.Int64("user_id", user.ID),
+				slog.String("err", err.Error()),
+			)
+		}
+	}
+	return user, nil
+}
+
+// ChangePassword 현재 비밀번호를 확인하고 비밀번호를 변경한다
+// 다른 기기의 세션은 모두 종료시키고, 요청한 기기에는 새 토큰을 발급하여 로그인 상태를 유지한다.
+func (uc *authUseCase) ChangePassword(ctx context.Context, userID int64, req *domain.ChangePasswordRequest) (*domain.LoginResponse, error) {
+	if err := forbidImpersonation(ctx); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.confirmPassword(ctx, user, req.CurrentPassword); err != nil {
+		return nil, err
+	}
+
+	if err := uc.checkPasswordPolicy(req.NewPassword, user.Email); err != nil {
+		return nil, err
+	}
+
+	hashedPassword, err := security.GeneratePasswordHash(req.NewPassword, uc.hashParams)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.userRepo.UpdatePassword(ctx, user.ID, hashedPassword); err != nil {
+		return nil, err
+	}
+
+	if err := uc.RevokeUserTokens(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	contextutil.GetLogger(ctx).Info("Password changed, other sessions revoked", slog.Int64("user_id", user.ID))
+
+	return uc.startSession(ctx, user)
+}
+
+// confirmPassword 는 민감한 요청을 처리하기 전에 현재 비밀번호를 확인한다
+// 확인 실패는 로그인 실패와 같이 횟수를 제한하며, 틀리면 ErrIncorrectPassword 를 반환한다
+func (uc *authUseCase) confirmPassword(ctx context.Context, user *domain.User, password string) error {
+	throttleKeys := uc.loginThrottleKeys(ctx, user.Email)
+	if err := uc.checkLoginLocked(ctx, throttleKeys); err != nil {
+		return err
+	}
+
+	// GetByID 는 비밀번호 해시를 반환하지 않는다
+	withPassword, err := uc.userRepo.GetUserByEmail(ctx, user.Email)
+	if err != nil {
+		return err
+	}
+	match, err := security.ComparePasswordHash(password, withPassword.Password)
+	if err != nil {
+		return err
+	}
+	if !match {
+		if err := uc.recordLoginFailure(ctx, throttleKeys); err != nil {
+			return err
+		}
+		return domain.ErrIncorrectPassword
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicewook/gocore/internal/config"
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/internal/domain/mocks"
+	"github.com/nicewook/gocore/internal/usecase"
+	"github.com/nicewook/gocore/pkg/security"
+)
+
+func TestUpdateProfile(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	cfg := &config.Config{
+		Secure: config.SecureConfig{
+			EmailVerification: config.EmailVerificationConfig{
+				URL:                "http://localhost:8080/auth/verify",
+				TokenExpirationMin: 60,
+			},
+			LoginThrottle: config.LoginThrottleConfig{
+				MaxFailures:    5,
+				WindowMin:      15,
+				BaseLockoutSec: 30,
+				MaxLockoutMin:  60,
+			},
+		},
+	}
+
+	hashedPassword, err := security.GeneratePasswordHash("password123", nil)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		req           *domain.UpdateProfileRequest
+		checkPassword bool
+		updateError   error
+		expectMail    bool
+		expected      *domain.User
+		expectErr     error
+	}{
+		{
+			name:     "Name Only Keeps Verification",
+			req:      &domain.UpdateProfileRequest{Name: "Johnny"},
+			expected: &domain.User{ID: 1, Name: "Johnny", Email: "john@example.com"},
+		},
+		{
+			name:     "Same Email Different Case Keeps Verification",
+			req:      &domain.UpdateProfileRequest{Email: "John@Example.com"},
+			expected: &domain.User{ID: 1, Name: "John", Email: "john@example.com"},
+		},
+		{
+			name:          "Email Change Requires Verification",
+			req:           &domain.UpdateProfileRequest{Email: "new@example.com", CurrentPassword: "password123"},
+			checkPassword: true,
+			expectMail:    true,
+			expected:      &domain.User{ID: 1, Name: "John", Email: "new@example.com"},
+		},
+		{
+			name:      "Email Change Without Current Password",
+			req:       &domain.UpdateProfileRequest{Email: "new@example.com"},
+			expectErr: domain.ErrIncorrectPassword,
+		},
+		{
+			name:          "Email Change With Incorrect Password",
+			req:           &domain.UpdateProfileRequest{Email: "new@example.com", CurrentPassword: "wrongpassword"},
+			checkPassword: true,
+			expectErr:     domain.ErrIncorrectPassword,
+		},
+		{
+			name:          "Email Taken",
+			req:           &domain.UpdateProfileRequest{Email: "taken@example.com", CurrentPassword: "password123"},
+			checkPassword: true,
+			updateError:   domain.ErrAlreadyExists,
+			expectErr:     domain.ErrAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := new(mocks.UserRepository)
+			mockOneTimeTokenRepo := new(mocks.OneTimeTokenRepository)
+			mockAttemptRepo := new(mocks.LoginAttemptRepository)
+			mockMailer := new(mocks.Mailer)
+
+			verifiedAt := time.Now()
+			mockUserRepo.On("GetByID", mock.Anything, int64(1)).
+				Return(&domain.User{ID: 1, Name: "John", Email: "john@example.com", VerifiedAt: &verifiedAt}, nil)
+			if tt.checkPassword {
+				// 현재 비밀번호 확인은 로그인 실패와 같이 횟수를 제한한다
+				mockAttemptRepo.On("LockedUntil", mock.Anything, "email:john@example.com").Return(time.Time{}, nil)
+				mockUserRepo.On("GetUserByEmail", mock.Anything, "john@example.com").
+					Return(&domain.User{ID: 1, Email: "john@example.com", Password: hashedPassword}, nil)
+			}
+			if tt.expectErr == domain.ErrIncorrectPassword && tt.checkPassword {
+				mockAttemptRepo.On("RecordFailure", mock.Anything, "email:john@example.com", 15*time.Minute).Return(1, nil)
+			}
+			if tt.expectErr != domain.ErrIncorrectPassword {
+				mockUserRepo.On("Update", mock.Anything, mock.AnythingOfType("*domain.User")).Return(tt.updateError)
+			}
+			if tt.expectMail {
+				mockOneTimeTokenRepo.On("DeleteByUserID", mock.Anything, int64(1), domain.PurposeEmailVerification).Return(nil)
+				mockOneTimeTokenRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.OneTimeToken")).Return(&domain.OneTimeToken{}, nil)
+				mockMailer.On("Send", mock.Anything, mock.MatchedBy(func(mail *domain.Mail) bool {
+					return mail.To == "new@example.com" && strings.Contains(mail.Body, "?token=")
+				})).Return(nil)
+			}
+
+			uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, new(mocks.RefreshTokenRepository), new(mocks.TokenRevocationRepository), mockOneTimeTokenRepo, new(mocks.MFARepository), mockAttemptRepo, newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, mockMailer, keyRing, nil, nil, cfg)
+
+			user, err := uc.UpdateProfile(context.Background(), 1, tt.req)
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+				assert.Nil(t, user)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected.Name, user.Name)
+				assert.Equal(t, tt.expected.Email, user.Email)
+				// 이메일을 바꾼 경우에만 다시 인증해야 한다
+				assert.Equal(t, !tt.expectMail, user.IsVerified())
+			}
+
+			mockUserRepo.AssertExpectations(t)
+			mockOneTimeTokenRepo.AssertExpectations(t)
+			mockAttemptRepo.AssertExpectations(t)
+			mockMailer.AssertExpectations(t)
+		})
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	keyRing, err := generateTestKeyRing()
+	assert.NoError(t, err)
+
+	cfg := &config.Config{
+		Secure: config.SecureConfig{
+			JWT: config.JWTConfig{
+				AccessExpirationMin:  15,
+				RefreshExpirationDay: 7,
+			},
+			LoginThrottle: config.LoginThrottleConfig{
+				MaxFailures:    5,
+				WindowMin:      15,
+				BaseLockoutSec: 30,
+				MaxLockoutMin:  60,
+			},
+		},
+	}
+
+	hashedPassword, err := security.GeneratePasswordHash("oldpassword", nil)
+	assert.NoError(t, err)
+	user := &domain.User{ID: 1, Email: "john@example.com", Roles: []string{domain.RoleUser}}
+
+	tests := []struct {
+		name      string
+		req       *domain.ChangePasswordRequest
+		locked    bool
+		expectErr error
+	}{
+		{
+			name: "Success Revokes Other Sessions",
+			req:  &domain.ChangePasswordRequest{CurrentPassword: "oldpassword", NewPassword: "newpassword123"},
+		},
+		{
+			name:      "Incorrect Current Password",
+			req:       &domain.ChangePasswordRequest{CurrentPassword: "wrongpassword", NewPassword: "newpassword123"},
+			expectErr: domain.ErrIncorrectPassword,
+		},
+		{
+			name:      "Weak New Password",
+			req:       &domain.ChangePasswordRequest{CurrentPassword: "oldpassword", NewPassword: "short"},
+			expectErr: domain.ErrWeakPassword,
+		},
+		{
+			name:      "Locked",
+			req:       &domain.ChangePasswordRequest{CurrentPassword: "oldpassword", NewPassword: "newpassword123"},
+			locked:    true,
+			expectErr: domain.ErrTooManyAttempts,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := new(mocks.UserRepository)
+			mockRefreshTokenRepo := new(mocks.RefreshTokenRepository)
+			mockRevocationRepo := new(mocks.TokenRevocationRepository)
+			mockAttemptRepo := new(mocks.LoginAttemptRepository)
+
+			mockUserRepo.On("GetByID", mock.Anything, int64(1)).Return(user, nil)
+			if tt.locked {
+				mockAttemptRepo.On("LockedUntil", mock.Anything, "email:john@example.com").Return(time.Now().Add(time.Minute), nil)
+			} else {
+				mockAttemptRepo.On("LockedUntil", mock.Anything, "email:john@example.com").Return(time.Time{}, nil)
+				mockUserRepo.On("GetUserByEmail", mock.Anything, user.Email).
+					Return(&domain.User{ID: 1, Email: user.Email, Password: hashedPassword}, nil)
+			}
+			if tt.expectErr == domain.ErrIncorrectPassword {
+				mockAttemptRepo.On("RecordFailure", mock.Anything, "email:john@example.com", 15*time.Minute).Return(1, nil)
+			}
+			if tt.expectErr == nil {
+				mockUserRepo.On("UpdatePassword", mock.Anything, int64(1), mock.MatchedBy(func(hash string) bool {
+					match, err := security.ComparePasswordHash(tt.req.NewPassword, hash)
+					return err == nil && match
+				})).Return(nil)
+				// 다른 세션을 모두 종료시키고 새 토큰을 발급한다
+				mockRevocationRepo.On("RevokeUserTokens", mock.Anything, int64(1), mock.Anything, mock.Anything).Return(nil)
+				mockRefreshTokenRepo.On("RevokeAllByUserID", mock.Anything, int64(1)).Return(nil)
+				mockRefreshTokenRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).Return(&domain.RefreshToken{ID: 2}, nil)
+			}
+
+			uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, mockRefreshTokenRepo, mockRevocationRepo, new(mocks.OneTimeTokenRepository), new(mocks.MFARepository), mockAttemptRepo, newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), keyRing, nil, nil, cfg)
+
+			result, err := uc.ChangePassword(context.Background(), 1, tt.req)
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, result.AccessToken)
+				assert.NotEmpty(t, result.RefreshToken)
+			}
+
+			mockUserRepo.AssertExpectations(t)
+			mockRefreshTokenRepo.AssertExpectations(t)
+			mockRevocationRepo.AssertExpectations(t)
+			mockAttemptRepo.AssertExpectations(t)
+		})
+	}
+}
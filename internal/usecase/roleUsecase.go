@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/pkg/contextutil"
+)
+
+// GetRoles 부여할 수 있는 역할 목록을 반환한다
+func (uc *authUseCase) GetRoles(ctx context.Context) ([]string, error) {
+	return uc.userRepo.GetAllRoles(ctx)
+}
+
+// GrantRole 사용자에게 역할을 부여한다. 이미 가진 역할이면 토큰을 폐기하지 않고 그대로 반환한다
+// 토큰에는 발급 시점의 역할이 담기므로, 역할이 바뀌면 모든 토큰을 폐기해서 다시 로그인하게 한다
+func (uc *authUseCase) GrantRole(ctx context.Context, userID int64, role string) (*domain.User, error) {
+	user, err := uc.roleChangeTarget(ctx, userID, role)
+	if err != nil {
+		return nil, err
+	}
+	if user.HasRole(role) {
+		return user, nil
+	}
+
+	if err := uc.userRepo.GrantRole(ctx, userID, role); err != nil {
+		return nil, err
+	}
+	user.Roles = append(user.Roles, role)
+	slices.Sort(user.Roles)
+
+	if err := uc.afterRoleChange(ctx, user, "Role granted", role); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// RevokeRole 사용자의 역할을 회수한다. 가지고 있지 않은 역할이면 ErrNotFound 를 반환한다
+func (uc *authUseCase) RevokeRole(ctx context.Context, userID int64, role string) (*domain.User, error) {
+	user, err := uc.roleChangeTarget(ctx, userID, role)
+	if err != nil {
+		return nil, err
+	}
+	if !user.HasRole(role) {
+		return nil, domain.ErrNotFound
+	}
+
+	if err := uc.userRepo.RevokeRole(ctx, userID, role); err != nil {
+		return nil, err
+	}
+	user.Roles = slices.DeleteFunc(user.Roles, func(r string) bool { return r == role })
+
+	if err := uc.afterRoleChange(ctx, user, "Role revoked", role); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// roleChangeTarget 은 역할을 바꿀 사용자를 조회한다.
+// 등록되지 않은 역할이면 ErrUnknownRole 을, 자신의 역할을 바꾸려고 하면 ErrForbidden 을 반환한다
+func (uc *authUseCase) roleChangeTarget(ctx context.Context, userID int64, role string) (*domain.User, error) {
+	// 관리자가 실수로 자신의 Admin 역할을 회수해서 관리자가 없어지는 일을 막는다
+	if principal, ok := contextutil.PrincipalFrom(ctx); ok && principal.UserID == userID {
+		return nil, domain.ErrForbidden
+	}
+
+	roles, err := uc.userRepo.GetAllRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(roles, role) {
+		return nil, domain.ErrUnknownRole
+	}
+
+	return uc.userRepo.GetByID(ctx, userID)
+}
+
+// afterRoleChange 는 역할이 바뀐 사용자의 토큰을 폐기하고 기록을 남긴다
+func (uc *authUseCase) afterRoleChange(ctx context.Context, user *domain.User, message, role string) error {
+	if err := uc.RevokeUserTokens(ctx, user.ID); err != nil {
+		return err
+	}
+
+	logger := contextutil.GetLogger(ctx)
+	attrs := []any{slog.Int64("user_id", user.ID), slog.String("role", role)}
+	if principal, ok := contextutil.PrincipalFrom(ctx); ok {
+		attrs = append(attrs, slog.Int64("actor_id", principal.UserID))
+	}
+	logger.Info(message, attrs...)
+	return nil
+}
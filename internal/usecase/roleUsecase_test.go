@@ -0,0 +1,134 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicewook/gocore/internal/config"
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/internal/domain/mocks"
+	"github.com/nicewook/gocore/internal/usecase"
+	"github.com/nicewook/gocore/pkg/contextutil"
+)
+
+func TestChangeRole(t *testing.T) {
+	cfg := &config.Config{
+		Secure: config.SecureConfig{
+			JWT: config.JWTConfig{AccessExpirationMin: 15, RefreshExpirationDay: 7},
+		},
+	}
+	knownRoles := []string{domain.RoleAdmin, domain.RoleManager, domain.RoleUser}
+	admin := &contextutil.Principal{UserID: 9, Roles: []string{domain.RoleAdmin}}
+
+	tests := []struct {
+		name          string
+		revoke        bool
+		userID        int64
+		role          string
+		mockUser      *domain.User
+		expectChange  bool
+		expectedRoles []string
+		expectErr     error
+	}{
+		{
+			name:          "Grant",
+			userID:        1,
+			role:          domain.RoleManager,
+			mockUser:      &domain.User{ID: 1, Roles: []string{domain.RoleUser}},
+			expectChange:  true,
+			expectedRoles: []string{domain.RoleManager, domain.RoleUser},
+		},
+		{
+			name:          "Grant Already Granted",
+			userID:        1,
+			role:          domain.RoleUser,
+			mockUser:      &domain.User{ID: 1, Roles: []string{domain.RoleUser}},
+			expectedRoles: []string{domain.RoleUser},
+		},
+		{
+			name:      "Grant Unknown Role",
+			userID:    1,
+			role:      "SuperUser",
+			expectErr: domain.ErrUnknownRole,
+		},
+		{
+			name:      "Grant Own Role",
+			userID:    9,
+			role:      domain.RoleManager,
+			expectErr: domain.ErrForbidden,
+		},
+		{
+			name:          "Revoke",
+			revoke:        true,
+			userID:        1,
+			role:          domain.RoleManager,
+			mockUser:      &domain.User{ID: 1, Roles: []string{domain.RoleManager, domain.RoleUser}},
+			expectChange:  true,
+			expectedRoles: []string{domain.RoleUser},
+		},
+		{
+			name:      "Revoke Not Granted",
+			revoke:    true,
+			userID:    1,
+			role:      domain.RoleAdmin,
+			mockUser:  &domain.User{ID: 1, Roles: []string{domain.RoleUser}},
+			expectErr: domain.ErrNotFound,
+		},
+		{
+			name:      "Revoke Own Admin Role",
+			revoke:    true,
+			userID:    9,
+			role:      domain.RoleAdmin,
+			expectErr: domain.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := new(mocks.UserRepository)
+			mockRevocationRepo := new(mocks.TokenRevocationRepository)
+			mockRefreshTokenRepo := new(mocks.RefreshTokenRepository)
+
+			mockUserRepo.On("GetAllRoles", mock.Anything).Return(knownRoles, nil).Maybe()
+			if tt.mockUser != nil {
+				mockUserRepo.On("GetByID", mock.Anything, tt.userID).Return(tt.mockUser, nil)
+			}
+			if tt.expectChange {
+				method := "GrantRole"
+				if tt.revoke {
+					method = "RevokeRole"
+				}
+				mockUserRepo.On(method, mock.Anything, tt.userID, tt.role).Return(nil)
+				// 역할이 바뀌면 사용자의 모든 토큰을 폐기한다
+				mockRevocationRepo.On("RevokeUserTokens", mock.Anything, tt.userID, mock.Anything, mock.Anything).Return(nil)
+				mockRefreshTokenRepo.On("RevokeAllByUserID", mock.Anything, tt.userID).Return(nil)
+			}
+
+			uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), mockUserRepo, mockRefreshTokenRepo, mockRevocationRepo, new(mocks.OneTimeTokenRepository), new(mocks.MFARepository), new(mocks.LoginAttemptRepository), newTestSessionRepo(), new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), nil, nil, nil, cfg)
+
+			ctx := contextutil.WithPrincipal(context.Background(), admin)
+			var user *domain.User
+			var err error
+			if tt.revoke {
+				user, err = uc.RevokeRole(ctx, tt.userID, tt.role)
+			} else {
+				user, err = uc.GrantRole(ctx, tt.userID, tt.role)
+			}
+
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+				assert.Nil(t, user)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedRoles, user.Roles)
+			}
+
+			mockUserRepo.AssertExpectations(t)
+			mockRevocationRepo.AssertExpectations(t)
+			mockRefreshTokenRepo.AssertExpectations(t)
+		})
+	}
+}
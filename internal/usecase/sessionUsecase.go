@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/pkg/contextutil"
+)
+
+// 로그인한 사용자가 자신의 세션을 조회하고 원격으로 로그아웃하는 authUseCase 메서드 (/me/sessions)
+// 세션 ID 는 리프레시 토큰 family ID 와 같으므로, 세션을 종료하면 해당 family 의 리프레시 토큰을 폐기한다.
+// 이미 발급된 액세스 토큰은 만료될 때까지 유효하다
+
+// ListSessions 사용자의 활성 세션 목록을 반환한다
+func (uc *authUseCase) ListSessions(ctx context.Context, userID int64, currentSessionID string) ([]*domain.Session, error) {
+	sessions, err := uc.sessionRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, session := range sessions {
+		session.Current = currentSessionID != "" && session.ID == currentSessionID
+	}
+	return sessions, nil
+}
+
+// RevokeSession 사용자의 세션 하나를 종료한다
+// 다른 사용자의 세션이거나 없는 세션이면 ErrNotFound 를 반환한다
+func (uc *authUseCase) RevokeSession(ctx context.Context, userID int64, sessionID string) error {
+	if err := uc.sessionRepo.Delete(ctx, userID, sessionID); err != nil {
+		return err
+	}
+	if err := uc.refreshTokenRepo.RevokeFamily(ctx, sessionID); err != nil {
+		return err
+	}
+
+	contextutil.GetLogger(ctx).Info("Session revoked",
+		slog.Int64("user_id", userID),
+		slog.String("session_id", sessionID),
+	)
+	return nil
+}
+
+// RevokeOtherSessions 현재 세션을 제외한 사용자의 모든 세션을 종료한다
+func (uc *authUseCase) RevokeOtherSessions(ctx context.Context, userID int64, currentSessionID string) error {
+	sessions, err := uc.sessionRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		if session.ID == currentSessionID {
+			continue
+		}
+		if err := uc.deleteSession(ctx, userID, session.ID); err != nil {
+			return err
+		}
+		if err := uc.refreshTokenRepo.RevokeFamily(ctx, session.ID); err != nil {
+			return err
+		}
+	}
+
+	contextutil.GetLogger(ctx).Info("Other sessions revoked", slog.Int64("user_id", userID))
+	return nil
+}
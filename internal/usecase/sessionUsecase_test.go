@@ -0,0 +1,92 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicewook/gocore/internal/config"
+	"github.com/nicewook/gocore/internal/domain"
+	"github.com/nicewook/gocore/internal/domain/mocks"
+	"github.com/nicewook/gocore/internal/usecase"
+)
+
+func TestListSessions(t *testing.T) {
+	mockSessionRepo := new(mocks.SessionRepository)
+	mockSessionRepo.On("ListByUserID", mock.Anything, int64(1)).
+		Return([]*domain.Session{{ID: "session-1", UserID: 1}, {ID: "session-2", UserID: 1}}, nil)
+
+	uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), new(mocks.UserRepository), new(mocks.RefreshTokenRepository), new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), new(mocks.MFARepository), new(mocks.LoginAttemptRepository), mockSessionRepo, new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), nil, nil, nil, &config.Config{})
+
+	sessions, err := uc.ListSessions(context.Background(), 1, "session-2")
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 2)
+	assert.False(t, sessions[0].Current)
+	assert.True(t, sessions[1].Current)
+
+	mockSessionRepo.AssertExpectations(t)
+}
+
+func TestRevokeSession(t *testing.T) {
+	tests := []struct {
+		name      string
+		deleteErr error
+		expectErr error
+	}{
+		{
+			name: "Success Revokes Refresh Tokens",
+		},
+		{
+			name:      "Not Own Session",
+			deleteErr: domain.ErrNotFound,
+			expectErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRefreshTokenRepo := new(mocks.RefreshTokenRepository)
+			mockSessionRepo := new(mocks.SessionRepository)
+
+			mockSessionRepo.On("Delete", mock.Anything, int64(1), "session-1").Return(tt.deleteErr)
+			if tt.expectErr == nil {
+				mockRefreshTokenRepo.On("RevokeFamily", mock.Anything, "session-1").Return(nil)
+			}
+
+			uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), new(mocks.UserRepository), mockRefreshTokenRepo, new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), new(mocks.MFARepository), new(mocks.LoginAttemptRepository), mockSessionRepo, new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), nil, nil, nil, &config.Config{})
+
+			err := uc.RevokeSession(context.Background(), 1, "session-1")
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRefreshTokenRepo.AssertExpectations(t)
+			mockSessionRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRevokeOtherSessions(t *testing.T) {
+	mockRefreshTokenRepo := new(mocks.RefreshTokenRepository)
+	mockSessionRepo := new(mocks.SessionRepository)
+
+	mockSessionRepo.On("ListByUserID", mock.Anything, int64(1)).
+		Return([]*domain.Session{{ID: "session-1"}, {ID: "current"}, {ID: "session-2"}}, nil)
+	// 현재 세션은 유지한다
+	for _, id := range []string{"session-1", "session-2"} {
+		mockSessionRepo.On("Delete", mock.Anything, int64(1), id).Return(nil)
+		mockRefreshTokenRepo.On("RevokeFamily", mock.Anything, id).Return(nil)
+	}
+
+	uc := usecase.NewAuthUseCase(new(mocks.AuthRepository), new(mocks.UserRepository), mockRefreshTokenRepo, new(mocks.TokenRevocationRepository), new(mocks.OneTimeTokenRepository), new(mocks.MFARepository), new(mocks.LoginAttemptRepository), mockSessionRepo, new(mocks.OAuthClientRepository), nil, new(mocks.Mailer), nil, nil, nil, &config.Config{})
+
+	assert.NoError(t, uc.RevokeOtherSessions(context.Background(), 1, "current"))
+
+	mockRefreshTokenRepo.AssertExpectations(t)
+	mockSessionRepo.AssertExpectations(t)
+	mockSessionRepo.AssertNotCalled(t, "Delete", mock.Anything, int64(1), "current")
+}
@@ -14,13 +14,28 @@ func NewUserUseCase(userRepo domain.UserRepository) domain.UserUseCase {
 	return &userUseCase{userRepo: userRepo}
 }
 
+// GetByID 사용자를 조회한다. 다른 사용자는 users:read:any 권한이 없으면 존재 여부를 알 수 없도록 ErrNotFound 를 반환한다
 func (uc *userUseCase) GetByID(ctx context.Context, id int64) (*domain.User, error) {
+	principal, err := requirePrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !canAccessOwned(principal, id, domain.PermUsersReadAny) {
+		return nil, domain.ErrNotFound
+	}
+
 	return uc.userRepo.GetByID(ctx, id)
 }
 
 func (uc *userUseCase) GetAll(ctx context.Context, req *domain.GetAllUsersRequest) (*domain.GetAllResponse, error) {
-	// usecase 레이어에서 추가적인 비즈니스 로직이 필요할 경우 여기에 구현
-	// 예: 특정 권한에 따른 필터링, 데이터 검증, 복잡한 비즈니스 룰 적용 등
+	// 모든 사용자의 정보를 조회하므로 users:read:any 권한이 필요하다
+	principal, err := requirePrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !principal.HasPermission(domain.PermUsersReadAny) {
+		return nil, domain.ErrForbidden
+	}
 
 	// 리포지토리 호출 결과를 확인하고 처리
 	response, err := uc.userRepo.GetAll(ctx, req)
@@ -9,12 +9,18 @@ import (
 
 	"github.com/nicewook/gocore/internal/domain"
 	"github.com/nicewook/gocore/internal/domain/mocks"
+	"github.com/nicewook/gocore/pkg/contextutil"
 )
 
 func TestGetByID(t *testing.T) {
+	user := &contextutil.Principal{UserID: 1, Permissions: domain.DefaultRolePermissions[domain.RoleUser]}
+	admin := &contextutil.Principal{UserID: 9, Permissions: domain.DefaultRolePermissions[domain.RoleAdmin]}
+
 	tests := []struct {
 		name       string
+		principal  *contextutil.Principal
 		inputID    int64
+		expectCall bool
 		mockReturn *domain.User
 		mockError  error
 		expected   *domain.User
@@ -22,15 +28,34 @@ func TestGetByID(t *testing.T) {
 	}{
 		{
 			name:       "User Found",
+			principal:  user,
 			inputID:    1,
+			expectCall: true,
 			mockReturn: &domain.User{ID: 1, Name: "John", Email: "john@example.com"},
 			mockError:  nil,
 			expected:   &domain.User{ID: 1, Name: "John", Email: "john@example.com"},
 			expectErr:  nil,
 		},
+		{
+			name:      "Other User Hidden",
+			principal: user,
+			inputID:   2,
+			expected:  nil,
+			expectErr: domain.ErrNotFound,
+		},
+		{
+			name:       "Admin Reads Other User",
+			principal:  admin,
+			inputID:    1,
+			expectCall: true,
+			mockReturn: &domain.User{ID: 1, Name: "John", Email: "john@example.com"},
+			expected:   &domain.User{ID: 1, Name: "John", Email: "john@example.com"},
+		},
 		{
 			name:       "User Not Found",
+			principal:  admin,
 			inputID:    2,
+			expectCall: true,
 			mockReturn: nil,
 			mockError:  domain.ErrNotFound,
 			expected:   nil,
@@ -41,10 +66,12 @@ func TestGetByID(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := new(mocks.UserRepository)
-			mockRepo.On("GetByID", mock.Anything, tt.inputID).Return(tt.mockReturn, tt.mockError)
+			if tt.expectCall {
+				mockRepo.On("GetByID", mock.Anything, tt.inputID).Return(tt.mockReturn, tt.mockError)
+			}
 
 			uc := NewUserUseCase(mockRepo)
-			ctx := context.Background()
+			ctx := contextutil.WithPrincipal(context.Background(), tt.principal)
 			result, err := uc.GetByID(ctx, tt.inputID)
 
 			assert.Equal(t, tt.expected, result)
@@ -58,7 +85,10 @@ func TestGetByID(t *testing.T) {
 func TestUserGetAll(t *testing.T) {
 	repo := new(mocks.UserRepository)
 	useCase := NewUserUseCase(repo)
-	ctx := context.Background()
+	ctx := contextutil.WithPrincipal(context.Background(), &contextutil.Principal{
+		UserID:      1,
+		Permissions: domain.DefaultRolePermissions[domain.RoleAdmin],
+	})
 
 	t.Run("사용자 목록 조회 성공", func(t *testing.T) {
 		// Mock data
@@ -188,4 +218,19 @@ func TestUserGetAll(t *testing.T) {
 
 		repo.AssertExpectations(t)
 	})
+
+	t.Run("users:read:any 권한이 없으면 거부", func(t *testing.T) {
+		repo := new(mocks.UserRepository)
+		useCase := NewUserUseCase(repo)
+		ctx := contextutil.WithPrincipal(context.Background(), &contextutil.Principal{
+			UserID:      1,
+			Permissions: domain.DefaultRolePermissions[domain.RoleUser],
+		})
+
+		result, err := useCase.GetAll(ctx, &domain.GetAllUsersRequest{Limit: 10})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		assert.Nil(t, result)
+		repo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything)
+	})
 }
@@ -4,14 +4,23 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"slices"
+	"strings"
+	"time"
 
-	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
+
+	"github.com/nicewook/gocore/pkg/security"
 )
 
 type contextKey string
 
-var loggerContextKey contextKey = "logger_context_key"
+var (
+	loggerContextKey    contextKey = "logger_context_key"
+	principalContextKey contextKey = "principal"
+	clientIPContextKey  contextKey = "client_ip"
+	userAgentContextKey contextKey = "user_agent"
+)
 
 func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
 	return context.WithValue(ctx, loggerContextKey, logger)
@@ -40,32 +49,132 @@ func GetRequestID(ctx context.Context) string {
 	return "no-request-id-in-context"
 }
 
-func TokenToUser(c echo.Context) (int64, string, []string, error) {
-	user, ok := c.Get("user").(*jwt.Token)
-	if !ok {
-		return 0, "", nil, errors.New("invalid or missing user token")
-	}
+// WithClientIP 는 요청한 클라이언트의 IP 를 저장한다. 로그인 시도 제한 등 usecase 에서 사용한다
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPContextKey, ip)
+}
 
-	claims, ok := user.Claims.(jwt.MapClaims)
-	if !ok {
-		return 0, "", nil, errors.New("invalid token claims")
+// GetClientIP 는 클라이언트 IP 를 반환한다. 없으면 빈 문자열을 반환한다
+func GetClientIP(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPContextKey).(string)
+	return ip
+}
+
+// WithUserAgent 는 클라이언트의 User-Agent 를 컨텍스트에 저장한다
+func WithUserAgent(ctx context.Context, userAgent string) context.Context {
+	return context.WithValue(ctx, userAgentContextKey, userAgent)
+}
+
+// GetUserAgent 는 클라이언트의 User-Agent 를 반환한다. 없으면 빈 문자열을 반환한다
+func GetUserAgent(ctx context.Context) string {
+	userAgent, _ := ctx.Value(userAgentContextKey).(string)
+	return userAgent
+}
+
+// Principal 은 인증된 요청의 주체이다. 미들웨어가 토큰 검증 후 echo 컨텍스트와 context.Context 에 저장한다
+type Principal struct {
+	UserID    int64
+	Email     string
+	Roles     []string
+	TokenType security.TokenType
+	TokenID   string    // 토큰의 jti
+	SessionID string    // 토큰을 발급한 로그인 세션 ID (sid). 세션 없이 발급된 토큰은 비어 있다
+	APIKeyID  int64     // API 키로 인증한 경우 키의 ID
+	ClientID  string    // 서비스 토큰(client_credentials)의 OAuth 클라이언트 ID. 사용자 주체는 비어 있다
+	Scopes    []string  // 서비스 토큰에 허용된 scope
+	ExpiresAt time.Time // 토큰 만료 시각. 만료일이 없는 API 키는 zero value 이다
+	// ActorID, ActorEmail 은 대리 로그인 토큰을 발급받은 관리자이다. 일반 토큰은 비어 있다
+	ActorID    int64
+	ActorEmail string
+	// Permissions 는 역할(서비스 주체는 scope)로 부여된 권한이다. 토큰에 담지 않고 요청마다 현재 매핑으로 계산한다
+	Permissions []string
+}
+
+// NewPrincipal 은 검증된 토큰 claims 로 Principal 을 만든다
+func NewPrincipal(claims *security.JWTClaims) *Principal {
+	p := &Principal{
+		UserID:    claims.UserID,
+		Email:     claims.Email,
+		Roles:     claims.Roles,
+		TokenType: claims.Type,
+		TokenID:   claims.ID,
+		SessionID: claims.SessionID,
+		ClientID:  claims.ClientID,
+		Scopes:    strings.Fields(claims.Scope),
+	}
+	if claims.ExpiresAt != nil {
+		p.ExpiresAt = claims.ExpiresAt.Time
 	}
-	idFloat, ok := claims["user_id"].(float64)
-	if !ok {
-		return 0, "", nil, errors.New("invalid user_id in token")
+	if claims.Actor != nil {
+		p.ActorID = claims.Actor.UserID
+		p.ActorEmail = claims.Actor.Email
 	}
-	id := int64(idFloat)
-	email, ok := claims["email"].(string)
-	if !ok {
-		return 0, "", nil, errors.New("invalid email in token")
+	return p
+}
+
+// NewAPIKeyPrincipal 은 API 키로 인증한 사용자로 Principal 을 만든다. roles 는 키의 scope 로 제한된 역할이다
+func NewAPIKeyPrincipal(userID int64, email string, roles []string, apiKeyID int64, expiresAt *time.Time) *Principal {
+	p := &Principal{
+		UserID:    userID,
+		Email:     email,
+		Roles:     roles,
+		TokenType: security.APIKey,
+		APIKeyID:  apiKeyID,
 	}
-	roles := make([]string, 0)
-	claimRoles, ok := claims["roles"].([]interface{})
-	if !ok {
-		return 0, "", nil, errors.New("invalid roles in token")
+	if expiresAt != nil {
+		p.ExpiresAt = *expiresAt
 	}
-	for _, role := range claimRoles {
-		roles = append(roles, role.(string))
+	return p
+}
+
+// IsService 는 사용자가 아닌 OAuth 클라이언트(서비스) 주체인지 확인한다. 서비스 주체는 UserID 가 없다
+func (p *Principal) IsService() bool {
+	return p.ClientID != ""
+}
+
+// IsImpersonated 는 관리자가 사용자로 대리 로그인한 주체인지 확인한다
+func (p *Principal) IsImpersonated() bool {
+	return p.ActorID != 0
+}
+
+// HasScope 은 서비스 주체가 scope 를 가지고 있는지 확인한다
+func (p *Principal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope)
+}
+
+// HasRole 은 주체가 role 을 가지고 있는지 확인한다
+func (p *Principal) HasRole(role string) bool {
+	return slices.Contains(p.Roles, role)
+}
+
+// HasPermission 은 주체가 permission 을 가지고 있는지 확인한다
+func (p *Principal) HasPermission(permission string) bool {
+	return slices.Contains(p.Permissions, permission)
+}
+
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey, principal)
+}
+
+// PrincipalFrom 은 context.Context 에 저장된 Principal 을 반환한다.
+// echo 에 의존하지 않으므로 usecase, repository 에서 권한 판단에 사용할 수 있다.
+func PrincipalFrom(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey).(*Principal)
+	return principal, ok && principal != nil
+}
+
+// SetPrincipal 은 Principal 을 echo 컨텍스트와 요청의 context.Context 양쪽에 저장한다
+func SetPrincipal(c echo.Context, principal *Principal) {
+	c.Set(string(principalContextKey), principal)
+	req := c.Request()
+	c.SetRequest(req.WithContext(WithPrincipal(req.Context(), principal)))
+}
+
+// GetPrincipal 은 echo 컨텍스트에 저장된 Principal 을 반환한다
+func GetPrincipal(c echo.Context) (*Principal, error) {
+	principal, ok := c.Get(string(principalContextKey)).(*Principal)
+	if !ok || principal == nil {
+		return nil, errors.New("invalid or missing principal")
 	}
-	return id, email, roles, nil
+	return principal, nil
 }
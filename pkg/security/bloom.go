@@ -0,0 +1,109 @@
+package security
+
+import (
+	"bufio"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math"
+	"os"
+	"strings"
+)
+
+// BreachedPasswords는 유출된 비밀번호 목록을 bloom filter로 보관
+// 목록 전체를 메모리에 두지 않고 항목당 약 15비트만 사용하며,
+// 목록에 없는 비밀번호를 있다고 판단할 확률(false positive)은 약 0.1%
+// 대소문자 변형(Password, PASSWORD)도 함께 걸러내도록 소문자로 변환해 저장
+type BreachedPasswords struct {
+	bits   []uint64
+	m      uint64 // 비트 수
+	k      uint64 // 해시 함수 수
+	length int    // 추가된 항목 수
+}
+
+// false positive 확률 0.1% 기준 항목당 비트 수와 해시 함수 수
+const (
+	bloomBitsPerItem = 14.4
+	bloomHashCount   = 10
+)
+
+// LoadBreachedPasswords는 한 줄에 하나씩 비밀번호가 적힌 파일을 읽어 bloom filter를 생성
+func LoadBreachedPasswords(path string) (*BreachedPasswords, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open breached password list: %w", err)
+	}
+	defer f.Close()
+
+	return NewBreachedPasswords(f)
+}
+
+// NewBreachedPasswords는 reader에서 한 줄에 하나씩 비밀번호를 읽어 bloom filter를 생성
+// 빈 줄과 #으로 시작하는 줄은 무시
+func NewBreachedPasswords(r io.Reader) (*BreachedPasswords, error) {
+	var passwords []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		passwords = append(passwords, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read breached password list: %w", err)
+	}
+
+	m := uint64(math.Ceil(float64(max(len(passwords), 1)) * bloomBitsPerItem))
+	b := &BreachedPasswords{
+		bits: make([]uint64, (m+63)/64),
+		m:    m,
+		k:    bloomHashCount,
+	}
+	for _, password := range passwords {
+		b.add(password)
+	}
+	return b, nil
+}
+
+// Len은 목록에서 읽은 비밀번호 수를 반환
+func (b *BreachedPasswords) Len() int {
+	return b.length
+}
+
+// Contains는 비밀번호가 목록에 있을 가능성이 있으면 true를 반환
+// false이면 목록에 없는 것이 확실
+func (b *BreachedPasswords) Contains(password string) bool {
+	h1, h2 := bloomHashes(password)
+	for i := uint64(0); i < b.k; i++ {
+		idx := (h1 + i*h2) % b.m
+		if b.bits[idx/64]&(1<<(idx%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *BreachedPasswords) add(password string) {
+	h1, h2 := bloomHashes(password)
+	for i := uint64(0); i < b.k; i++ {
+		idx := (h1 + i*h2) % b.m
+		b.bits[idx/64] |= 1 << (idx % 64)
+	}
+	b.length++
+}
+
+// bloomHashes는 k개의 해시를 h1 + i*h2로 만들기 위한 두 해시 값을 반환 (double hashing)
+func bloomHashes(password string) (uint64, uint64) {
+	h := fnv.New128a()
+	h.Write([]byte(strings.ToLower(password)))
+	sum := h.Sum(nil)
+
+	var h1, h2 uint64
+	for i := 0; i < 8; i++ {
+		h1 = h1<<8 | uint64(sum[i])
+		h2 = h2<<8 | uint64(sum[i+8])
+	}
+	// h2가 0이면 모든 해시가 같은 비트를 가리키므로 홀수로 만든다
+	return h1, h2 | 1
+}
@@ -0,0 +1,303 @@
+package security
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrUnknownKeyID    = errors.New("unknown key id")
+	ErrNoSigningKey    = errors.New("no signing key configured")
+	ErrDuplicateKeyID  = errors.New("duplicate key id")
+	ErrMissingKeyID    = errors.New("key id is required")
+	ErrMissingKeyValue = errors.New("public or private key is required")
+	ErrKeyPairMismatch = errors.New("public key does not match private key")
+)
+
+// KeyPEM 은 PEM 형식으로 설정된 키 한 쌍이다.
+// PrivateKey 가 비어 있으면 검증 전용 키(교체되어 더 이상 서명에 사용하지 않는 키)로 등록된다.
+type KeyPEM struct {
+	ID         string
+	Algorithm  string // RS256, ES256, EdDSA. 비어 있으면 RS256
+	PrivateKey string
+	PublicKey  string
+}
+
+// SigningKey 는 키 ID(kid)로 식별되는 서명/검증 키이다
+type SigningKey struct {
+	ID         string
+	Method     jwt.SigningMethod
+	PrivateKey crypto.Signer // 검증 전용 키이면 nil
+	PublicKey  crypto.PublicKey
+}
+
+// KeyRing 은 여러 개의 검증 키와 현재 서명 키를 관리한다.
+// 키 교체 시 새 키를 추가하고 서명 키 ID 를 바꾼 뒤, 이전 키는 발급된 토큰이 모두 만료될 때까지 검증 전용으로 남겨둔다.
+// 발급하는 토큰의 iss, aud 도 함께 가지고 있어 검증 시 같은 값인지 확인한다.
+type KeyRing struct {
+	keys      map[string]*SigningKey
+	keyIDs    []string // JWKS 출력 순서를 일정하게 유지하기 위한 등록 순서
+	signingID string
+	issuer    string
+	audience  string
+}
+
+// NewKeyRing 은 PEM 키 목록으로 KeyRing 을 만든다. signingID 키는 개인키를 가지고 있어야 한다.
+func NewKeyRing(signingID string, keys ...KeyPEM) (*KeyRing, error) {
+	kr := &KeyRing{
+		keys:      make(map[string]*SigningKey, len(keys)),
+		signingID: signingID,
+	}
+
+	for _, k := range keys {
+		key, err := parseKeyPEM(k)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", k.ID, err)
+		}
+		if _, exists := kr.keys[key.ID]; exists {
+			return nil, fmt.Errorf("key %q: %w", k.ID, ErrDuplicateKeyID)
+		}
+		kr.keys[key.ID] = key
+		kr.keyIDs = append(kr.keyIDs, key.ID)
+	}
+
+	signingKey, ok := kr.keys[signingID]
+	if !ok || signingKey.PrivateKey == nil {
+		return nil, ErrNoSigningKey
+	}
+
+	return kr, nil
+}
+
+func parseKeyPEM(k KeyPEM) (*SigningKey, error) {
+	if k.ID == "" {
+		return nil, ErrMissingKeyID
+	}
+	if k.PrivateKey == "" && k.PublicKey == "" {
+		return nil, ErrMissingKeyValue
+	}
+
+	method, err := SigningMethod(k.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &SigningKey{ID: k.ID, Method: method}
+	if k.PrivateKey != "" {
+		privateKey, err := ParsePrivateKeyFromPEM(method, k.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		key.PrivateKey = privateKey
+		key.PublicKey = privateKey.Public()
+	}
+	if k.PublicKey != "" {
+		publicKey, err := ParsePublicKeyFromPEM(method, k.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		// 개인키와 함께 설정된 공개키는 개인키에서 구한 공개키와 같아야 한다.
+		// 다르면 서명한 토큰을 검증할 수 없거나 JWKS 에 잘못된 키가 공개된다
+		if key.PrivateKey != nil {
+			if derived, ok := key.PublicKey.(interface{ Equal(crypto.PublicKey) bool }); !ok || !derived.Equal(publicKey) {
+				return nil, ErrKeyPairMismatch
+			}
+		}
+		key.PublicKey = publicKey
+	}
+	return key, nil
+}
+
+// WithIssuer 는 발급/검증하는 토큰의 iss, aud 를 설정한다. 빈 값이면 해당 claim 을 사용하지 않는다
+func (kr *KeyRing) WithIssuer(issuer, audience string) *KeyRing {
+	kr.issuer = issuer
+	kr.audience = audience
+	return kr
+}
+
+// Issuer 는 발급하는 토큰의 iss 를 반환한다
+func (kr *KeyRing) Issuer() string {
+	return kr.issuer
+}
+
+// Audience 는 발급하는 토큰의 aud 를 반환한다
+func (kr *KeyRing) Audience() string {
+	return kr.audience
+}
+
+// parserOptions 는 토큰 검증에 사용할 jwt 파서 옵션을 반환한다
+func (kr *KeyRing) parserOptions() []jwt.ParserOption {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(kr.Algorithms()),
+		jwt.WithIssuedAt(),
+	}
+	if kr.issuer != "" {
+		opts = append(opts, jwt.WithIssuer(kr.issuer))
+	}
+	if kr.audience != "" {
+		opts = append(opts, jwt.WithAudience(kr.audience))
+	}
+	return opts
+}
+
+// SigningKey 는 현재 서명에 사용하는 키를 반환한다
+func (kr *KeyRing) SigningKey() *SigningKey {
+	return kr.keys[kr.signingID]
+}
+
+// PublicKey 는 kid 에 해당하는 검증 키를 반환한다
+func (kr *KeyRing) PublicKey(kid string) (crypto.PublicKey, error) {
+	key, ok := kr.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKeyID
+	}
+	return key.PublicKey, nil
+}
+
+// Algorithms 는 키 링에 등록된 키들의 서명 알고리즘 목록을 반환한다.
+// jwt.WithValidMethods 에 넘겨 등록되지 않은 알고리즘(none, HS256 등)의 토큰을 거부하는 데 사용한다.
+func (kr *KeyRing) Algorithms() []string {
+	algs := make([]string, 0, 1)
+	for _, kid := range kr.keyIDs {
+		if alg := kr.keys[kid].Method.Alg(); !slices.Contains(algs, alg) {
+			algs = append(algs, alg)
+		}
+	}
+	return algs
+}
+
+// KeyFunc 는 토큰 헤더의 kid 로 검증 키를 찾는 jwt.Keyfunc 이다.
+// kid 가 없는 토큰(키 링 도입 이전에 발급된 토큰)은 현재 서명 키로 검증한다.
+// 토큰의 alg 가 키에 설정된 알고리즘과 다르면 거부한다.
+func (kr *KeyRing) KeyFunc(token *jwt.Token) (interface{}, error) {
+	key := kr.SigningKey()
+	if kid, ok := token.Header["kid"].(string); ok {
+		if key, ok = kr.keys[kid]; !ok {
+			return nil, ErrUnknownKeyID
+		}
+	}
+
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, ErrInvalidToken
+	}
+	return key.PublicKey, nil
+}
+
+// JWK 는 RFC 7517 JSON Web Key 이다.
+// RSA 키는 n, e 를, EC 키는 crv, x, y 를, OKP(Ed25519) 키는 crv, x 를 사용한다.
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JWKSet 은 /.well-known/jwks.json 으로 공개하는 키 목록이다
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS 는 등록된 모든 검증 키를 JWK Set 으로 반환한다
+func (kr *KeyRing) JWKS() JWKSet {
+	set := JWKSet{Keys: make([]JWK, 0, len(kr.keyIDs))}
+	for _, kid := range kr.keyIDs {
+		key := kr.keys[kid]
+		jwk := JWK{
+			Use: "sig",
+			Alg: key.Method.Alg(),
+			Kid: kid,
+		}
+
+		switch publicKey := key.PublicKey.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+		case *ecdsa.PublicKey:
+			// 좌표는 곡선 크기(P-256 은 32바이트)에 맞춰 앞을 0 으로 채운다 (RFC 7518 6.2.1)
+			size := (publicKey.Curve.Params().BitSize + 7) / 8
+			jwk.Kty = "EC"
+			jwk.Crv = publicKey.Curve.Params().Name
+			jwk.X = base64.RawURLEncoding.EncodeToString(publicKey.X.FillBytes(make([]byte, size)))
+			jwk.Y = base64.RawURLEncoding.EncodeToString(publicKey.Y.FillBytes(make([]byte, size)))
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+		default:
+			continue
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set
+}
+
+// ErrUnsupportedJWK 는 지원하지 않는 키 종류나 곡선의 JWK 를 변환할 때 반환한다
+var ErrUnsupportedJWK = errors.New("unsupported jwk")
+
+// PublicKey 는 JWK 를 검증용 공개키로 변환한다. 외부 공급자의 JWKS 로 토큰을 검증할 때 사용한다
+func (k JWK) PublicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent: %w", err)
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if len(n) == 0 || !exponent.IsInt64() || exponent.Int64() < 2 || exponent.Int64() > 1<<31-1 {
+			return nil, ErrUnsupportedJWK
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, ErrUnsupportedJWK
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x coordinate: %w", err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y coordinate: %w", err)
+		}
+		if len(x) != 32 || len(y) != 32 {
+			return nil, ErrUnsupportedJWK
+		}
+		publicKey := &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}
+		if !publicKey.Curve.IsOnCurve(publicKey.X, publicKey.Y) {
+			return nil, ErrUnsupportedJWK
+		}
+		return publicKey, nil
+	case "OKP":
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if k.Crv != "Ed25519" || err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, ErrUnsupportedJWK
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, ErrUnsupportedJWK
+	}
+}
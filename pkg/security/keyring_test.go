@@ -0,0 +1,317 @@
+package security
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+	"time"
+)
+
+// generateKeyPEM 은 테스트용 키를 알고리즘에 맞게 생성하여 PEM 으로 반환한다
+func generateKeyPEM(t *testing.T, id, alg string) KeyPEM {
+	t.Helper()
+
+	var (
+		privateKey crypto.Signer
+		err        error
+	)
+	switch alg {
+	case "ES256":
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case "EdDSA":
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		privateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	}
+	if err != nil {
+		t.Fatalf("failed to generate %s key: %v", alg, err)
+	}
+
+	privateKeyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("failed to marshal private key: %v", err)
+	}
+	publicKeyBytes, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	if err != nil {
+		t.Fatalf("failed to marshal public key: %v", err)
+	}
+
+	return KeyPEM{
+		ID:         id,
+		Algorithm:  alg,
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKeyBytes})),
+		PublicKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyBytes})),
+	}
+}
+
+func TestNewKeyRing(t *testing.T) {
+	key1 := generateKeyPEM(t, "key-1", "RS256")
+	verifyOnly := KeyPEM{ID: "key-0", Algorithm: key1.Algorithm, PublicKey: key1.PublicKey}
+	otherKey := generateKeyPEM(t, "key-2", "RS256")
+	edKey := generateKeyPEM(t, "key-3", "EdDSA")
+	otherEdKey := generateKeyPEM(t, "key-4", "EdDSA")
+
+	tests := []struct {
+		name      string
+		signingID string
+		keys      []KeyPEM
+		expectErr error
+	}{
+		{
+			name:      "signing key with verification only key",
+			signingID: "key-1",
+			keys:      []KeyPEM{verifyOnly, key1},
+		},
+		{
+			name:      "unknown signing key",
+			signingID: "key-2",
+			keys:      []KeyPEM{key1},
+			expectErr: ErrNoSigningKey,
+		},
+		{
+			name:      "signing key without private key",
+			signingID: "key-0",
+			keys:      []KeyPEM{verifyOnly},
+			expectErr: ErrNoSigningKey,
+		},
+		{
+			name:      "duplicate key id",
+			signingID: "key-1",
+			keys:      []KeyPEM{key1, key1},
+			expectErr: ErrDuplicateKeyID,
+		},
+		{
+			name:      "unsupported algorithm",
+			signingID: "key-1",
+			keys:      []KeyPEM{{ID: "key-1", Algorithm: "HS256", PrivateKey: key1.PrivateKey}},
+			expectErr: ErrUnsupportedAlgorithm,
+		},
+		{
+			name:      "key does not match algorithm",
+			signingID: "key-1",
+			keys:      []KeyPEM{{ID: "key-1", Algorithm: "ES256", PrivateKey: key1.PrivateKey}},
+			expectErr: ErrParsingKey,
+		},
+		{
+			name:      "public key does not match private key",
+			signingID: "key-1",
+			keys:      []KeyPEM{{ID: "key-1", PrivateKey: key1.PrivateKey, PublicKey: otherKey.PublicKey}},
+			expectErr: ErrKeyPairMismatch,
+		},
+		{
+			name:      "EdDSA public key does not match private key",
+			signingID: "key-3",
+			keys:      []KeyPEM{{ID: "key-3", Algorithm: "EdDSA", PrivateKey: edKey.PrivateKey, PublicKey: otherEdKey.PublicKey}},
+			expectErr: ErrKeyPairMismatch,
+		},
+		{
+			name:      "missing key id",
+			signingID: "key-1",
+			keys:      []KeyPEM{{PrivateKey: key1.PrivateKey}},
+			expectErr: ErrMissingKeyID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewKeyRing(tt.signingID, tt.keys...)
+			if !errors.Is(err, tt.expectErr) {
+				t.Fatalf("expected error: %v, got: %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
+// TestKeyRingRotation 은 서명 키를 교체한 뒤에도 이전 키로 발급된 토큰이 검증되는지 확인한다
+func TestKeyRingRotation(t *testing.T) {
+	oldKey := generateKeyPEM(t, "old", "RS256")
+	newKey := generateKeyPEM(t, "new", "RS256")
+
+	oldRing, err := NewKeyRing("old", oldKey)
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+	oldToken, err := GenerateAccessToken(1, "test@example.com", []string{"User"}, oldRing, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	// 교체 후: 새 키로 서명하고 이전 키는 검증 전용으로 남긴다
+	rotatedRing, err := NewKeyRing("new", KeyPEM{ID: "old", Algorithm: oldKey.Algorithm, PublicKey: oldKey.PublicKey}, newKey)
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+	newToken, err := GenerateAccessToken(1, "test@example.com", []string{"User"}, rotatedRing, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	for name, token := range map[string]string{"old": oldToken, "new": newToken} {
+		claims, err := ValidateAccessToken(token, rotatedRing)
+		if err != nil {
+			t.Fatalf("%s token should be valid: %v", name, err)
+		}
+		if claims.UserID != 1 || claims.ID == "" {
+			t.Errorf("%s token has unexpected claims: %+v", name, claims)
+		}
+	}
+
+	// 새 키로 발급된 토큰은 이전 키 링으로 검증할 수 없다
+	if _, err := ValidateAccessToken(newToken, oldRing); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("expected ErrInvalidToken, got: %v", err)
+	}
+}
+
+func TestKeyRingJWKS(t *testing.T) {
+	oldKey := generateKeyPEM(t, "old", "RS256")
+	newKey := generateKeyPEM(t, "new", "RS256")
+
+	keyRing, err := NewKeyRing("new", KeyPEM{ID: "old", Algorithm: oldKey.Algorithm, PublicKey: oldKey.PublicKey}, newKey)
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+
+	jwks := keyRing.JWKS()
+	if len(jwks.Keys) != 2 {
+		t.Fatalf("expected 2 keys, got: %d", len(jwks.Keys))
+	}
+	for i, kid := range []string{"old", "new"} {
+		jwk := jwks.Keys[i]
+		if jwk.Kid != kid || jwk.Kty != "RSA" || jwk.Alg != "RS256" || jwk.Use != "sig" {
+			t.Errorf("unexpected jwk: %+v", jwk)
+		}
+		if jwk.N == "" || jwk.E != "AQAB" {
+			t.Errorf("unexpected modulus or exponent: %+v", jwk)
+		}
+	}
+}
+
+func TestKeyRingAlgorithms(t *testing.T) {
+	for _, alg := range []string{"RS256", "ES256", "EdDSA"} {
+		t.Run(alg, func(t *testing.T) {
+			keyRing, err := NewKeyRing("key-1", generateKeyPEM(t, "key-1", alg))
+			if err != nil {
+				t.Fatalf("failed to create key ring: %v", err)
+			}
+
+			token, err := GenerateAccessToken(1, "test@example.com", []string{"User"}, keyRing, time.Minute)
+			if err != nil {
+				t.Fatalf("failed to generate token: %v", err)
+			}
+			if _, err := ValidateAccessToken(token, keyRing); err != nil {
+				t.Fatalf("token should be valid: %v", err)
+			}
+
+			jwks := keyRing.JWKS()
+			if len(jwks.Keys) != 1 || jwks.Keys[0].Alg != alg {
+				t.Fatalf("unexpected jwks: %+v", jwks)
+			}
+		})
+	}
+}
+
+// TestKeyRingRejectsAlgorithmMismatch 는 키에 설정된 알고리즘과 다른 alg 헤더의 토큰을 거부하는지 확인한다
+func TestKeyRingRejectsAlgorithmMismatch(t *testing.T) {
+	rsaKey := generateKeyPEM(t, "rsa", "RS256")
+	ecKey := generateKeyPEM(t, "ec", "ES256")
+
+	keyRing, err := NewKeyRing("rsa", rsaKey, KeyPEM{ID: "ec", Algorithm: "ES256", PublicKey: ecKey.PublicKey})
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+
+	// EC 키로 서명했지만 kid 는 RSA 키를 가리키는 토큰
+	ecRing, err := NewKeyRing("ec", ecKey)
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+	ecRing.keys["ec"].ID = "rsa"
+	token, err := GenerateAccessToken(1, "test@example.com", []string{"User"}, ecRing, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	if _, err := ValidateAccessToken(token, keyRing); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("expected ErrInvalidToken, got: %v", err)
+	}
+}
+
+func TestKeyRingJWKSKeyTypes(t *testing.T) {
+	keyRing, err := NewKeyRing("ed",
+		generateKeyPEM(t, "ec", "ES256"),
+		generateKeyPEM(t, "ed", "EdDSA"),
+	)
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+
+	jwks := keyRing.JWKS()
+	if len(jwks.Keys) != 2 {
+		t.Fatalf("expected 2 keys, got: %d", len(jwks.Keys))
+	}
+
+	ec := jwks.Keys[0]
+	if ec.Kty != "EC" || ec.Crv != "P-256" || ec.X == "" || ec.Y == "" || ec.N != "" {
+		t.Errorf("unexpected EC jwk: %+v", ec)
+	}
+	ed := jwks.Keys[1]
+	if ed.Kty != "OKP" || ed.Crv != "Ed25519" || ed.X == "" || ed.Y != "" {
+		t.Errorf("unexpected OKP jwk: %+v", ed)
+	}
+
+	algs := keyRing.Algorithms()
+	if len(algs) != 2 || algs[0] != "ES256" || algs[1] != "EdDSA" {
+		t.Errorf("unexpected algorithms: %v", algs)
+	}
+}
+
+func TestJWKPublicKeyRoundTrip(t *testing.T) {
+	keyRing, err := NewKeyRing("rsa",
+		generateKeyPEM(t, "rsa", "RS256"),
+		generateKeyPEM(t, "ec", "ES256"),
+		generateKeyPEM(t, "ed", "EdDSA"),
+	)
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+
+	for _, jwk := range keyRing.JWKS().Keys {
+		publicKey, err := jwk.PublicKey()
+		if err != nil {
+			t.Fatalf("%s: failed to parse jwk: %v", jwk.Kid, err)
+		}
+
+		expected, _ := keyRing.PublicKey(jwk.Kid)
+		if !publicKey.(interface{ Equal(crypto.PublicKey) bool }).Equal(expected) {
+			t.Errorf("%s: public key does not match", jwk.Kid)
+		}
+	}
+}
+
+func TestJWKPublicKeyRejectsInvalidKey(t *testing.T) {
+	tests := []struct {
+		name string
+		jwk  JWK
+	}{
+		{"Unknown Key Type", JWK{Kty: "oct"}},
+		{"Unsupported Curve", JWK{Kty: "EC", Crv: "P-384", X: "AA", Y: "AA"}},
+		{"Point Not On Curve", JWK{Kty: "EC", Crv: "P-256",
+			X: "AQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA",
+			Y: "AQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"}},
+		{"Empty RSA Modulus", JWK{Kty: "RSA", E: "AQAB"}},
+		{"Short Ed25519 Key", JWK{Kty: "OKP", Crv: "Ed25519", X: "AA"}},
+	}
+
+	for _, tt := range tests {
+		if _, err := tt.jwk.PublicKey(); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+}
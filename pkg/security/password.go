@@ -76,6 +76,12 @@ func GeneratePasswordHash(password string, p *HashParams) (string, error) {
 		argon2.Version, p.Memory, p.Time, p.Threads, encodedSalt, encodedHash), nil
 }
 
+// ValidateHashParams는 파라미터가 최소 보안 기준을 충족하는지 확인
+// 설정에서 읽은 파라미터를 서버 시작 시점에 검증하는 데 사용
+func ValidateHashParams(p *HashParams) error {
+	return validateParams(p)
+}
+
 func validateParams(p *HashParams) error {
 	// 시간 비용 검증 (OWASP 권장 최소값: 2)
 	if p.Time < 2 {
@@ -159,3 +165,31 @@ func ComparePasswordHash(password, encodedHash string) (bool, error) {
 	computedHash := argon2.IDKey([]byte(password), salt, time, memory, threads, uint32(len(hash)))
 	return subtle.ConstantTimeCompare(hash, computedHash) == 1, nil
 }
+
+// ValidatePasswordHash는 설정 등으로 전달받은 해시가 이 패키지가 만든 Argon2id 해시 형식인지 확인
+// 입력: 해시 문자열
+// 출력: 형식이 올바르지 않으면 에러
+func ValidatePasswordHash(encodedHash string) error {
+	_, _, _, _, _, err := parseHash(encodedHash)
+	return err
+}
+
+// NeedsRehash는 저장된 해시의 파라미터가 현재 정책(p)보다 약한지 확인
+// 입력: 저장된 해시 문자열, 현재 파라미터 (nil이면 기본값 사용)
+// 출력: 다시 해싱해야 하면 true. 해시를 해석할 수 없는 경우에도 true
+// 정책보다 강한 해시는 그대로 둔다 (파라미터를 낮춰도 기존 해시가 약해지지 않도록)
+func NeedsRehash(encodedHash string, p *HashParams) bool {
+	if p == nil {
+		p = &defaultParams
+	}
+
+	memory, time, threads, _, hash, err := parseHash(encodedHash)
+	if err != nil {
+		return true
+	}
+
+	return memory < p.Memory ||
+		time < p.Time ||
+		threads < p.Threads ||
+		uint32(len(hash)) < p.KeyLen
+}
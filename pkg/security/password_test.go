@@ -94,6 +94,106 @@ func TestComparePasswordHash(t *testing.T) {
 	}
 }
 
+// TestNeedsRehash는 저장된 해시의 파라미터가 현재 정책보다 약할 때만 다시 해싱하는지 확인하는 테스트
+func TestNeedsRehash(t *testing.T) {
+	weak := &HashParams{Time: 2, Memory: 32 * 1024, Threads: 2, KeyLen: 16}
+	weakHash, err := GeneratePasswordHash("password123", weak)
+	if err != nil {
+		t.Fatalf("failed to generate password hash: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		hash     string
+		params   *HashParams
+		expected bool
+	}{
+		{
+			name:     "same parameters",
+			hash:     weakHash,
+			params:   weak,
+			expected: false,
+		},
+		{
+			name:     "weaker than default",
+			hash:     weakHash,
+			params:   nil,
+			expected: true,
+		},
+		{
+			name:     "stronger than policy",
+			hash:     generateValidHash("password123"),
+			params:   weak,
+			expected: false,
+		},
+		{
+			name:     "only time raised",
+			hash:     weakHash,
+			params:   &HashParams{Time: 3, Memory: 32 * 1024, Threads: 2, KeyLen: 16},
+			expected: true,
+		},
+		{
+			name:     "only key length raised",
+			hash:     weakHash,
+			params:   &HashParams{Time: 2, Memory: 32 * 1024, Threads: 2, KeyLen: 32},
+			expected: true,
+		},
+		{
+			name:     "invalid hash format",
+			hash:     "$argon2id$v=19$m=65536,t=3,p=4$MTIzNDU2Nzg5MDEyMzQ1Ng",
+			params:   nil,
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NeedsRehash(tt.hash, tt.params); got != tt.expected {
+				t.Errorf("expected: %v, got: %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestValidatePasswordHash는 Argon2id 해시 형식이 아닌 값을 거부하는지 확인하는 테스트
+func TestValidatePasswordHash(t *testing.T) {
+	tests := []struct {
+		name        string
+		hash        string
+		shouldError bool
+	}{
+		{
+			name:        "valid hash",
+			hash:        generateValidHash("password123"),
+			shouldError: false,
+		},
+		{
+			name:        "plain text",
+			hash:        "password123",
+			shouldError: true,
+		},
+		{
+			name:        "other algorithm",
+			hash:        "$2a$10$abcdefghijklmnopqrstuv",
+			shouldError: true,
+		},
+		{
+			name:        "invalid salt encoding",
+			hash:        "$argon2id$v=19$m=65536,t=3,p=4$!!!$aGFzaA",
+			shouldError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePasswordHash(tt.hash)
+			if (err != nil) != tt.shouldError {
+				t.Errorf("expected error: %v, got: %v", tt.shouldError, err)
+			}
+		})
+	}
+}
+
 func generateValidHash(password string) string {
 	hash, _ := GeneratePasswordHash(password, nil)
 	return hash
@@ -0,0 +1,18 @@
+package security
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// GeneratePKCEVerifier returns a random PKCE code verifier (RFC 7636 4.1).
+// 43자의 URL-safe 문자열로, 인가 요청을 시작한 브라우저만 토큰을 교환할 수 있게 한다.
+func GeneratePKCEVerifier() (string, error) {
+	return GenerateOpaqueToken()
+}
+
+// PKCEChallenge returns the S256 code challenge for a code verifier (RFC 7636 4.2).
+func PKCEChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
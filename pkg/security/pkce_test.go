@@ -0,0 +1,32 @@
+package security
+
+import "testing"
+
+// challenge 는 BASE64URL(SHA256(verifier)) 이며 패딩을 붙이지 않는다
+func TestPKCEChallenge(t *testing.T) {
+	verifier := "M25iVXpKU3puUjFaYWg3T1NDTDQ2UmdHZjZ1dGx3dFJjMGVYbk1HM3VudQ"
+	expected := "y_sq79sYYZ7ydvLQVZ8CdlQMFd4utoa3SStAEtegy_Q"
+
+	if challenge := PKCEChallenge(verifier); challenge != expected {
+		t.Errorf("expected %s, got %s", expected, challenge)
+	}
+}
+
+func TestGeneratePKCEVerifier(t *testing.T) {
+	verifier, err := GeneratePKCEVerifier()
+	if err != nil {
+		t.Fatalf("failed to generate verifier: %v", err)
+	}
+	// RFC 7636 4.1: 43자 이상 128자 이하
+	if len(verifier) < 43 || len(verifier) > 128 {
+		t.Errorf("unexpected verifier length: %d", len(verifier))
+	}
+
+	other, err := GeneratePKCEVerifier()
+	if err != nil {
+		t.Fatalf("failed to generate verifier: %v", err)
+	}
+	if verifier == other {
+		t.Error("expected different verifiers")
+	}
+}
@@ -0,0 +1,132 @@
+package security
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PasswordPolicy는 비밀번호가 충족해야 하는 조건을 정의
+// - MinLength, MaxLength: 문자(rune) 단위 길이 제한 (0이면 제한 없음)
+// - RequireUpper, RequireLower, RequireDigit, RequireSymbol: 반드시 포함해야 하는 문자 종류
+// - DisallowEmail: 이메일 주소나 이메일 아이디(@ 앞부분) 포함 금지
+// - Breached: 유출된 비밀번호 목록 (nil이면 검사하지 않음)
+type PasswordPolicy struct {
+	MinLength     int
+	MaxLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	DisallowEmail bool
+	Breached      *BreachedPasswords
+}
+
+// DefaultMinLength 는 기본 정책의 최소 길이. 기존 가입 요청의 min=8 과 같은 수준
+const DefaultMinLength = 8
+
+// 기본 정책
+// MaxLength는 매우 긴 입력으로 해싱 비용을 키우는 것을 막기 위한 값
+var defaultPolicy = PasswordPolicy{
+	MinLength:     DefaultMinLength,
+	MaxLength:     128,
+	DisallowEmail: true,
+}
+
+// 정책 위반 사유 코드. 클라이언트는 코드로 메시지를 번역해서 보여줄 수 있다
+const (
+	ViolationTooShort      = "too_short"
+	ViolationTooLong       = "too_long"
+	ViolationMissingUpper  = "missing_upper"
+	ViolationMissingLower  = "missing_lower"
+	ViolationMissingDigit  = "missing_digit"
+	ViolationMissingSymbol = "missing_symbol"
+	ViolationContainsEmail = "contains_email"
+	ViolationBreached      = "breached"
+)
+
+// 이메일 아이디가 이보다 짧으면 우연히 포함될 수 있으므로 검사하지 않는다
+const minEmailPartLength = 3
+
+// PolicyViolation은 정책을 충족하지 못한 사유
+type PolicyViolation struct {
+	Code    string
+	Message string
+}
+
+// Check는 비밀번호가 정책을 충족하지 못한 사유를 모두 반환 (nil 정책은 기본 정책 사용)
+// 입력: 평문 비밀번호, 사용자 이메일 (DisallowEmail 검사용, 비어 있으면 검사하지 않음)
+// 출력: 위반 사유 목록. 비어 있으면 정책 충족
+func (p *PasswordPolicy) Check(password, email string) []PolicyViolation {
+	if p == nil {
+		p = &defaultPolicy
+	}
+
+	var violations []PolicyViolation
+	length := utf8.RuneCountInString(password)
+	if p.MinLength > 0 && length < p.MinLength {
+		violations = append(violations, PolicyViolation{
+			Code:    ViolationTooShort,
+			Message: fmt.Sprintf("must be at least %d characters", p.MinLength),
+		})
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		violations = append(violations, PolicyViolation{
+			Code:    ViolationTooLong,
+			Message: fmt.Sprintf("must be at most %d characters", p.MaxLength),
+		})
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSymbol = true
+		}
+	}
+	if p.RequireUpper && !hasUpper {
+		violations = append(violations, PolicyViolation{Code: ViolationMissingUpper, Message: "must contain an uppercase letter"})
+	}
+	if p.RequireLower && !hasLower {
+		violations = append(violations, PolicyViolation{Code: ViolationMissingLower, Message: "must contain a lowercase letter"})
+	}
+	if p.RequireDigit && !hasDigit {
+		violations = append(violations, PolicyViolation{Code: ViolationMissingDigit, Message: "must contain a digit"})
+	}
+	if p.RequireSymbol && !hasSymbol {
+		violations = append(violations, PolicyViolation{Code: ViolationMissingSymbol, Message: "must contain a symbol"})
+	}
+
+	if p.DisallowEmail && containsEmail(password, email) {
+		violations = append(violations, PolicyViolation{Code: ViolationContainsEmail, Message: "must not contain your email address"})
+	}
+
+	if p.Breached != nil && p.Breached.Contains(password) {
+		violations = append(violations, PolicyViolation{Code: ViolationBreached, Message: "has appeared in a data breach, choose a different password"})
+	}
+
+	return violations
+}
+
+// containsEmail은 비밀번호에 이메일 주소나 이메일 아이디가 포함되어 있는지 대소문자 구분 없이 확인
+func containsEmail(password, email string) bool {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		return false
+	}
+
+	password = strings.ToLower(password)
+	if strings.Contains(password, email) {
+		return true
+	}
+
+	local, _, _ := strings.Cut(email, "@")
+	return len(local) >= minEmailPartLength && strings.Contains(password, local)
+}
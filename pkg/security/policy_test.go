@@ -0,0 +1,154 @@
+package security
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func violationCodes(violations []PolicyViolation) []string {
+	var codes []string
+	for _, v := range violations {
+		codes = append(codes, v.Code)
+	}
+	return codes
+}
+
+func TestPasswordPolicyCheck(t *testing.T) {
+	breached, err := NewBreachedPasswords(strings.NewReader("# top passwords\n123456\npassword1\n\nqwerty123\n"))
+	if err != nil {
+		t.Fatalf("failed to load breached passwords: %v", err)
+	}
+
+	strict := &PasswordPolicy{
+		MinLength:     10,
+		MaxLength:     20,
+		RequireUpper:  true,
+		RequireLower:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+		DisallowEmail: true,
+		Breached:      breached,
+	}
+
+	tests := []struct {
+		name     string
+		policy   *PasswordPolicy
+		password string
+		email    string
+		expected []string
+	}{
+		{
+			name:     "default policy accepts 8 characters",
+			policy:   nil,
+			password: "abcdefgh",
+			email:    "user@example.com",
+			expected: nil,
+		},
+		{
+			name:     "default policy rejects short password",
+			policy:   nil,
+			password: "abc",
+			expected: []string{ViolationTooShort},
+		},
+		{
+			name:     "length counted in characters",
+			policy:   &PasswordPolicy{MinLength: 4},
+			password: "비밀번호",
+			expected: nil,
+		},
+		{
+			name:     "strict policy accepts strong password",
+			policy:   strict,
+			password: "Tr0ub4dor&3x",
+			email:    "user@example.com",
+			expected: nil,
+		},
+		{
+			name:     "all reasons reported",
+			policy:   strict,
+			password: "abc",
+			expected: []string{ViolationTooShort, ViolationMissingUpper, ViolationMissingDigit, ViolationMissingSymbol},
+		},
+		{
+			name:     "too long",
+			policy:   strict,
+			password: "Aa1!" + strings.Repeat("x", 20),
+			expected: []string{ViolationTooLong},
+		},
+		{
+			name:     "contains email local part",
+			policy:   strict,
+			password: "Johnsmith!2024",
+			email:    "JohnSmith@example.com",
+			expected: []string{ViolationContainsEmail},
+		},
+		{
+			name:     "short local part ignored",
+			policy:   &PasswordPolicy{DisallowEmail: true},
+			password: "jo-password",
+			email:    "jo@example.com",
+			expected: nil,
+		},
+		{
+			name:     "breached password regardless of case",
+			policy:   &PasswordPolicy{Breached: breached},
+			password: "PASSWORD1",
+			expected: []string{ViolationBreached},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := violationCodes(tt.policy.Check(tt.password, tt.email))
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected violations %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestBreachedPasswords(t *testing.T) {
+	var list strings.Builder
+	for i := 0; i < 10000; i++ {
+		fmt.Fprintf(&list, "breached-%d\n", i)
+	}
+
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	if err := os.WriteFile(path, []byte(list.String()), 0o600); err != nil {
+		t.Fatalf("failed to write list: %v", err)
+	}
+
+	breached, err := LoadBreachedPasswords(path)
+	if err != nil {
+		t.Fatalf("failed to load breached passwords: %v", err)
+	}
+	if breached.Len() != 10000 {
+		t.Errorf("expected 10000 passwords, got %d", breached.Len())
+	}
+
+	// 목록에 있는 비밀번호는 항상 찾아야 한다
+	for i := 0; i < 10000; i++ {
+		if !breached.Contains(fmt.Sprintf("breached-%d", i)) {
+			t.Fatalf("breached-%d not found", i)
+		}
+	}
+
+	// 목록에 없는 비밀번호를 있다고 판단하는 비율은 설계값(0.1%)에 가까워야 한다
+	falsePositives := 0
+	for i := 0; i < 10000; i++ {
+		if breached.Contains(fmt.Sprintf("safe-%d", i)) {
+			falsePositives++
+		}
+	}
+	if falsePositives > 50 {
+		t.Errorf("too many false positives: %d/10000", falsePositives)
+	}
+
+	if _, err := LoadBreachedPasswords(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
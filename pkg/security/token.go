@@ -1,17 +1,32 @@
 package security
 
 import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 var (
 	ErrInvalidToken = errors.New("invalid token")
 	ErrExpiredToken = errors.New("token has expired")
-	ErrParsingKey   = errors.New("error parsing RSA key")
+	ErrRevokedToken = errors.New("token has been revoked")
+	ErrTokenType    = errors.New("unexpected token type")
+	ErrParsingKey   = errors.New("error parsing key")
+
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
 )
 
 // TokenType defines the type of token
@@ -22,17 +37,34 @@ const (
 	AccessToken TokenType = "access"
 	// RefreshToken is used to get new access tokens
 	RefreshToken TokenType = "refresh"
+	// MFAToken is issued after password login and exchanged for tokens with a second factor
+	MFAToken TokenType = "mfa"
+	// APIKey is not a JWT. It marks principals authenticated with a personal API key
+	APIKey TokenType = "api_key"
 )
 
 // JWTClaims represents the claims in a JWT token
 type JWTClaims struct {
-	UserID int64     `json:"user_id"`
-	Email  string    `json:"email"`
-	Roles  []string  `json:"roles"`
-	Type   TokenType `json:"type"`
+	UserID    int64     `json:"user_id"`
+	Email     string    `json:"email"`
+	Roles     []string  `json:"roles"`
+	Type      TokenType `json:"type"`
+	SessionID string    `json:"sid,omitempty"` // 토큰을 발급한 로그인 세션 ID. 세션 없이 발급된 토큰은 비어 있다
+	// ClientID 는 client_credentials 로 발급한 서비스 토큰의 OAuth 클라이언트 ID 이다. sub 에도 같은 값이 들어간다
+	ClientID string `json:"client_id,omitempty"`
+	Scope    string `json:"scope,omitempty"` // 서비스 토큰에 허용된 scope (공백으로 구분)
+	// Actor 는 대리 로그인 토큰에서 실제로 요청하는 관리자이다. 일반 토큰은 nil 이다
+	Actor *ActorClaims `json:"act,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// ActorClaims represents the act (actor) claim of an impersonation token (RFC 8693 4.1)
+type ActorClaims struct {
+	Subject string `json:"sub"` // 관리자의 사용자 ID
+	UserID  int64  `json:"user_id"`
+	Email   string `json:"email,omitempty"`
+}
+
 // ParseRSAPrivateKeyFromPEM parses a PEM encoded RSA private key
 func ParseRSAPrivateKeyFromPEM(key string) (*rsa.PrivateKey, error) {
 	privateKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(key))
@@ -51,21 +83,99 @@ func ParseRSAPublicKeyFromPEM(key string) (*rsa.PublicKey, error) {
 	return publicKey, nil
 }
 
-// GenerateToken creates a new JWT token for a user using RSA private key
-func GenerateToken(userID int64, email string, roles []string, privateKey *rsa.PrivateKey, expirationTime time.Duration, tokenType TokenType) (string, error) {
-	claims := &JWTClaims{
+// SigningMethod returns the jwt signing method for a configured algorithm name.
+// 빈 문자열이면 기존과 같이 RS256 을 사용한다.
+func SigningMethod(alg string) (jwt.SigningMethod, error) {
+	switch alg {
+	case "", jwt.SigningMethodRS256.Alg():
+		return jwt.SigningMethodRS256, nil
+	case jwt.SigningMethodES256.Alg():
+		return jwt.SigningMethodES256, nil
+	case jwt.SigningMethodEdDSA.Alg():
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+}
+
+// ParsePrivateKeyFromPEM parses a PEM encoded private key for the given signing method
+func ParsePrivateKeyFromPEM(method jwt.SigningMethod, key string) (crypto.Signer, error) {
+	switch method {
+	case jwt.SigningMethodRS256:
+		return ParseRSAPrivateKeyFromPEM(key)
+	case jwt.SigningMethodES256:
+		privateKey, err := jwt.ParseECPrivateKeyFromPEM([]byte(key))
+		if err != nil || privateKey.Curve != elliptic.P256() {
+			return nil, ErrParsingKey
+		}
+		return privateKey, nil
+	case jwt.SigningMethodEdDSA:
+		privateKey, err := jwt.ParseEdPrivateKeyFromPEM([]byte(key))
+		if err != nil {
+			return nil, ErrParsingKey
+		}
+		signer, ok := privateKey.(ed25519.PrivateKey)
+		if !ok {
+			return nil, ErrParsingKey
+		}
+		return signer, nil
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+}
+
+// ParsePublicKeyFromPEM parses a PEM encoded public key for the given signing method
+func ParsePublicKeyFromPEM(method jwt.SigningMethod, key string) (crypto.PublicKey, error) {
+	switch method {
+	case jwt.SigningMethodRS256:
+		return ParseRSAPublicKeyFromPEM(key)
+	case jwt.SigningMethodES256:
+		publicKey, err := jwt.ParseECPublicKeyFromPEM([]byte(key))
+		if err != nil || publicKey.Curve != elliptic.P256() {
+			return nil, ErrParsingKey
+		}
+		return publicKey, nil
+	case jwt.SigningMethodEdDSA:
+		publicKey, err := jwt.ParseEdPublicKeyFromPEM([]byte(key))
+		if err != nil {
+			return nil, ErrParsingKey
+		}
+		return publicKey, nil
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+}
+
+// GenerateToken creates a new JWT token for a user using the current signing key of the key ring
+func GenerateToken(userID int64, email string, roles []string, keyRing *KeyRing, expirationTime time.Duration, tokenType TokenType) (string, error) {
+	return signToken(&JWTClaims{
 		UserID: userID,
 		Email:  email,
 		Roles:  roles,
 		Type:   tokenType,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expirationTime)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-		},
+	}, keyRing, expirationTime)
+}
+
+// signToken fills the registered claims (jti, iss, aud, exp, iat) and signs the token
+// 호출하는 쪽에서 지정한 sub 는 유지한다
+func signToken(claims *JWTClaims, keyRing *KeyRing, expirationTime time.Duration) (string, error) {
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		// jti: 같은 초에 발급된 토큰이라도 서로 구별되도록 고유 ID 를 부여한다
+		ID:        uuid.NewString(),
+		Subject:   claims.Subject,
+		Issuer:    keyRing.issuer,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(expirationTime)),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+	}
+	if keyRing.audience != "" {
+		claims.Audience = jwt.ClaimStrings{keyRing.audience}
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
-	tokenString, err := token.SignedString(privateKey)
+	// 검증하는 쪽에서 어떤 키로 서명되었는지 알 수 있도록 kid 헤더를 추가한다
+	signingKey := keyRing.SigningKey()
+	token := jwt.NewWithClaims(signingKey.Method, claims)
+	token.Header["kid"] = signingKey.ID
+	tokenString, err := token.SignedString(signingKey.PrivateKey)
 	if err != nil {
 		return "", err
 	}
@@ -74,30 +184,71 @@ func GenerateToken(userID int64, email string, roles []string, privateKey *rsa.P
 }
 
 // GenerateAccessToken creates a new access token
-func GenerateAccessToken(userID int64, email string, roles []string, privateKey *rsa.PrivateKey, expirationTime time.Duration) (string, error) {
-	return GenerateToken(userID, email, roles, privateKey, expirationTime, AccessToken)
+func GenerateAccessToken(userID int64, email string, roles []string, keyRing *KeyRing, expirationTime time.Duration) (string, error) {
+	return GenerateToken(userID, email, roles, keyRing, expirationTime, AccessToken)
+}
+
+// GenerateSessionAccessToken creates a new access token bound to a login session (sid claim)
+func GenerateSessionAccessToken(userID int64, email string, roles []string, sessionID string, keyRing *KeyRing, expirationTime time.Duration) (string, error) {
+	return signToken(&JWTClaims{
+		UserID:    userID,
+		Email:     email,
+		Roles:     roles,
+		Type:      AccessToken,
+		SessionID: sessionID,
+	}, keyRing, expirationTime)
+}
+
+// GenerateImpersonationAccessToken creates an access token for a user that an admin (actor) acts as
+// 세션 없이 발급하므로 리프레시 토큰으로 연장할 수 없고, act claim 으로 실제 요청자를 구별한다
+func GenerateImpersonationAccessToken(userID int64, email string, roles []string, actorID int64, actorEmail string, keyRing *KeyRing, expirationTime time.Duration) (string, error) {
+	return signToken(&JWTClaims{
+		UserID: userID,
+		Email:  email,
+		Roles:  roles,
+		Type:   AccessToken,
+		Actor: &ActorClaims{
+			Subject: strconv.FormatInt(actorID, 10),
+			UserID:  actorID,
+			Email:   actorEmail,
+		},
+	}, keyRing, expirationTime)
+}
+
+// GenerateClientAccessToken creates an access token for an OAuth client (client_credentials grant)
+// 사용자 토큰과 달리 user_id 없이 sub 와 client_id 에 클라이언트 ID 를 담는다
+func GenerateClientAccessToken(clientID string, scopes []string, keyRing *KeyRing, expirationTime time.Duration) (string, error) {
+	return signToken(&JWTClaims{
+		Type:     AccessToken,
+		ClientID: clientID,
+		Scope:    strings.Join(scopes, " "),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: clientID,
+		},
+	}, keyRing, expirationTime)
 }
 
 // GenerateRefreshToken creates a new refresh token
-func GenerateRefreshToken(userID int64, email string, roles []string, privateKey *rsa.PrivateKey, expirationTime time.Duration) (string, error) {
-	return GenerateToken(userID, email, roles, privateKey, expirationTime, RefreshToken)
+func GenerateRefreshToken(userID int64, email string, roles []string, keyRing *KeyRing, expirationTime time.Duration) (string, error) {
+	return GenerateToken(userID, email, roles, keyRing, expirationTime, RefreshToken)
+}
+
+// GenerateMFAToken creates a short-lived MFA challenge token
+func GenerateMFAToken(userID int64, email string, roles []string, keyRing *KeyRing, expirationTime time.Duration) (string, error) {
+	return GenerateToken(userID, email, roles, keyRing, expirationTime, MFAToken)
 }
 
 // ValidateToken validates a JWT token and returns the claims
-func ValidateToken(tokenString string, publicKey *rsa.PublicKey) (*JWTClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		// 서명 방식 확인
-		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
-			return nil, ErrInvalidToken
-		}
-		return publicKey, nil
-	})
+// 토큰 헤더의 kid 로 키 링에서 검증 키를 찾고, 키 링에 등록된 알고리즘과 iss, aud 를 검증한다.
+// 반환하는 에러는 원인이 된 jwt 에러(jwt.ErrTokenSignatureInvalid 등)도 함께 감싼다.
+func ValidateToken(tokenString string, keyRing *KeyRing) (*JWTClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, keyRing.KeyFunc, keyRing.parserOptions()...)
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
-			return nil, ErrExpiredToken
+			return nil, fmt.Errorf("%w: %w", ErrExpiredToken, err)
 		}
-		return nil, ErrInvalidToken
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
 	}
 
 	if !token.Valid {
@@ -113,29 +264,61 @@ func ValidateToken(tokenString string, publicKey *rsa.PublicKey) (*JWTClaims, er
 }
 
 // ValidateAccessToken validates an access token
-func ValidateAccessToken(tokenString string, publicKey *rsa.PublicKey) (*JWTClaims, error) {
-	claims, err := ValidateToken(tokenString, publicKey)
+func ValidateAccessToken(tokenString string, keyRing *KeyRing) (*JWTClaims, error) {
+	claims, err := ValidateToken(tokenString, keyRing)
 	if err != nil {
 		return nil, err
 	}
 
+	// 리프레시 토큰 등 다른 종류의 토큰을 액세스 토큰으로 사용할 수 없다
 	if claims.Type != AccessToken {
-		return nil, ErrInvalidToken
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, ErrTokenType)
 	}
 
 	return claims, nil
 }
 
 // ValidateRefreshToken validates a refresh token
-func ValidateRefreshToken(tokenString string, publicKey *rsa.PublicKey) (*JWTClaims, error) {
-	claims, err := ValidateToken(tokenString, publicKey)
+func ValidateRefreshToken(tokenString string, keyRing *KeyRing) (*JWTClaims, error) {
+	claims, err := ValidateToken(tokenString, keyRing)
 	if err != nil {
 		return nil, err
 	}
 
 	if claims.Type != RefreshToken {
-		return nil, ErrInvalidToken
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, ErrTokenType)
 	}
 
 	return claims, nil
 }
+
+// ValidateMFAToken validates an MFA challenge token
+func ValidateMFAToken(tokenString string, keyRing *KeyRing) (*JWTClaims, error) {
+	claims, err := ValidateToken(tokenString, keyRing)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Type != MFAToken {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, ErrTokenType)
+	}
+
+	return claims, nil
+}
+
+// HashToken returns the hex encoded SHA-256 digest of a token.
+// 토큰 원문 대신 이 값을 저장하여 DB 가 유출되어도 토큰을 재사용할 수 없게 한다.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// GenerateOpaqueToken returns a URL-safe random token with 256 bits of entropy.
+// 비밀번호 재설정 링크처럼 JWT 가 필요 없는 일회용 토큰에 사용하며, 저장할 때는 HashToken 으로 해싱한다.
+func GenerateOpaqueToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,199 @@
+package security
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestValidateAccessTokenRejectsRefreshToken(t *testing.T) {
+	keyRing, err := NewKeyRing("key-1", generateKeyPEM(t, "key-1", "RS256"))
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+
+	refreshToken, err := GenerateRefreshToken(1, "test@example.com", []string{"User"}, keyRing, time.Hour)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	_, err = ValidateAccessToken(refreshToken, keyRing)
+	if !errors.Is(err, ErrInvalidToken) || !errors.Is(err, ErrTokenType) {
+		t.Errorf("expected ErrInvalidToken and ErrTokenType, got: %v", err)
+	}
+	if _, err := ValidateRefreshToken(refreshToken, keyRing); err != nil {
+		t.Errorf("refresh token should be valid: %v", err)
+	}
+}
+
+func TestValidateTokenIssuerAndAudience(t *testing.T) {
+	key := generateKeyPEM(t, "key-1", "RS256")
+	newKeyRing := func(issuer, audience string) *KeyRing {
+		keyRing, err := NewKeyRing("key-1", key)
+		if err != nil {
+			t.Fatalf("failed to create key ring: %v", err)
+		}
+		return keyRing.WithIssuer(issuer, audience)
+	}
+
+	issuing := newKeyRing("https://auth.example.com", "gocore")
+	token, err := GenerateAccessToken(1, "test@example.com", []string{"User"}, issuing, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		keyRing   *KeyRing
+		expectErr error
+	}{
+		{name: "same issuer and audience", keyRing: issuing},
+		{name: "other issuer", keyRing: newKeyRing("https://evil.example.com", "gocore"), expectErr: jwt.ErrTokenInvalidIssuer},
+		{name: "other audience", keyRing: newKeyRing("https://auth.example.com", "billing"), expectErr: jwt.ErrTokenInvalidAudience},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := ValidateAccessToken(token, tt.keyRing)
+			if tt.expectErr != nil {
+				if !errors.Is(err, ErrInvalidToken) || !errors.Is(err, tt.expectErr) {
+					t.Fatalf("expected %v, got: %v", tt.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("token should be valid: %v", err)
+			}
+			if claims.Issuer != "https://auth.example.com" || len(claims.Audience) != 1 || claims.Audience[0] != "gocore" {
+				t.Errorf("unexpected claims: %+v", claims)
+			}
+		})
+	}
+}
+
+func TestValidateTokenExpired(t *testing.T) {
+	keyRing, err := NewKeyRing("key-1", generateKeyPEM(t, "key-1", "ES256"))
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+
+	token, err := GenerateAccessToken(1, "test@example.com", []string{"User"}, keyRing, -time.Minute)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	_, err = ValidateAccessToken(token, keyRing)
+	if !errors.Is(err, ErrExpiredToken) || !errors.Is(err, jwt.ErrTokenExpired) {
+		t.Errorf("expected ErrExpiredToken, got: %v", err)
+	}
+}
+
+func TestValidateAccessTokenRejectsMFAToken(t *testing.T) {
+	keyRing, err := NewKeyRing("key-1", generateKeyPEM(t, "key-1", "RS256"))
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+
+	mfaToken, err := GenerateMFAToken(1, "test@example.com", []string{"Admin"}, keyRing, 5*time.Minute)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	// MFA 를 마치지 않은 토큰으로는 API 에 접근할 수 없다
+	if _, err := ValidateAccessToken(mfaToken, keyRing); !errors.Is(err, ErrTokenType) {
+		t.Errorf("expected ErrTokenType, got: %v", err)
+	}
+	if _, err := ValidateMFAToken(mfaToken, keyRing); err != nil {
+		t.Errorf("mfa token should be valid: %v", err)
+	}
+}
+
+func TestGenerateSessionAccessToken(t *testing.T) {
+	keyRing, err := NewKeyRing("key-1", generateKeyPEM(t, "key-1", "RS256"))
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+
+	token, err := GenerateSessionAccessToken(1, "test@example.com", []string{"User"}, "session-1", keyRing, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	claims, err := ValidateAccessToken(token, keyRing)
+	if err != nil {
+		t.Fatalf("session access token should be valid: %v", err)
+	}
+	if claims.SessionID != "session-1" {
+		t.Errorf("expected sid session-1, got: %q", claims.SessionID)
+	}
+
+	// 세션 없이 발급된 토큰에는 sid 가 없다
+	token, err = GenerateAccessToken(1, "test@example.com", []string{"User"}, keyRing, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+	claims, err = ValidateAccessToken(token, keyRing)
+	if err != nil {
+		t.Fatalf("access token should be valid: %v", err)
+	}
+	if claims.SessionID != "" {
+		t.Errorf("expected empty sid, got: %q", claims.SessionID)
+	}
+}
+
+func TestGenerateImpersonationAccessToken(t *testing.T) {
+	keyRing, err := NewKeyRing("key-1", generateKeyPEM(t, "key-1", "RS256"))
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+
+	token, err := GenerateImpersonationAccessToken(2, "user@example.com", []string{"User"}, 1, "admin@example.com", keyRing, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	claims, err := ValidateAccessToken(token, keyRing)
+	if err != nil {
+		t.Fatalf("impersonation token should be valid: %v", err)
+	}
+	if claims.UserID != 2 || claims.Email != "user@example.com" {
+		t.Errorf("expected target user, got: %d %q", claims.UserID, claims.Email)
+	}
+	if claims.Actor == nil {
+		t.Fatal("expected act claim")
+	}
+	if claims.Actor.Subject != "1" || claims.Actor.UserID != 1 || claims.Actor.Email != "admin@example.com" {
+		t.Errorf("unexpected act claim: %+v", claims.Actor)
+	}
+	if claims.SessionID != "" {
+		t.Errorf("impersonation token should not be bound to a session, got: %q", claims.SessionID)
+	}
+}
+
+func TestGenerateClientAccessToken(t *testing.T) {
+	keyRing, err := NewKeyRing("key-1", generateKeyPEM(t, "key-1", "RS256"))
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+
+	token, err := GenerateClientAccessToken("billing-service", []string{"orders:read", "users:read"}, keyRing, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	claims, err := ValidateAccessToken(token, keyRing)
+	if err != nil {
+		t.Fatalf("client access token should be valid: %v", err)
+	}
+	if claims.Subject != "billing-service" || claims.ClientID != "billing-service" {
+		t.Errorf("expected client subject, got sub %q client_id %q", claims.Subject, claims.ClientID)
+	}
+	if claims.UserID != 0 {
+		t.Errorf("expected no user id, got: %d", claims.UserID)
+	}
+	if claims.Scope != "orders:read users:read" {
+		t.Errorf("unexpected scope: %q", claims.Scope)
+	}
+}
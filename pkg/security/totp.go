@@ -0,0 +1,132 @@
+package security
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RFC 6238 TOTP 설정. 대부분의 인증 앱(Google Authenticator 등)이 지원하는 기본값을 사용한다
+const (
+	totpPeriod    = 30 // 코드가 바뀌는 주기 (초)
+	totpDigits    = 6  // 코드 자릿수
+	totpSkew      = 1  // 시계 오차를 고려해 앞뒤로 허용하는 주기 수
+	totpSecretLen = 20 // 비밀키 길이 (바이트). RFC 4226 권장값 160비트
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret returns a random base32 encoded TOTP secret
+func GenerateTOTPSecret() (string, error) {
+	b := make([]byte, totpSecretLen)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return totpEncoding.EncodeToString(b), nil
+}
+
+// TOTPURI returns the otpauth URI which authenticator apps read from a QR code
+func TOTPURI(issuer, account, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(totpDigits))
+	query.Set("period", fmt.Sprint(totpPeriod))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: query.Encode(),
+	}
+	return u.String()
+}
+
+// TOTPStep returns the RFC 6238 time step for t
+func TOTPStep(t time.Time) int64 {
+	return t.Unix() / totpPeriod
+}
+
+// GenerateTOTPCode returns the TOTP code of the secret for the time step containing t
+func GenerateTOTPCode(secret string, t time.Time) (string, error) {
+	key, err := decodeTOTPSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return hotp(key, TOTPStep(t)), nil
+}
+
+// ValidateTOTPCode checks the code against the time steps around t and returns the matched step.
+// 같은 코드를 다시 사용하지 못하도록 호출하는 쪽에서 반환된 step 이 마지막으로 사용한 step 보다 큰지 확인해야 한다.
+func ValidateTOTPCode(secret, code string, t time.Time) (int64, bool) {
+	key, err := decodeTOTPSecret(secret)
+	if err != nil || len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := TOTPStep(t)
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if subtle.ConstantTimeCompare([]byte(hotp(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// GenerateRecoveryCodes returns n random recovery codes formatted as xxxxx-xxxxx.
+// 저장할 때는 NormalizeRecoveryCode 로 정규화한 값을 GeneratePasswordHash 로 해싱한다.
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		b := make([]byte, 7)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		code := strings.ToLower(totpEncoding.EncodeToString(b))[:10]
+		codes = append(codes, code[:5]+"-"+code[5:])
+	}
+	return codes, nil
+}
+
+// NormalizeRecoveryCode removes separators and case differences from a recovery code
+func NormalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+func decodeTOTPSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.TrimRight(secret, "="))
+	key, err := totpEncoding.DecodeString(secret)
+	if err != nil {
+		return nil, ErrParsingKey
+	}
+	return key, nil
+}
+
+// hotp 는 RFC 4226 HOTP 값을 계산한다
+func hotp(key []byte, counter int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%mod)
+}
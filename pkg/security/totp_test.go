@@ -0,0 +1,105 @@
+package security
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+// RFC 6238 Appendix B 의 SHA1 테스트 벡터. 8자리 값의 뒤 6자리가 6자리 코드와 같다
+func TestGenerateTOTPCodeRFC6238(t *testing.T) {
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tt := range tests {
+		code, err := GenerateTOTPCode(secret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatalf("failed to generate code: %v", err)
+		}
+		if code != tt.code {
+			t.Errorf("time %d: expected %s, got %s", tt.unix, tt.code, code)
+		}
+	}
+}
+
+func TestValidateTOTPCode(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatalf("failed to generate secret: %v", err)
+	}
+
+	now := time.Now()
+	code, err := GenerateTOTPCode(secret, now)
+	if err != nil {
+		t.Fatalf("failed to generate code: %v", err)
+	}
+
+	step, ok := ValidateTOTPCode(secret, code, now)
+	if !ok || step != TOTPStep(now) {
+		t.Errorf("expected current code to be valid at step %d, got %d, %v", TOTPStep(now), step, ok)
+	}
+
+	// 시계 오차는 앞뒤 한 주기까지 허용한다
+	if _, ok := ValidateTOTPCode(secret, code, now.Add(30*time.Second)); !ok {
+		t.Error("expected code from previous step to be valid")
+	}
+	if _, ok := ValidateTOTPCode(secret, code, now.Add(2*time.Minute)); ok {
+		t.Error("expected code from old step to be invalid")
+	}
+	if _, ok := ValidateTOTPCode(secret, "12345", now); ok {
+		t.Error("expected short code to be invalid")
+	}
+	if _, ok := ValidateTOTPCode("not base32!", code, now); ok {
+		t.Error("expected invalid secret to be rejected")
+	}
+}
+
+func TestTOTPURI(t *testing.T) {
+	uri := TOTPURI("gocore", "admin@gmail.com", "JBSWY3DPEHPK3PXP")
+
+	if !strings.HasPrefix(uri, "otpauth://totp/gocore:admin@gmail.com?") {
+		t.Errorf("unexpected uri prefix: %s", uri)
+	}
+	for _, param := range []string{"secret=JBSWY3DPEHPK3PXP", "issuer=gocore", "digits=6", "period=30"} {
+		if !strings.Contains(uri, param) {
+			t.Errorf("expected uri to contain %s: %s", param, uri)
+		}
+	}
+}
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(10)
+	if err != nil {
+		t.Fatalf("failed to generate recovery codes: %v", err)
+	}
+	if len(codes) != 10 {
+		t.Fatalf("expected 10 codes, got %d", len(codes))
+	}
+
+	seen := make(map[string]bool)
+	for _, code := range codes {
+		if len(code) != 11 || code[5] != '-' {
+			t.Errorf("unexpected recovery code format: %s", code)
+		}
+		if seen[code] {
+			t.Errorf("duplicate recovery code: %s", code)
+		}
+		seen[code] = true
+
+		if normalized := NormalizeRecoveryCode(" " + strings.ToUpper(code) + " "); normalized != strings.ReplaceAll(code, "-", "") {
+			t.Errorf("unexpected normalized code: %s", normalized)
+		}
+	}
+}